@@ -121,6 +121,285 @@ func (AutomationMode) EnumDescriptor() ([]byte, []int) {
 	return file_jules_proto_rawDescGZIP(), []int{1}
 }
 
+// PipelineCondition decides when a pipeline step starts, based on the jobs of the steps it depends on.
+type PipelineCondition int32
+
+const (
+	PipelineCondition_PIPELINE_CONDITION_UNSPECIFIED        PipelineCondition = 0 // Same as SESSIONS_COMPLETED
+	PipelineCondition_PIPELINE_CONDITION_SESSIONS_COMPLETED PipelineCondition = 1 // Every session of each dependency ended COMPLETED
+	PipelineCondition_PIPELINE_CONDITION_PR_MERGED          PipelineCondition = 2 // A PR of each dependency was merged
+	PipelineCondition_PIPELINE_CONDITION_ANY_SUCCESS        PipelineCondition = 3 // At least one session of each dependency COMPLETED or had its PR merged
+)
+
+// Enum value maps for PipelineCondition.
+var (
+	PipelineCondition_name = map[int32]string{
+		0: "PIPELINE_CONDITION_UNSPECIFIED",
+		1: "PIPELINE_CONDITION_SESSIONS_COMPLETED",
+		2: "PIPELINE_CONDITION_PR_MERGED",
+		3: "PIPELINE_CONDITION_ANY_SUCCESS",
+	}
+	PipelineCondition_value = map[string]int32{
+		"PIPELINE_CONDITION_UNSPECIFIED":        0,
+		"PIPELINE_CONDITION_SESSIONS_COMPLETED": 1,
+		"PIPELINE_CONDITION_PR_MERGED":          2,
+		"PIPELINE_CONDITION_ANY_SUCCESS":        3,
+	}
+)
+
+func (x PipelineCondition) Enum() *PipelineCondition {
+	p := new(PipelineCondition)
+	*p = x
+	return p
+}
+
+func (x PipelineCondition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PipelineCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_jules_proto_enumTypes[2].Descriptor()
+}
+
+func (PipelineCondition) Type() protoreflect.EnumType {
+	return &file_jules_proto_enumTypes[2]
+}
+
+func (x PipelineCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PipelineCondition.Descriptor instead.
+func (PipelineCondition) EnumDescriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{2}
+}
+
+// Stored in the jobs table without the prefix ('PENDING', 'PROCESSING', ...).
+type JobStatus int32
+
+const (
+	JobStatus_JOB_STATUS_UNSPECIFIED         JobStatus = 0
+	JobStatus_JOB_STATUS_PENDING             JobStatus = 1
+	JobStatus_JOB_STATUS_PROCESSING          JobStatus = 2
+	JobStatus_JOB_STATUS_COMPLETED           JobStatus = 3
+	JobStatus_JOB_STATUS_FAILED              JobStatus = 4
+	JobStatus_JOB_STATUS_CANCELLED           JobStatus = 5
+	JobStatus_JOB_STATUS_PARTIALLY_SUCCEEDED JobStatus = 6 // Some sessions could not be created
+	JobStatus_JOB_STATUS_QUEUED              JobStatus = 7 // Waiting in its repo queue, see QueueService
+)
+
+// Enum value maps for JobStatus.
+var (
+	JobStatus_name = map[int32]string{
+		0: "JOB_STATUS_UNSPECIFIED",
+		1: "JOB_STATUS_PENDING",
+		2: "JOB_STATUS_PROCESSING",
+		3: "JOB_STATUS_COMPLETED",
+		4: "JOB_STATUS_FAILED",
+		5: "JOB_STATUS_CANCELLED",
+		6: "JOB_STATUS_PARTIALLY_SUCCEEDED",
+		7: "JOB_STATUS_QUEUED",
+	}
+	JobStatus_value = map[string]int32{
+		"JOB_STATUS_UNSPECIFIED":         0,
+		"JOB_STATUS_PENDING":             1,
+		"JOB_STATUS_PROCESSING":          2,
+		"JOB_STATUS_COMPLETED":           3,
+		"JOB_STATUS_FAILED":              4,
+		"JOB_STATUS_CANCELLED":           5,
+		"JOB_STATUS_PARTIALLY_SUCCEEDED": 6,
+		"JOB_STATUS_QUEUED":              7,
+	}
+)
+
+func (x JobStatus) Enum() *JobStatus {
+	p := new(JobStatus)
+	*p = x
+	return p
+}
+
+func (x JobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_jules_proto_enumTypes[3].Descriptor()
+}
+
+func (JobStatus) Type() protoreflect.EnumType {
+	return &file_jules_proto_enumTypes[3]
+}
+
+func (x JobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobStatus.Descriptor instead.
+func (JobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{3}
+}
+
+// CronCatchUp decides what happens to runs missed while the server was down.
+// Stored in the cron_jobs table without the prefix ('SKIP', 'ONCE', 'ALL').
+type CronCatchUp int32
+
+const (
+	CronCatchUp_CRON_CATCH_UP_UNSPECIFIED CronCatchUp = 0 // Same as ONCE
+	CronCatchUp_CRON_CATCH_UP_SKIP        CronCatchUp = 1 // Drop missed runs and wait for the next one
+	CronCatchUp_CRON_CATCH_UP_ONCE        CronCatchUp = 2 // Run once for all missed runs
+	CronCatchUp_CRON_CATCH_UP_ALL         CronCatchUp = 3 // Run every missed run
+)
+
+// Enum value maps for CronCatchUp.
+var (
+	CronCatchUp_name = map[int32]string{
+		0: "CRON_CATCH_UP_UNSPECIFIED",
+		1: "CRON_CATCH_UP_SKIP",
+		2: "CRON_CATCH_UP_ONCE",
+		3: "CRON_CATCH_UP_ALL",
+	}
+	CronCatchUp_value = map[string]int32{
+		"CRON_CATCH_UP_UNSPECIFIED": 0,
+		"CRON_CATCH_UP_SKIP":        1,
+		"CRON_CATCH_UP_ONCE":        2,
+		"CRON_CATCH_UP_ALL":         3,
+	}
+)
+
+func (x CronCatchUp) Enum() *CronCatchUp {
+	p := new(CronCatchUp)
+	*p = x
+	return p
+}
+
+func (x CronCatchUp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CronCatchUp) Descriptor() protoreflect.EnumDescriptor {
+	return file_jules_proto_enumTypes[4].Descriptor()
+}
+
+func (CronCatchUp) Type() protoreflect.EnumType {
+	return &file_jules_proto_enumTypes[4]
+}
+
+func (x CronCatchUp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CronCatchUp.Descriptor instead.
+func (CronCatchUp) EnumDescriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{4}
+}
+
+// TriggerEvent is the GitHub event an event trigger starts jobs for.
+// Stored in the event_triggers table without the prefix ('ISSUE_LABELED', ...).
+type TriggerEvent int32
+
+const (
+	TriggerEvent_TRIGGER_EVENT_UNSPECIFIED   TriggerEvent = 0
+	TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED TriggerEvent = 1 // An open issue carries the trigger's label
+	TriggerEvent_TRIGGER_EVENT_CI_FAILED     TriggerEvent = 2 // Checks failed on the head commit of the trigger's branch
+	TriggerEvent_TRIGGER_EVENT_RELEASE       TriggerEvent = 3 // A release was published
+	TriggerEvent_TRIGGER_EVENT_DEPENDABOT_PR TriggerEvent = 4 // Dependabot opened a PR, the job starts from its head branch
+)
+
+// Enum value maps for TriggerEvent.
+var (
+	TriggerEvent_name = map[int32]string{
+		0: "TRIGGER_EVENT_UNSPECIFIED",
+		1: "TRIGGER_EVENT_ISSUE_LABELED",
+		2: "TRIGGER_EVENT_CI_FAILED",
+		3: "TRIGGER_EVENT_RELEASE",
+		4: "TRIGGER_EVENT_DEPENDABOT_PR",
+	}
+	TriggerEvent_value = map[string]int32{
+		"TRIGGER_EVENT_UNSPECIFIED":   0,
+		"TRIGGER_EVENT_ISSUE_LABELED": 1,
+		"TRIGGER_EVENT_CI_FAILED":     2,
+		"TRIGGER_EVENT_RELEASE":       3,
+		"TRIGGER_EVENT_DEPENDABOT_PR": 4,
+	}
+)
+
+func (x TriggerEvent) Enum() *TriggerEvent {
+	p := new(TriggerEvent)
+	*p = x
+	return p
+}
+
+func (x TriggerEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TriggerEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_jules_proto_enumTypes[5].Descriptor()
+}
+
+func (TriggerEvent) Type() protoreflect.EnumType {
+	return &file_jules_proto_enumTypes[5]
+}
+
+func (x TriggerEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TriggerEvent.Descriptor instead.
+func (TriggerEvent) EnumDescriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{5}
+}
+
+// Stored in the cron_runs table without the prefix ('SCHEDULED', 'MANUAL').
+type CronTrigger int32
+
+const (
+	CronTrigger_CRON_TRIGGER_UNSPECIFIED CronTrigger = 0
+	CronTrigger_CRON_TRIGGER_SCHEDULED   CronTrigger = 1
+	CronTrigger_CRON_TRIGGER_MANUAL      CronTrigger = 2 // ExecuteCronJob
+)
+
+// Enum value maps for CronTrigger.
+var (
+	CronTrigger_name = map[int32]string{
+		0: "CRON_TRIGGER_UNSPECIFIED",
+		1: "CRON_TRIGGER_SCHEDULED",
+		2: "CRON_TRIGGER_MANUAL",
+	}
+	CronTrigger_value = map[string]int32{
+		"CRON_TRIGGER_UNSPECIFIED": 0,
+		"CRON_TRIGGER_SCHEDULED":   1,
+		"CRON_TRIGGER_MANUAL":      2,
+	}
+)
+
+func (x CronTrigger) Enum() *CronTrigger {
+	p := new(CronTrigger)
+	*p = x
+	return p
+}
+
+func (x CronTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CronTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_jules_proto_enumTypes[6].Descriptor()
+}
+
+func (CronTrigger) Type() protoreflect.EnumType {
+	return &file_jules_proto_enumTypes[6]
+}
+
+func (x CronTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CronTrigger.Descriptor instead.
+func (CronTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{6}
+}
+
 type Settings struct {
 	state                               protoimpl.MessageState `protogen:"open.v1"`
 	Id                                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -163,8 +442,28 @@ type Settings struct {
 	AutoMergeMessage           string `protobuf:"bytes,37,opt,name=auto_merge_message,json=autoMergeMessage,proto3" json:"auto_merge_message,omitempty"`
 	AutoCloseOnConflictMessage string `protobuf:"bytes,38,opt,name=auto_close_on_conflict_message,json=autoCloseOnConflictMessage,proto3" json:"auto_close_on_conflict_message,omitempty"`
 	ClosePrOnConflictEnabled   bool   `protobuf:"varint,39,opt,name=close_pr_on_conflict_enabled,json=closePrOnConflictEnabled,proto3" json:"close_pr_on_conflict_enabled,omitempty"`
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+	IssueAutomationEnabled     bool   `protobuf:"varint,40,opt,name=issue_automation_enabled,json=issueAutomationEnabled,proto3" json:"issue_automation_enabled,omitempty"`
+	IssueAutomationLabel       string `protobuf:"bytes,41,opt,name=issue_automation_label,json=issueAutomationLabel,proto3" json:"issue_automation_label,omitempty"` // Default: "jules"
+	IssueAutomationRepos       string `protobuf:"bytes,42,opt,name=issue_automation_repos,json=issueAutomationRepos,proto3" json:"issue_automation_repos,omitempty"` // Comma-separated owner/repo list
+	ChatOpsEnabled             bool   `protobuf:"varint,43,opt,name=chat_ops_enabled,json=chatOpsEnabled,proto3" json:"chat_ops_enabled,omitempty"`                  // Run /jules and /hub commands from PR comments
+	// What to do once a PR got check_failing_actions_threshold failure reports:
+	// "label" (default), "request_reviewer", "close" or "notify"
+	CheckFailingActionsEscalation string `protobuf:"bytes,44,opt,name=check_failing_actions_escalation,json=checkFailingActionsEscalation,proto3" json:"check_failing_actions_escalation,omitempty"`
+	// The label (default "needs-human"), or comma-separated logins to request a review from or notify
+	CheckFailingActionsEscalationTarget string `protobuf:"bytes,45,opt,name=check_failing_actions_escalation_target,json=checkFailingActionsEscalationTarget,proto3" json:"check_failing_actions_escalation_target,omitempty"`
+	FlakyCheckMaxReruns                 int32  `protobuf:"varint,46,opt,name=flaky_check_max_reruns,json=flakyCheckMaxReruns,proto3" json:"flaky_check_max_reruns,omitempty"`                                    // Reruns of a failing check before it is reported. Default: 1
+	InformationalChecks                 string `protobuf:"bytes,47,opt,name=informational_checks,json=informationalChecks,proto3" json:"informational_checks,omitempty"`                                         // Lines of "owner/repo: check, check" (or "*: check") whose results don't gate PRs
+	AutoMergeScope                      string `protobuf:"bytes,48,opt,name=auto_merge_scope,json=autoMergeScope,proto3" json:"auto_merge_scope,omitempty"`                                                      // "all" (default), "sessions" or "labeled"
+	AutoMergeLabel                      string `protobuf:"bytes,49,opt,name=auto_merge_label,json=autoMergeLabel,proto3" json:"auto_merge_label,omitempty"`                                                      // Label that opts PRs into auto-merge. Default: "automerge"
+	AutoMergeRequiredApprovals          int32  `protobuf:"varint,50,opt,name=auto_merge_required_approvals,json=autoMergeRequiredApprovals,proto3" json:"auto_merge_required_approvals,omitempty"`               // 0 for none
+	AutoMergeBlockChangesRequested      bool   `protobuf:"varint,51,opt,name=auto_merge_block_changes_requested,json=autoMergeBlockChangesRequested,proto3" json:"auto_merge_block_changes_requested,omitempty"` // Default: true
+	AutoMergeMaxAdditions               int32  `protobuf:"varint,52,opt,name=auto_merge_max_additions,json=autoMergeMaxAdditions,proto3" json:"auto_merge_max_additions,omitempty"`                              // 0 for no limit
+	AutoMergeMaxDeletions               int32  `protobuf:"varint,53,opt,name=auto_merge_max_deletions,json=autoMergeMaxDeletions,proto3" json:"auto_merge_max_deletions,omitempty"`                              // 0 for no limit
+	AutoMergeMaxFiles                   int32  `protobuf:"varint,54,opt,name=auto_merge_max_files,json=autoMergeMaxFiles,proto3" json:"auto_merge_max_files,omitempty"`                                          // 0 for no limit
+	AutoMergeProtectedPaths             string `protobuf:"bytes,55,opt,name=auto_merge_protected_paths,json=autoMergeProtectedPaths,proto3" json:"auto_merge_protected_paths,omitempty"`                         // Comma or newline separated globs PRs must not touch
+	AutoMergeRequireBaseGreen           bool   `protobuf:"varint,56,opt,name=auto_merge_require_base_green,json=autoMergeRequireBaseGreen,proto3" json:"auto_merge_require_base_green,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
 }
 
 func (x *Settings) Reset() {
@@ -470,6 +769,125 @@ func (x *Settings) GetClosePrOnConflictEnabled() bool {
 	return false
 }
 
+func (x *Settings) GetIssueAutomationEnabled() bool {
+	if x != nil {
+		return x.IssueAutomationEnabled
+	}
+	return false
+}
+
+func (x *Settings) GetIssueAutomationLabel() string {
+	if x != nil {
+		return x.IssueAutomationLabel
+	}
+	return ""
+}
+
+func (x *Settings) GetIssueAutomationRepos() string {
+	if x != nil {
+		return x.IssueAutomationRepos
+	}
+	return ""
+}
+
+func (x *Settings) GetChatOpsEnabled() bool {
+	if x != nil {
+		return x.ChatOpsEnabled
+	}
+	return false
+}
+
+func (x *Settings) GetCheckFailingActionsEscalation() string {
+	if x != nil {
+		return x.CheckFailingActionsEscalation
+	}
+	return ""
+}
+
+func (x *Settings) GetCheckFailingActionsEscalationTarget() string {
+	if x != nil {
+		return x.CheckFailingActionsEscalationTarget
+	}
+	return ""
+}
+
+func (x *Settings) GetFlakyCheckMaxReruns() int32 {
+	if x != nil {
+		return x.FlakyCheckMaxReruns
+	}
+	return 0
+}
+
+func (x *Settings) GetInformationalChecks() string {
+	if x != nil {
+		return x.InformationalChecks
+	}
+	return ""
+}
+
+func (x *Settings) GetAutoMergeScope() string {
+	if x != nil {
+		return x.AutoMergeScope
+	}
+	return ""
+}
+
+func (x *Settings) GetAutoMergeLabel() string {
+	if x != nil {
+		return x.AutoMergeLabel
+	}
+	return ""
+}
+
+func (x *Settings) GetAutoMergeRequiredApprovals() int32 {
+	if x != nil {
+		return x.AutoMergeRequiredApprovals
+	}
+	return 0
+}
+
+func (x *Settings) GetAutoMergeBlockChangesRequested() bool {
+	if x != nil {
+		return x.AutoMergeBlockChangesRequested
+	}
+	return false
+}
+
+func (x *Settings) GetAutoMergeMaxAdditions() int32 {
+	if x != nil {
+		return x.AutoMergeMaxAdditions
+	}
+	return 0
+}
+
+func (x *Settings) GetAutoMergeMaxDeletions() int32 {
+	if x != nil {
+		return x.AutoMergeMaxDeletions
+	}
+	return 0
+}
+
+func (x *Settings) GetAutoMergeMaxFiles() int32 {
+	if x != nil {
+		return x.AutoMergeMaxFiles
+	}
+	return 0
+}
+
+func (x *Settings) GetAutoMergeProtectedPaths() string {
+	if x != nil {
+		return x.AutoMergeProtectedPaths
+	}
+	return ""
+}
+
+func (x *Settings) GetAutoMergeRequireBaseGreen() bool {
+	if x != nil {
+		return x.AutoMergeRequireBaseGreen
+	}
+	return false
+}
+
 type GetSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProfileId     string                 `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
@@ -959,6 +1377,12 @@ type CronJob struct {
 	CreatedAt           string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt           string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	LastRunAt           string                 `protobuf:"bytes,15,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	Matrix              *JobMatrix             `protobuf:"bytes,16,opt,name=matrix,proto3" json:"matrix,omitempty"`                     // Targets of each triggered job, repo/branch are used when unset
+	Priority            int32                  `protobuf:"varint,17,opt,name=priority,proto3" json:"priority,omitempty"`                // Priority of each triggered job
+	TimeZone            string                 `protobuf:"bytes,18,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA name the schedule is evaluated in, server local time when empty
+	CatchUp             CronCatchUp            `protobuf:"varint,19,opt,name=catch_up,json=catchUp,proto3,enum=jules.CronCatchUp" json:"catch_up,omitempty"`
+	JitterSeconds       int32                  `protobuf:"varint,20,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`   // Each run is delayed by up to this many seconds
+	SkipIfRunning       bool                   `protobuf:"varint,21,opt,name=skip_if_running,json=skipIfRunning,proto3" json:"skip_if_running,omitempty"` // Skip a run while the previous job or its sessions are still active
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -1098,6 +1522,48 @@ func (x *CronJob) GetLastRunAt() string {
 	return ""
 }
 
+func (x *CronJob) GetMatrix() *JobMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *CronJob) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CronJob) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CronJob) GetCatchUp() CronCatchUp {
+	if x != nil {
+		return x.CatchUp
+	}
+	return CronCatchUp_CRON_CATCH_UP_UNSPECIFIED
+}
+
+func (x *CronJob) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *CronJob) GetSkipIfRunning() bool {
+	if x != nil {
+		return x.SkipIfRunning
+	}
+	return false
+}
+
 type ListCronJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CronJobs      []*CronJob             `protobuf:"bytes,1,rep,name=cron_jobs,json=cronJobs,proto3" json:"cron_jobs,omitempty"`
@@ -1154,6 +1620,13 @@ type CreateCronJobRequest struct {
 	RequirePlanApproval bool                   `protobuf:"varint,8,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
 	SessionCount        int32                  `protobuf:"varint,9,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
 	ProfileId           string                 `protobuf:"bytes,10,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Id                  string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"` // Optional, generated if empty
+	Matrix              *JobMatrix             `protobuf:"bytes,12,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	Priority            int32                  `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
+	TimeZone            string                 `protobuf:"bytes,14,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	CatchUp             CronCatchUp            `protobuf:"varint,15,opt,name=catch_up,json=catchUp,proto3,enum=jules.CronCatchUp" json:"catch_up,omitempty"`
+	JitterSeconds       int32                  `protobuf:"varint,16,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	SkipIfRunning       bool                   `protobuf:"varint,17,opt,name=skip_if_running,json=skipIfRunning,proto3" json:"skip_if_running,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -1258,22 +1731,77 @@ func (x *CreateCronJobRequest) GetProfileId() string {
 	return ""
 }
 
-type UpdateCronJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Using specific fields for partial updates or a mask would be better,
-	// but for simplicity mirroring the TS partial logic, we'll make fields optional/nullable
-	Name                *string         `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Schedule            *string         `protobuf:"bytes,3,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`
-	Prompt              *string         `protobuf:"bytes,4,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
-	Repo                *string         `protobuf:"bytes,5,opt,name=repo,proto3,oneof" json:"repo,omitempty"`
-	Branch              *string         `protobuf:"bytes,6,opt,name=branch,proto3,oneof" json:"branch,omitempty"`
-	AutoApproval        *bool           `protobuf:"varint,7,opt,name=auto_approval,json=autoApproval,proto3,oneof" json:"auto_approval,omitempty"`
-	AutomationMode      *AutomationMode `protobuf:"varint,8,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode,oneof" json:"automation_mode,omitempty"`
-	RequirePlanApproval *bool           `protobuf:"varint,9,opt,name=require_plan_approval,json=requirePlanApproval,proto3,oneof" json:"require_plan_approval,omitempty"`
-	SessionCount        *int32          `protobuf:"varint,10,opt,name=session_count,json=sessionCount,proto3,oneof" json:"session_count,omitempty"`
-	Enabled             *bool           `protobuf:"varint,11,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
-	unknownFields       protoimpl.UnknownFields
+func (x *CreateCronJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetMatrix() *JobMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *CreateCronJobRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateCronJobRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetCatchUp() CronCatchUp {
+	if x != nil {
+		return x.CatchUp
+	}
+	return CronCatchUp_CRON_CATCH_UP_UNSPECIFIED
+}
+
+func (x *CreateCronJobRequest) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *CreateCronJobRequest) GetSkipIfRunning() bool {
+	if x != nil {
+		return x.SkipIfRunning
+	}
+	return false
+}
+
+type UpdateCronJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Using specific fields for partial updates or a mask would be better,
+	// but for simplicity mirroring the TS partial logic, we'll make fields optional/nullable
+	Name                *string         `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Schedule            *string         `protobuf:"bytes,3,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`
+	Prompt              *string         `protobuf:"bytes,4,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	Repo                *string         `protobuf:"bytes,5,opt,name=repo,proto3,oneof" json:"repo,omitempty"`
+	Branch              *string         `protobuf:"bytes,6,opt,name=branch,proto3,oneof" json:"branch,omitempty"`
+	AutoApproval        *bool           `protobuf:"varint,7,opt,name=auto_approval,json=autoApproval,proto3,oneof" json:"auto_approval,omitempty"`
+	AutomationMode      *AutomationMode `protobuf:"varint,8,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode,oneof" json:"automation_mode,omitempty"`
+	RequirePlanApproval *bool           `protobuf:"varint,9,opt,name=require_plan_approval,json=requirePlanApproval,proto3,oneof" json:"require_plan_approval,omitempty"`
+	SessionCount        *int32          `protobuf:"varint,10,opt,name=session_count,json=sessionCount,proto3,oneof" json:"session_count,omitempty"`
+	Enabled             *bool           `protobuf:"varint,11,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Matrix              *JobMatrix      `protobuf:"bytes,12,opt,name=matrix,proto3" json:"matrix,omitempty"` // Replaces the matrix when set, an empty matrix removes it
+	Priority            *int32          `protobuf:"varint,13,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	TimeZone            *string         `protobuf:"bytes,14,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	CatchUp             *CronCatchUp    `protobuf:"varint,15,opt,name=catch_up,json=catchUp,proto3,enum=jules.CronCatchUp,oneof" json:"catch_up,omitempty"`
+	JitterSeconds       *int32          `protobuf:"varint,16,opt,name=jitter_seconds,json=jitterSeconds,proto3,oneof" json:"jitter_seconds,omitempty"`
+	SkipIfRunning       *bool           `protobuf:"varint,17,opt,name=skip_if_running,json=skipIfRunning,proto3,oneof" json:"skip_if_running,omitempty"`
+	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
@@ -1384,6 +1912,48 @@ func (x *UpdateCronJobRequest) GetEnabled() bool {
 	return false
 }
 
+func (x *UpdateCronJobRequest) GetMatrix() *JobMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *UpdateCronJobRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateCronJobRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *UpdateCronJobRequest) GetCatchUp() CronCatchUp {
+	if x != nil && x.CatchUp != nil {
+		return *x.CatchUp
+	}
+	return CronCatchUp_CRON_CATCH_UP_UNSPECIFIED
+}
+
+func (x *UpdateCronJobRequest) GetJitterSeconds() int32 {
+	if x != nil && x.JitterSeconds != nil {
+		return *x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *UpdateCronJobRequest) GetSkipIfRunning() bool {
+	if x != nil && x.SkipIfRunning != nil {
+		return *x.SkipIfRunning
+	}
+	return false
+}
+
 type DeleteCronJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1472,28 +2042,38 @@ func (x *ExecuteCronJobRequest) GetId() string {
 	return ""
 }
 
-type ToggleCronJobRequest struct {
+// CronRun records one trigger of a cron job and what it produced.
+type CronRun struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CronJobId     string                 `protobuf:"bytes,2,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
+	Trigger       CronTrigger            `protobuf:"varint,3,opt,name=trigger,proto3,enum=jules.CronTrigger" json:"trigger,omitempty"`
+	ScheduledAt   string                 `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // Fire time of the schedule, the trigger time for manual runs
+	TriggeredAt   string                 `protobuf:"bytes,5,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`   // 'STARTED', 'SKIPPED' or 'FAILED'
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"` // Why the run was skipped or failed
+	JobId         string                 `protobuf:"bytes,8,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	JobStatus     JobStatus              `protobuf:"varint,9,opt,name=job_status,json=jobStatus,proto3,enum=jules.JobStatus" json:"job_status,omitempty"` // Current status of the created job
+	PrUrls        []string               `protobuf:"bytes,10,rep,name=pr_urls,json=prUrls,proto3" json:"pr_urls,omitempty"`                               // PRs opened by the job's sessions
+	PrsMerged     int32                  `protobuf:"varint,11,opt,name=prs_merged,json=prsMerged,proto3" json:"prs_merged,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleCronJobRequest) Reset() {
-	*x = ToggleCronJobRequest{}
+func (x *CronRun) Reset() {
+	*x = CronRun{}
 	mi := &file_jules_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleCronJobRequest) String() string {
+func (x *CronRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleCronJobRequest) ProtoMessage() {}
+func (*CronRun) ProtoMessage() {}
 
-func (x *ToggleCronJobRequest) ProtoReflect() protoreflect.Message {
+func (x *CronRun) ProtoReflect() protoreflect.Message {
 	mi := &file_jules_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1505,210 +2085,161 @@ func (x *ToggleCronJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleCronJobRequest.ProtoReflect.Descriptor instead.
-func (*ToggleCronJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CronRun.ProtoReflect.Descriptor instead.
+func (*CronRun) Descriptor() ([]byte, []int) {
 	return file_jules_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ToggleCronJobRequest) GetId() string {
+func (x *CronRun) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ToggleCronJobRequest) GetEnabled() bool {
+func (x *CronRun) GetCronJobId() string {
 	if x != nil {
-		return x.Enabled
+		return x.CronJobId
 	}
-	return false
-}
-
-type Job struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	SessionIds          []string               `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
-	CreatedAt           string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Repo                string                 `protobuf:"bytes,5,opt,name=repo,proto3" json:"repo,omitempty"`
-	Branch              string                 `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
-	AutoApproval        bool                   `protobuf:"varint,7,opt,name=auto_approval,json=autoApproval,proto3" json:"auto_approval,omitempty"`
-	Background          bool                   `protobuf:"varint,8,opt,name=background,proto3" json:"background,omitempty"`
-	Prompt              string                 `protobuf:"bytes,9,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	SessionCount        int32                  `protobuf:"varint,10,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
-	Status              string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // 'PENDING', 'PROCESSING', 'COMPLETED'
-	AutomationMode      AutomationMode         `protobuf:"varint,12,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode" json:"automation_mode,omitempty"`
-	RequirePlanApproval bool                   `protobuf:"varint,13,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
-	CronJobId           string                 `protobuf:"bytes,14,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
-	ProfileId           string                 `protobuf:"bytes,15,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	ChatEnabled         bool                   `protobuf:"varint,16,opt,name=chat_enabled,json=chatEnabled,proto3" json:"chat_enabled,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
-}
-
-func (x *Job) Reset() {
-	*x = Job{}
-	mi := &file_jules_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Job) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*Job) ProtoMessage() {}
-
-func (x *Job) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[18]
+func (x *CronRun) GetTrigger() CronTrigger {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Trigger
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Job.ProtoReflect.Descriptor instead.
-func (*Job) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{18}
+	return CronTrigger_CRON_TRIGGER_UNSPECIFIED
 }
 
-func (x *Job) GetId() string {
+func (x *CronRun) GetScheduledAt() string {
 	if x != nil {
-		return x.Id
+		return x.ScheduledAt
 	}
 	return ""
 }
 
-func (x *Job) GetName() string {
+func (x *CronRun) GetTriggeredAt() string {
 	if x != nil {
-		return x.Name
+		return x.TriggeredAt
 	}
 	return ""
 }
 
-func (x *Job) GetSessionIds() []string {
-	if x != nil {
-		return x.SessionIds
-	}
-	return nil
-}
-
-func (x *Job) GetCreatedAt() string {
+func (x *CronRun) GetStatus() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Job) GetRepo() string {
+func (x *CronRun) GetMessage() string {
 	if x != nil {
-		return x.Repo
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Job) GetBranch() string {
+func (x *CronRun) GetJobId() string {
 	if x != nil {
-		return x.Branch
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *Job) GetAutoApproval() bool {
+func (x *CronRun) GetJobStatus() JobStatus {
 	if x != nil {
-		return x.AutoApproval
+		return x.JobStatus
 	}
-	return false
+	return JobStatus_JOB_STATUS_UNSPECIFIED
 }
 
-func (x *Job) GetBackground() bool {
+func (x *CronRun) GetPrUrls() []string {
 	if x != nil {
-		return x.Background
+		return x.PrUrls
 	}
-	return false
+	return nil
 }
 
-func (x *Job) GetPrompt() string {
+func (x *CronRun) GetPrsMerged() int32 {
 	if x != nil {
-		return x.Prompt
+		return x.PrsMerged
 	}
-	return ""
+	return 0
 }
 
-func (x *Job) GetSessionCount() int32 {
-	if x != nil {
-		return x.SessionCount
-	}
-	return 0
+type ListCronRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronJobId     string                 `protobuf:"bytes,1,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"` // All cron jobs when empty
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                           // Defaults to 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Job) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *ListCronRunsRequest) Reset() {
+	*x = ListCronRunsRequest{}
+	mi := &file_jules_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Job) GetAutomationMode() AutomationMode {
-	if x != nil {
-		return x.AutomationMode
-	}
-	return AutomationMode_AUTOMATION_MODE_UNSPECIFIED
+func (x *ListCronRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Job) GetRequirePlanApproval() bool {
+func (*ListCronRunsRequest) ProtoMessage() {}
+
+func (x *ListCronRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[18]
 	if x != nil {
-		return x.RequirePlanApproval
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Job) GetCronJobId() string {
-	if x != nil {
-		return x.CronJobId
-	}
-	return ""
+// Deprecated: Use ListCronRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListCronRunsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Job) GetProfileId() string {
+func (x *ListCronRunsRequest) GetCronJobId() string {
 	if x != nil {
-		return x.ProfileId
+		return x.CronJobId
 	}
 	return ""
 }
 
-func (x *Job) GetChatEnabled() bool {
+func (x *ListCronRunsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ChatEnabled
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-type ListJobsResponse struct {
+type ListCronRunsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Runs          []*CronRun             `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJobsResponse) Reset() {
-	*x = ListJobsResponse{}
+func (x *ListCronRunsResponse) Reset() {
+	*x = ListCronRunsResponse{}
 	mi := &file_jules_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJobsResponse) String() string {
+func (x *ListCronRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobsResponse) ProtoMessage() {}
+func (*ListCronRunsResponse) ProtoMessage() {}
 
-func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListCronRunsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jules_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1720,39 +2251,42 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCronRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListCronRunsResponse) Descriptor() ([]byte, []int) {
 	return file_jules_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListJobsResponse) GetJobs() []*Job {
+func (x *ListCronRunsResponse) GetRuns() []*CronRun {
 	if x != nil {
-		return x.Jobs
+		return x.Runs
 	}
 	return nil
 }
 
-type GetJobRequest struct {
+type PreviewCronScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schedule      string                 `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // Defaults to 5, at most 100
+	After         string                 `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`  // RFC3339, now when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJobRequest) Reset() {
-	*x = GetJobRequest{}
+func (x *PreviewCronScheduleRequest) Reset() {
+	*x = PreviewCronScheduleRequest{}
 	mi := &file_jules_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJobRequest) String() string {
+func (x *PreviewCronScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobRequest) ProtoMessage() {}
+func (*PreviewCronScheduleRequest) ProtoMessage() {}
 
-func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+func (x *PreviewCronScheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jules_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1764,54 +2298,60 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
-func (*GetJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewCronScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCronScheduleRequest) Descriptor() ([]byte, []int) {
 	return file_jules_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetJobRequest) GetId() string {
+func (x *PreviewCronScheduleRequest) GetSchedule() string {
 	if x != nil {
-		return x.Id
+		return x.Schedule
 	}
 	return ""
 }
 
-type CreateJobRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	SessionIds          []string               `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
-	CreatedAt           string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Repo                string                 `protobuf:"bytes,5,opt,name=repo,proto3" json:"repo,omitempty"`
-	Branch              string                 `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
-	AutoApproval        bool                   `protobuf:"varint,7,opt,name=auto_approval,json=autoApproval,proto3" json:"auto_approval,omitempty"`
-	Background          bool                   `protobuf:"varint,8,opt,name=background,proto3" json:"background,omitempty"`
-	Prompt              string                 `protobuf:"bytes,9,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	SessionCount        int32                  `protobuf:"varint,10,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
-	Status              string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
-	AutomationMode      AutomationMode         `protobuf:"varint,12,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode" json:"automation_mode,omitempty"`
-	RequirePlanApproval bool                   `protobuf:"varint,13,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
-	CronJobId           string                 `protobuf:"bytes,14,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
-	ProfileId           string                 `protobuf:"bytes,15,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	ChatEnabled         bool                   `protobuf:"varint,16,opt,name=chat_enabled,json=chatEnabled,proto3" json:"chat_enabled,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *PreviewCronScheduleRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
 }
 
-func (x *CreateJobRequest) Reset() {
-	*x = CreateJobRequest{}
+func (x *PreviewCronScheduleRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PreviewCronScheduleRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type PreviewCronScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Times         []string               `protobuf:"bytes,1,rep,name=times,proto3" json:"times,omitempty"` // RFC3339 in the schedule's time zone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCronScheduleResponse) Reset() {
+	*x = PreviewCronScheduleResponse{}
 	mi := &file_jules_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateJobRequest) String() string {
+func (x *PreviewCronScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJobRequest) ProtoMessage() {}
+func (*PreviewCronScheduleResponse) ProtoMessage() {}
 
-func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
+func (x *PreviewCronScheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jules_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1823,145 +2363,305 @@ func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
-func (*CreateJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewCronScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCronScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_jules_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateJobRequest) GetId() string {
+func (x *PreviewCronScheduleResponse) GetTimes() []string {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+type ToggleCronJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleCronJobRequest) Reset() {
+	*x = ToggleCronJobRequest{}
+	mi := &file_jules_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleCronJobRequest) ProtoMessage() {}
+
+func (x *ToggleCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleCronJobRequest.ProtoReflect.Descriptor instead.
+func (*ToggleCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ToggleCronJobRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetName() string {
+func (x *ToggleCronJobRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type Job struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SessionIds          []string               `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Repo                string                 `protobuf:"bytes,5,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch              string                 `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
+	AutoApproval        bool                   `protobuf:"varint,7,opt,name=auto_approval,json=autoApproval,proto3" json:"auto_approval,omitempty"`
+	Background          bool                   `protobuf:"varint,8,opt,name=background,proto3" json:"background,omitempty"`
+	Prompt              string                 `protobuf:"bytes,9,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	SessionCount        int32                  `protobuf:"varint,10,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	Status              string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // 'QUEUED', 'PENDING', 'PROCESSING', 'COMPLETED', 'PARTIALLY_SUCCEEDED', 'FAILED', 'CANCELLED'
+	AutomationMode      AutomationMode         `protobuf:"varint,12,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode" json:"automation_mode,omitempty"`
+	RequirePlanApproval bool                   `protobuf:"varint,13,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
+	CronJobId           string                 `protobuf:"bytes,14,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
+	ProfileId           string                 `protobuf:"bytes,15,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	ChatEnabled         bool                   `protobuf:"varint,16,opt,name=chat_enabled,json=chatEnabled,proto3" json:"chat_enabled,omitempty"`
+	State               JobStatus              `protobuf:"varint,17,opt,name=state,proto3,enum=jules.JobStatus" json:"state,omitempty"`                   // Typed form of status
+	SessionSlots        []*JobSessionSlot      `protobuf:"bytes,18,rep,name=session_slots,json=sessionSlots,proto3" json:"session_slots,omitempty"`       // Per-session creation status, only set by GetJob
+	ParentJobId         string                 `protobuf:"bytes,19,opt,name=parent_job_id,json=parentJobId,proto3" json:"parent_job_id,omitempty"`        // Job this one is a rerun of
+	Matrix              *JobMatrix             `protobuf:"bytes,20,opt,name=matrix,proto3" json:"matrix,omitempty"`                                       // Repo/branch targets, repo/branch are used when unset
+	TargetProgress      []*JobTargetProgress   `protobuf:"bytes,21,rep,name=target_progress,json=targetProgress,proto3" json:"target_progress,omitempty"` // Aggregated per matrix target, only set by GetJob
+	Priority            int32                  `protobuf:"varint,22,opt,name=priority,proto3" json:"priority,omitempty"`                                  // Higher runs first
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_jules_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetSessionIds() []string {
+func (x *Job) GetSessionIds() []string {
 	if x != nil {
 		return x.SessionIds
 	}
 	return nil
 }
 
-func (x *CreateJobRequest) GetCreatedAt() string {
+func (x *Job) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetRepo() string {
+func (x *Job) GetRepo() string {
 	if x != nil {
 		return x.Repo
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetBranch() string {
+func (x *Job) GetBranch() string {
 	if x != nil {
 		return x.Branch
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetAutoApproval() bool {
+func (x *Job) GetAutoApproval() bool {
 	if x != nil {
 		return x.AutoApproval
 	}
 	return false
 }
 
-func (x *CreateJobRequest) GetBackground() bool {
+func (x *Job) GetBackground() bool {
 	if x != nil {
 		return x.Background
 	}
 	return false
 }
 
-func (x *CreateJobRequest) GetPrompt() string {
+func (x *Job) GetPrompt() string {
 	if x != nil {
 		return x.Prompt
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetSessionCount() int32 {
+func (x *Job) GetSessionCount() int32 {
 	if x != nil {
 		return x.SessionCount
 	}
 	return 0
 }
 
-func (x *CreateJobRequest) GetStatus() string {
+func (x *Job) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetAutomationMode() AutomationMode {
+func (x *Job) GetAutomationMode() AutomationMode {
 	if x != nil {
 		return x.AutomationMode
 	}
 	return AutomationMode_AUTOMATION_MODE_UNSPECIFIED
 }
 
-func (x *CreateJobRequest) GetRequirePlanApproval() bool {
+func (x *Job) GetRequirePlanApproval() bool {
 	if x != nil {
 		return x.RequirePlanApproval
 	}
 	return false
 }
 
-func (x *CreateJobRequest) GetCronJobId() string {
+func (x *Job) GetCronJobId() string {
 	if x != nil {
 		return x.CronJobId
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetProfileId() string {
+func (x *Job) GetProfileId() string {
 	if x != nil {
 		return x.ProfileId
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetChatEnabled() bool {
+func (x *Job) GetChatEnabled() bool {
 	if x != nil {
 		return x.ChatEnabled
 	}
 	return false
 }
 
-type CreateManyJobsRequest struct {
+func (x *Job) GetState() JobStatus {
+	if x != nil {
+		return x.State
+	}
+	return JobStatus_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *Job) GetSessionSlots() []*JobSessionSlot {
+	if x != nil {
+		return x.SessionSlots
+	}
+	return nil
+}
+
+func (x *Job) GetParentJobId() string {
+	if x != nil {
+		return x.ParentJobId
+	}
+	return ""
+}
+
+func (x *Job) GetMatrix() *JobMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *Job) GetTargetProgress() []*JobTargetProgress {
+	if x != nil {
+		return x.TargetProgress
+	}
+	return nil
+}
+
+func (x *Job) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// JobMatrix fans a job out over several repo/branch targets.
+type JobMatrix struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jobs          []*CreateJobRequest    `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Targets       []*JobTarget           `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateManyJobsRequest) Reset() {
-	*x = CreateManyJobsRequest{}
-	mi := &file_jules_proto_msgTypes[22]
+func (x *JobMatrix) Reset() {
+	*x = JobMatrix{}
+	mi := &file_jules_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateManyJobsRequest) String() string {
+func (x *JobMatrix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateManyJobsRequest) ProtoMessage() {}
+func (*JobMatrix) ProtoMessage() {}
 
-func (x *CreateManyJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[22]
+func (x *JobMatrix) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,44 +2672,43 @@ func (x *CreateManyJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateManyJobsRequest.ProtoReflect.Descriptor instead.
-func (*CreateManyJobsRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use JobMatrix.ProtoReflect.Descriptor instead.
+func (*JobMatrix) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateManyJobsRequest) GetJobs() []*CreateJobRequest {
+func (x *JobMatrix) GetTargets() []*JobTarget {
 	if x != nil {
-		return x.Jobs
+		return x.Targets
 	}
 	return nil
 }
 
-type UpdateJobRequest struct {
+type JobTarget struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	Repo          *string                `protobuf:"bytes,4,opt,name=repo,proto3,oneof" json:"repo,omitempty"`
-	Branch        *string                `protobuf:"bytes,5,opt,name=branch,proto3,oneof" json:"branch,omitempty"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch        string                 `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Vars          map[string]string      `protobuf:"bytes,3,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Substituted for {{name}} in the prompt, along with {{repo}} and {{branch}}
+	SessionCount  int32                  `protobuf:"varint,4,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`                                      // Overrides the job's session_count when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJobRequest) Reset() {
-	*x = UpdateJobRequest{}
-	mi := &file_jules_proto_msgTypes[23]
+func (x *JobTarget) Reset() {
+	*x = JobTarget{}
+	mi := &file_jules_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJobRequest) String() string {
+func (x *JobTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobRequest) ProtoMessage() {}
+func (*JobTarget) ProtoMessage() {}
 
-func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[23]
+func (x *JobTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,68 +2719,3436 @@ func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJobRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use JobTarget.ProtoReflect.Descriptor instead.
+func (*JobTarget) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateJobRequest) GetId() string {
+func (x *JobTarget) GetRepo() string {
 	if x != nil {
-		return x.Id
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *JobTarget) GetBranch() string {
+	if x != nil {
+		return x.Branch
 	}
 	return ""
 }
 
-func (x *UpdateJobRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *JobTarget) GetVars() map[string]string {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *JobTarget) GetSessionCount() int32 {
+	if x != nil {
+		return x.SessionCount
+	}
+	return 0
+}
+
+type JobTargetProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetIndex   int32                  `protobuf:"varint,1,opt,name=target_index,json=targetIndex,proto3" json:"target_index,omitempty"`
+	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch        string                 `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Created       int32                  `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	Failed        int32                  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Pending       int32                  `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"`
+	SessionIds    []string               `protobuf:"bytes,8,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobTargetProgress) Reset() {
+	*x = JobTargetProgress{}
+	mi := &file_jules_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTargetProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTargetProgress) ProtoMessage() {}
+
+func (x *JobTargetProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTargetProgress.ProtoReflect.Descriptor instead.
+func (*JobTargetProgress) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JobTargetProgress) GetTargetIndex() int32 {
+	if x != nil {
+		return x.TargetIndex
+	}
+	return 0
+}
+
+func (x *JobTargetProgress) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *JobTargetProgress) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *JobTargetProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *JobTargetProgress) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *JobTargetProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *JobTargetProgress) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *JobTargetProgress) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+// JobSessionSlot tracks the creation of one of the sessions requested by a job.
+type JobSessionSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotIndex     int32                  `protobuf:"varint,1,opt,name=slot_index,json=slotIndex,proto3" json:"slot_index,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // 'PENDING', 'CREATING', 'CREATED', 'FAILED'
+	Attempts      int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TargetIndex   int32                  `protobuf:"varint,7,opt,name=target_index,json=targetIndex,proto3" json:"target_index,omitempty"` // Index into the job's matrix targets
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobSessionSlot) Reset() {
+	*x = JobSessionSlot{}
+	mi := &file_jules_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobSessionSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSessionSlot) ProtoMessage() {}
+
+func (x *JobSessionSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSessionSlot.ProtoReflect.Descriptor instead.
+func (*JobSessionSlot) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *JobSessionSlot) GetSlotIndex() int32 {
+	if x != nil {
+		return x.SlotIndex
+	}
+	return 0
+}
+
+func (x *JobSessionSlot) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *JobSessionSlot) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobSessionSlot) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *JobSessionSlot) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *JobSessionSlot) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *JobSessionSlot) GetTargetIndex() int32 {
+	if x != nil {
+		return x.TargetIndex
+	}
+	return 0
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_jules_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_jules_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateJobRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SessionIds          []string               `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Repo                string                 `protobuf:"bytes,5,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch              string                 `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
+	AutoApproval        bool                   `protobuf:"varint,7,opt,name=auto_approval,json=autoApproval,proto3" json:"auto_approval,omitempty"`
+	Background          bool                   `protobuf:"varint,8,opt,name=background,proto3" json:"background,omitempty"`
+	Prompt              string                 `protobuf:"bytes,9,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	SessionCount        int32                  `protobuf:"varint,10,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	Status              string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	AutomationMode      AutomationMode         `protobuf:"varint,12,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode" json:"automation_mode,omitempty"`
+	RequirePlanApproval bool                   `protobuf:"varint,13,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
+	CronJobId           string                 `protobuf:"bytes,14,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
+	ProfileId           string                 `protobuf:"bytes,15,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	ChatEnabled         bool                   `protobuf:"varint,16,opt,name=chat_enabled,json=chatEnabled,proto3" json:"chat_enabled,omitempty"`
+	ParentJobId         string                 `protobuf:"bytes,17,opt,name=parent_job_id,json=parentJobId,proto3" json:"parent_job_id,omitempty"`
+	Matrix              *JobMatrix             `protobuf:"bytes,18,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	Priority            int32                  `protobuf:"varint,19,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateJobRequest) Reset() {
+	*x = CreateJobRequest{}
+	mi := &file_jules_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobRequest) ProtoMessage() {}
+
+func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+func (x *CreateJobRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetAutoApproval() bool {
+	if x != nil {
+		return x.AutoApproval
+	}
+	return false
+}
+
+func (x *CreateJobRequest) GetBackground() bool {
+	if x != nil {
+		return x.Background
+	}
+	return false
+}
+
+func (x *CreateJobRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetSessionCount() int32 {
+	if x != nil {
+		return x.SessionCount
+	}
+	return 0
+}
+
+func (x *CreateJobRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetAutomationMode() AutomationMode {
+	if x != nil {
+		return x.AutomationMode
+	}
+	return AutomationMode_AUTOMATION_MODE_UNSPECIFIED
+}
+
+func (x *CreateJobRequest) GetRequirePlanApproval() bool {
+	if x != nil {
+		return x.RequirePlanApproval
+	}
+	return false
+}
+
+func (x *CreateJobRequest) GetCronJobId() string {
+	if x != nil {
+		return x.CronJobId
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetChatEnabled() bool {
+	if x != nil {
+		return x.ChatEnabled
+	}
+	return false
+}
+
+func (x *CreateJobRequest) GetParentJobId() string {
+	if x != nil {
+		return x.ParentJobId
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetMatrix() *JobMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *CreateJobRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type CreateManyJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*CreateJobRequest    `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManyJobsRequest) Reset() {
+	*x = CreateManyJobsRequest{}
+	mi := &file_jules_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManyJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManyJobsRequest) ProtoMessage() {}
+
+func (x *CreateManyJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManyJobsRequest.ProtoReflect.Descriptor instead.
+func (*CreateManyJobsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateManyJobsRequest) GetJobs() []*CreateJobRequest {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type UpdateJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Repo          *string                `protobuf:"bytes,4,opt,name=repo,proto3,oneof" json:"repo,omitempty"`
+	Branch        *string                `protobuf:"bytes,5,opt,name=branch,proto3,oneof" json:"branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJobRequest) Reset() {
+	*x = UpdateJobRequest{}
+	mi := &file_jules_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobRequest) ProtoMessage() {}
+
+func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateJobRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateJobRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateJobRequest) GetRepo() string {
+	if x != nil && x.Repo != nil {
+		return *x.Repo
+	}
+	return ""
+}
+
+func (x *UpdateJobRequest) GetBranch() string {
+	if x != nil && x.Branch != nil {
+		return *x.Branch
+	}
+	return ""
+}
+
+type DeleteJobRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeleteRemoteSessions bool                   `protobuf:"varint,2,opt,name=delete_remote_sessions,json=deleteRemoteSessions,proto3" json:"delete_remote_sessions,omitempty"` // Also delete the job's sessions from the Jules API
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
+	mi := &file_jules_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobRequest) ProtoMessage() {}
+
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteJobRequest) GetDeleteRemoteSessions() bool {
+	if x != nil {
+		return x.DeleteRemoteSessions
+	}
+	return false
+}
+
+type RetryFailedSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryFailedSessionsRequest) Reset() {
+	*x = RetryFailedSessionsRequest{}
+	mi := &file_jules_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedSessionsRequest) ProtoMessage() {}
+
+func (x *RetryFailedSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RetryFailedSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RetryFailedSessionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RerunJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Overrides, unset fields are copied from the original job
+	Name                *string         `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Prompt              *string         `protobuf:"bytes,3,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	Repo                *string         `protobuf:"bytes,4,opt,name=repo,proto3,oneof" json:"repo,omitempty"`
+	Branch              *string         `protobuf:"bytes,5,opt,name=branch,proto3,oneof" json:"branch,omitempty"`
+	SessionCount        *int32          `protobuf:"varint,6,opt,name=session_count,json=sessionCount,proto3,oneof" json:"session_count,omitempty"`
+	AutoApproval        *bool           `protobuf:"varint,7,opt,name=auto_approval,json=autoApproval,proto3,oneof" json:"auto_approval,omitempty"`
+	AutomationMode      *AutomationMode `protobuf:"varint,8,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode,oneof" json:"automation_mode,omitempty"`
+	RequirePlanApproval *bool           `protobuf:"varint,9,opt,name=require_plan_approval,json=requirePlanApproval,proto3,oneof" json:"require_plan_approval,omitempty"`
+	ChatEnabled         *bool           `protobuf:"varint,10,opt,name=chat_enabled,json=chatEnabled,proto3,oneof" json:"chat_enabled,omitempty"`
+	Matrix              *JobMatrix      `protobuf:"bytes,11,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RerunJobRequest) Reset() {
+	*x = RerunJobRequest{}
+	mi := &file_jules_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerunJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunJobRequest) ProtoMessage() {}
+
+func (x *RerunJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunJobRequest.ProtoReflect.Descriptor instead.
+func (*RerunJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RerunJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RerunJobRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *RerunJobRequest) GetPrompt() string {
+	if x != nil && x.Prompt != nil {
+		return *x.Prompt
+	}
+	return ""
+}
+
+func (x *RerunJobRequest) GetRepo() string {
+	if x != nil && x.Repo != nil {
+		return *x.Repo
+	}
+	return ""
+}
+
+func (x *RerunJobRequest) GetBranch() string {
+	if x != nil && x.Branch != nil {
+		return *x.Branch
+	}
+	return ""
+}
+
+func (x *RerunJobRequest) GetSessionCount() int32 {
+	if x != nil && x.SessionCount != nil {
+		return *x.SessionCount
+	}
+	return 0
+}
+
+func (x *RerunJobRequest) GetAutoApproval() bool {
+	if x != nil && x.AutoApproval != nil {
+		return *x.AutoApproval
+	}
+	return false
+}
+
+func (x *RerunJobRequest) GetAutomationMode() AutomationMode {
+	if x != nil && x.AutomationMode != nil {
+		return *x.AutomationMode
+	}
+	return AutomationMode_AUTOMATION_MODE_UNSPECIFIED
+}
+
+func (x *RerunJobRequest) GetRequirePlanApproval() bool {
+	if x != nil && x.RequirePlanApproval != nil {
+		return *x.RequirePlanApproval
+	}
+	return false
+}
+
+func (x *RerunJobRequest) GetChatEnabled() bool {
+	if x != nil && x.ChatEnabled != nil {
+		return *x.ChatEnabled
+	}
+	return false
+}
+
+func (x *RerunJobRequest) GetMatrix() *JobMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+type RerunFailedSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RerunFailedSessionsRequest) Reset() {
+	*x = RerunFailedSessionsRequest{}
+	mi := &file_jules_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerunFailedSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunFailedSessionsRequest) ProtoMessage() {}
+
+func (x *RerunFailedSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunFailedSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RerunFailedSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RerunFailedSessionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	mi := &file_jules_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelJobResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CancelledSessionIds []string               `protobuf:"bytes,1,rep,name=cancelled_session_ids,json=cancelledSessionIds,proto3" json:"cancelled_session_ids,omitempty"`
+	FailedSessionIds    []string               `protobuf:"bytes,2,rep,name=failed_session_ids,json=failedSessionIds,proto3" json:"failed_session_ids,omitempty"` // Remote teardown failed, calling CancelJob again retries them
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	mi := &file_jules_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CancelJobResponse) GetCancelledSessionIds() []string {
+	if x != nil {
+		return x.CancelledSessionIds
+	}
+	return nil
+}
+
+func (x *CancelJobResponse) GetFailedSessionIds() []string {
+	if x != nil {
+		return x.FailedSessionIds
+	}
+	return nil
+}
+
+type PredefinedPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Prompt        string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredefinedPrompt) Reset() {
+	*x = PredefinedPrompt{}
+	mi := &file_jules_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredefinedPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredefinedPrompt) ProtoMessage() {}
+
+func (x *PredefinedPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredefinedPrompt.ProtoReflect.Descriptor instead.
+func (*PredefinedPrompt) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PredefinedPrompt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PredefinedPrompt) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PredefinedPrompt) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *PredefinedPrompt) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type ListPredefinedPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompts       []*PredefinedPrompt    `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPredefinedPromptsResponse) Reset() {
+	*x = ListPredefinedPromptsResponse{}
+	mi := &file_jules_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPredefinedPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPredefinedPromptsResponse) ProtoMessage() {}
+
+func (x *ListPredefinedPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPredefinedPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListPredefinedPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListPredefinedPromptsResponse) GetPrompts() []*PredefinedPrompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+type GetPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromptRequest) Reset() {
+	*x = GetPromptRequest{}
+	mi := &file_jules_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromptRequest) ProtoMessage() {}
+
+func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromptRequest.ProtoReflect.Descriptor instead.
+func (*GetPromptRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetPromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreatePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Prompt        string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromptRequest) Reset() {
+	*x = CreatePromptRequest{}
+	mi := &file_jules_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptRequest) ProtoMessage() {}
+
+func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreatePromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreatePromptRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePromptRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *CreatePromptRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type CreateManyPromptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompts       []*CreatePromptRequest `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManyPromptsRequest) Reset() {
+	*x = CreateManyPromptsRequest{}
+	mi := &file_jules_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManyPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManyPromptsRequest) ProtoMessage() {}
+
+func (x *CreateManyPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManyPromptsRequest.ProtoReflect.Descriptor instead.
+func (*CreateManyPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateManyPromptsRequest) GetPrompts() []*CreatePromptRequest {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+type UpdatePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Prompt        *string                `protobuf:"bytes,3,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromptRequest) Reset() {
+	*x = UpdatePromptRequest{}
+	mi := &file_jules_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromptRequest) ProtoMessage() {}
+
+func (x *UpdatePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromptRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromptRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdatePromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePromptRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePromptRequest) GetPrompt() string {
+	if x != nil && x.Prompt != nil {
+		return *x.Prompt
+	}
+	return ""
+}
+
+type DeletePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromptRequest) Reset() {
+	*x = DeletePromptRequest{}
+	mi := &file_jules_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromptRequest) ProtoMessage() {}
+
+func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromptRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromptRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeletePromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Global Prompt
+type GlobalPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlobalPrompt) Reset() {
+	*x = GlobalPrompt{}
+	mi := &file_jules_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlobalPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalPrompt) ProtoMessage() {}
+
+func (x *GlobalPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalPrompt.ProtoReflect.Descriptor instead.
+func (*GlobalPrompt) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GlobalPrompt) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+type SaveGlobalPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGlobalPromptRequest) Reset() {
+	*x = SaveGlobalPromptRequest{}
+	mi := &file_jules_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGlobalPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGlobalPromptRequest) ProtoMessage() {}
+
+func (x *SaveGlobalPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGlobalPromptRequest.ProtoReflect.Descriptor instead.
+func (*SaveGlobalPromptRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SaveGlobalPromptRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+// History Prompts
+type HistoryPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,3,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryPrompt) Reset() {
+	*x = HistoryPrompt{}
+	mi := &file_jules_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryPrompt) ProtoMessage() {}
+
+func (x *HistoryPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryPrompt.ProtoReflect.Descriptor instead.
+func (*HistoryPrompt) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *HistoryPrompt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HistoryPrompt) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *HistoryPrompt) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *HistoryPrompt) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type ListHistoryPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompts       []*HistoryPrompt       `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHistoryPromptsResponse) Reset() {
+	*x = ListHistoryPromptsResponse{}
+	mi := &file_jules_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHistoryPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHistoryPromptsResponse) ProtoMessage() {}
+
+func (x *ListHistoryPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHistoryPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListHistoryPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListHistoryPromptsResponse) GetPrompts() []*HistoryPrompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+type GetRecentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentRequest) Reset() {
+	*x = GetRecentRequest{}
+	mi := &file_jules_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentRequest) ProtoMessage() {}
+
+func (x *GetRecentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetRecentRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SaveHistoryPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveHistoryPromptRequest) Reset() {
+	*x = SaveHistoryPromptRequest{}
+	mi := &file_jules_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveHistoryPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveHistoryPromptRequest) ProtoMessage() {}
+
+func (x *SaveHistoryPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveHistoryPromptRequest.ProtoReflect.Descriptor instead.
+func (*SaveHistoryPromptRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SaveHistoryPromptRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+// Repo Prompt
+type RepoPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,3,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoPrompt) Reset() {
+	*x = RepoPrompt{}
+	mi := &file_jules_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoPrompt) ProtoMessage() {}
+
+func (x *RepoPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoPrompt.ProtoReflect.Descriptor instead.
+func (*RepoPrompt) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RepoPrompt) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *RepoPrompt) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *RepoPrompt) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type GetRepoPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoPromptRequest) Reset() {
+	*x = GetRepoPromptRequest{}
+	mi := &file_jules_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoPromptRequest) ProtoMessage() {}
+
+func (x *GetRepoPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoPromptRequest.ProtoReflect.Descriptor instead.
+func (*GetRepoPromptRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetRepoPromptRequest) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+type SaveRepoPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRepoPromptRequest) Reset() {
+	*x = SaveRepoPromptRequest{}
+	mi := &file_jules_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRepoPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRepoPromptRequest) ProtoMessage() {}
+
+func (x *SaveRepoPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRepoPromptRequest.ProtoReflect.Descriptor instead.
+func (*SaveRepoPromptRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SaveRepoPromptRequest) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SaveRepoPromptRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+// Sessions (Basic def for now to support Jobs)
+type Session struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title      string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Prompt     string                 `protobuf:"bytes,4,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	CreateTime string                 `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime string                 `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	State      string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Url        string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	// outputs skipped for brevity/complexity for now
+	RequirePlanApproval bool           `protobuf:"varint,9,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
+	AutomationMode      AutomationMode `protobuf:"varint,10,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode" json:"automation_mode,omitempty"`
+	LastUpdated         int64          `protobuf:"varint,11,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	RetryCount          int32          `protobuf:"varint,12,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LastError           string         `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastInteractionAt   int64          `protobuf:"varint,14,opt,name=last_interaction_at,json=lastInteractionAt,proto3" json:"last_interaction_at,omitempty"`
+	ProfileId           string         `protobuf:"bytes,15,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_jules_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Session) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Session) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *Session) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *Session) GetUpdateTime() string {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return ""
+}
+
+func (x *Session) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Session) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Session) GetRequirePlanApproval() bool {
+	if x != nil {
+		return x.RequirePlanApproval
+	}
+	return false
+}
+
+func (x *Session) GetAutomationMode() AutomationMode {
+	if x != nil {
+		return x.AutomationMode
+	}
+	return AutomationMode_AUTOMATION_MODE_UNSPECIFIED
+}
+
+func (x *Session) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *Session) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *Session) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Session) GetLastInteractionAt() int64 {
+	if x != nil {
+		return x.LastInteractionAt
+	}
+	return 0
+}
+
+func (x *Session) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     string                 `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"` // Filter by profile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_jules_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListSessionsRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_jules_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type GetSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_jules_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch        string                 `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,5,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
+	mi := &file_jules_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionRequest) ProtoMessage() {}
+
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateSessionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type UpdateSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSessionRequest) Reset() {
+	*x = UpdateSessionRequest{}
+	mi := &file_jules_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionRequest) ProtoMessage() {}
+
+func (x *UpdateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeleteRemote  bool                   `protobuf:"varint,2,opt,name=delete_remote,json=deleteRemote,proto3" json:"delete_remote,omitempty"` // Also delete the session from the Jules API
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionRequest) Reset() {
+	*x = DeleteSessionRequest{}
+	mi := &file_jules_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionRequest) ProtoMessage() {}
+
+func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSessionRequest) GetDeleteRemote() bool {
+	if x != nil {
+		return x.DeleteRemote
+	}
+	return false
+}
+
+type ApprovePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovePlanRequest) Reset() {
+	*x = ApprovePlanRequest{}
+	mi := &file_jules_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePlanRequest) ProtoMessage() {}
+
+func (x *ApprovePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePlanRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePlanRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ApprovePlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SendMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_jules_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SendMessageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ChatConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	AgentName     string                 `protobuf:"bytes,2,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"` // Unique name for the agent in this job
+	ApiKey        string                 `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`          // Unique secret key for this agent
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatConfig) Reset() {
+	*x = ChatConfig{}
+	mi := &file_jules_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatConfig) ProtoMessage() {}
+
+func (x *ChatConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatConfig.ProtoReflect.Descriptor instead.
+func (*ChatConfig) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ChatConfig) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ChatConfig) GetAgentName() string {
+	if x != nil {
+		return x.AgentName
+	}
+	return ""
+}
+
+func (x *ChatConfig) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *ChatConfig) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,3,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsHuman       bool                   `protobuf:"varint,6,opt,name=is_human,json=isHuman,proto3" json:"is_human,omitempty"`
+	Recipient     string                 `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"` // Optional: if set, only visible to this recipient (and sender)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_jules_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ChatMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetIsHuman() bool {
+	if x != nil {
+		return x.IsHuman
+	}
+	return false
+}
+
+func (x *ChatMessage) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+type GetChatConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	AgentName     string                 `protobuf:"bytes,2,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"` // Retrieve config for specific agent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatConfigRequest) Reset() {
+	*x = GetChatConfigRequest{}
+	mi := &file_jules_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatConfigRequest) ProtoMessage() {}
+
+func (x *GetChatConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetChatConfigRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetChatConfigRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetChatConfigRequest) GetAgentName() string {
+	if x != nil {
+		return x.AgentName
+	}
+	return ""
+}
+
+type CreateChatConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	AgentName     string                 `protobuf:"bytes,2,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChatConfigRequest) Reset() {
+	*x = CreateChatConfigRequest{}
+	mi := &file_jules_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChatConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChatConfigRequest) ProtoMessage() {}
+
+func (x *CreateChatConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChatConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateChatConfigRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateChatConfigRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CreateChatConfigRequest) GetAgentName() string {
+	if x != nil {
+		return x.AgentName
+	}
+	return ""
+}
+
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ApiKey        string                 `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // Used to identify sender
+	IsHuman       bool                   `protobuf:"varint,4,opt,name=is_human,json=isHuman,proto3" json:"is_human,omitempty"`
+	SenderName    string                 `protobuf:"bytes,5,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"` // Optional, only for Human
+	Recipient     string                 `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`                     // Optional: target specific agent or "Human"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	mi := &file_jules_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SendChatMessageRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetIsHuman() bool {
+	if x != nil {
+		return x.IsHuman
+	}
+	return false
+}
+
+func (x *SendChatMessageRequest) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+type ListChatMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Since         string                 `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"` // ISO timestamp to fetch only new messages
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	ViewerName    string                 `protobuf:"bytes,4,opt,name=viewer_name,json=viewerName,proto3" json:"viewer_name,omitempty"` // Optional: strictly filter messages visible to this viewer (Agent Name or "Human")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChatMessagesRequest) Reset() {
+	*x = ListChatMessagesRequest{}
+	mi := &file_jules_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatMessagesRequest) ProtoMessage() {}
+
+func (x *ListChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListChatMessagesRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListChatMessagesRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *ListChatMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListChatMessagesRequest) GetViewerName() string {
+	if x != nil {
+		return x.ViewerName
+	}
+	return ""
+}
+
+type ListChatMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChatMessagesResponse) Reset() {
+	*x = ListChatMessagesResponse{}
+	mi := &file_jules_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatMessagesResponse) ProtoMessage() {}
+
+func (x *ListChatMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListChatMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListChatMessagesResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ApplyStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`                  // YAML document
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only compute the diff, do not write anything
+	Prune         bool                   `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`                 // Delete items of declared profiles (and undeclared profiles) that are not in the state file
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyStateRequest) Reset() {
+	*x = ApplyStateRequest{}
+	mi := &file_jules_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStateRequest) ProtoMessage() {}
+
+func (x *ApplyStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStateRequest.ProtoReflect.Descriptor instead.
+func (*ApplyStateRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ApplyStateRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ApplyStateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ApplyStateRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+type StateChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "profile", "settings", "predefined_prompt", "quick_reply", "repo_prompt", "cron_job"
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,3,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // "create", "update", "delete"
+	Fields        []string               `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"` // Changed fields for updates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateChange) Reset() {
+	*x = StateChange{}
+	mi := &file_jules_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateChange) ProtoMessage() {}
+
+func (x *StateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateChange.ProtoReflect.Descriptor instead.
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StateChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *StateChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StateChange) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *StateChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *StateChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ApplyStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*StateChange         `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyStateResponse) Reset() {
+	*x = ApplyStateResponse{}
+	mi := &file_jules_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStateResponse) ProtoMessage() {}
+
+func (x *ApplyStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStateResponse.ProtoReflect.Descriptor instead.
+func (*ApplyStateResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ApplyStateResponse) GetChanges() []*StateChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ApplyStateResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PipelineStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`   // Unique within the pipeline, referenced by depends_on
+	Job           *CreateJobRequest      `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"` // Job template, id and status are ignored
+	DependsOn     []string               `protobuf:"bytes,3,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	Condition     PipelineCondition      `protobuf:"varint,4,opt,name=condition,proto3,enum=jules.PipelineCondition" json:"condition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineStep) Reset() {
+	*x = PipelineStep{}
+	mi := &file_jules_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStep) ProtoMessage() {}
+
+func (x *PipelineStep) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStep.ProtoReflect.Descriptor instead.
+func (*PipelineStep) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PipelineStep) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetJob() *CreateJobRequest {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetCondition() PipelineCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return PipelineCondition_PIPELINE_CONDITION_UNSPECIFIED
+}
+
+type Pipeline struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Steps         []*PipelineStep        `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	Sequential    bool                   `protobuf:"varint,4,opt,name=sequential,proto3" json:"sequential,omitempty"` // Each step depends on the one before it, depends_on is ignored
+	ProfileId     string                 `protobuf:"bytes,5,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pipeline) Reset() {
+	*x = Pipeline{}
+	mi := &file_jules_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pipeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pipeline) ProtoMessage() {}
+
+func (x *Pipeline) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pipeline.ProtoReflect.Descriptor instead.
+func (*Pipeline) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Pipeline) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Pipeline) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pipeline) GetSteps() []*PipelineStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Pipeline) GetSequential() bool {
+	if x != nil {
+		return x.Sequential
+	}
+	return false
+}
+
+func (x *Pipeline) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *Pipeline) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Pipeline) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListPipelinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pipelines     []*Pipeline            `protobuf:"bytes,1,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPipelinesResponse) Reset() {
+	*x = ListPipelinesResponse{}
+	mi := &file_jules_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelinesResponse) ProtoMessage() {}
+
+func (x *ListPipelinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelinesResponse.ProtoReflect.Descriptor instead.
+func (*ListPipelinesResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListPipelinesResponse) GetPipelines() []*Pipeline {
+	if x != nil {
+		return x.Pipelines
+	}
+	return nil
+}
+
+type GetPipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineRequest) Reset() {
+	*x = GetPipelineRequest{}
+	mi := &file_jules_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineRequest) ProtoMessage() {}
+
+func (x *GetPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetPipelineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreatePipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Steps         []*PipelineStep        `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	Sequential    bool                   `protobuf:"varint,3,opt,name=sequential,proto3" json:"sequential,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"` // Optional, generated if empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePipelineRequest) Reset() {
+	*x = CreatePipelineRequest{}
+	mi := &file_jules_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePipelineRequest) ProtoMessage() {}
+
+func (x *CreatePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePipelineRequest.ProtoReflect.Descriptor instead.
+func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreatePipelineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePipelineRequest) GetSteps() []*PipelineStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CreatePipelineRequest) GetSequential() bool {
+	if x != nil {
+		return x.Sequential
+	}
+	return false
+}
+
+func (x *CreatePipelineRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *CreatePipelineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdatePipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Steps         []*PipelineStep        `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	Sequential    bool                   `protobuf:"varint,4,opt,name=sequential,proto3" json:"sequential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePipelineRequest) Reset() {
+	*x = UpdatePipelineRequest{}
+	mi := &file_jules_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineRequest) ProtoMessage() {}
+
+func (x *UpdatePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdatePipelineRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateJobRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *UpdatePipelineRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateJobRequest) GetRepo() string {
-	if x != nil && x.Repo != nil {
-		return *x.Repo
+func (x *UpdatePipelineRequest) GetSteps() []*PipelineStep {
+	if x != nil {
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateJobRequest) GetBranch() string {
-	if x != nil && x.Branch != nil {
-		return *x.Branch
+func (x *UpdatePipelineRequest) GetSequential() bool {
+	if x != nil {
+		return x.Sequential
 	}
-	return ""
+	return false
 }
 
-type DeleteJobRequest struct {
+type DeletePipelineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteJobRequest) Reset() {
-	*x = DeleteJobRequest{}
-	mi := &file_jules_proto_msgTypes[24]
+func (x *DeletePipelineRequest) Reset() {
+	*x = DeletePipelineRequest{}
+	mi := &file_jules_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJobRequest) String() string {
+func (x *DeletePipelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobRequest) ProtoMessage() {}
+func (*DeletePipelineRequest) ProtoMessage() {}
 
-func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[24]
+func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2092,43 +6159,40 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeletePipelineRequest.ProtoReflect.Descriptor instead.
+func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *DeleteJobRequest) GetId() string {
+func (x *DeletePipelineRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type PredefinedPrompt struct {
+type StartPipelineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Prompt        string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	ProfileId     string                 `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PredefinedPrompt) Reset() {
-	*x = PredefinedPrompt{}
-	mi := &file_jules_proto_msgTypes[25]
+func (x *StartPipelineRequest) Reset() {
+	*x = StartPipelineRequest{}
+	mi := &file_jules_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PredefinedPrompt) String() string {
+func (x *StartPipelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PredefinedPrompt) ProtoMessage() {}
+func (*StartPipelineRequest) ProtoMessage() {}
 
-func (x *PredefinedPrompt) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[25]
+func (x *StartPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,61 +6203,84 @@ func (x *PredefinedPrompt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PredefinedPrompt.ProtoReflect.Descriptor instead.
-func (*PredefinedPrompt) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use StartPipelineRequest.ProtoReflect.Descriptor instead.
+func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *PredefinedPrompt) GetId() string {
+func (x *StartPipelineRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PredefinedPrompt) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type CancelPipelineRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PredefinedPrompt) GetPrompt() string {
+func (x *CancelPipelineRunRequest) Reset() {
+	*x = CancelPipelineRunRequest{}
+	mi := &file_jules_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPipelineRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPipelineRunRequest) ProtoMessage() {}
+
+func (x *CancelPipelineRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[82]
 	if x != nil {
-		return x.Prompt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PredefinedPrompt) GetProfileId() string {
+// Deprecated: Use CancelPipelineRunRequest.ProtoReflect.Descriptor instead.
+func (*CancelPipelineRunRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CancelPipelineRunRequest) GetId() string {
 	if x != nil {
-		return x.ProfileId
+		return x.Id
 	}
 	return ""
 }
 
-type ListPredefinedPromptsResponse struct {
+type GetPipelineRunRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompts       []*PredefinedPrompt    `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPredefinedPromptsResponse) Reset() {
-	*x = ListPredefinedPromptsResponse{}
-	mi := &file_jules_proto_msgTypes[26]
+func (x *GetPipelineRunRequest) Reset() {
+	*x = GetPipelineRunRequest{}
+	mi := &file_jules_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPredefinedPromptsResponse) String() string {
+func (x *GetPipelineRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPredefinedPromptsResponse) ProtoMessage() {}
+func (*GetPipelineRunRequest) ProtoMessage() {}
 
-func (x *ListPredefinedPromptsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[26]
+func (x *GetPipelineRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2204,40 +6291,41 @@ func (x *ListPredefinedPromptsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPredefinedPromptsResponse.ProtoReflect.Descriptor instead.
-func (*ListPredefinedPromptsResponse) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetPipelineRunRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRunRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ListPredefinedPromptsResponse) GetPrompts() []*PredefinedPrompt {
+func (x *GetPipelineRunRequest) GetId() string {
 	if x != nil {
-		return x.Prompts
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetPromptRequest struct {
+type ListPipelineRunsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 20
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPromptRequest) Reset() {
-	*x = GetPromptRequest{}
-	mi := &file_jules_proto_msgTypes[27]
+func (x *ListPipelineRunsRequest) Reset() {
+	*x = ListPipelineRunsRequest{}
+	mi := &file_jules_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPromptRequest) String() string {
+func (x *ListPipelineRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPromptRequest) ProtoMessage() {}
+func (*ListPipelineRunsRequest) ProtoMessage() {}
 
-func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[27]
+func (x *ListPipelineRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2248,43 +6336,52 @@ func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPromptRequest.ProtoReflect.Descriptor instead.
-func (*GetPromptRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListPipelineRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListPipelineRunsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetPromptRequest) GetId() string {
+func (x *ListPipelineRunsRequest) GetPipelineId() string {
 	if x != nil {
-		return x.Id
+		return x.PipelineId
 	}
 	return ""
 }
 
-type CreatePromptRequest struct {
+func (x *ListPipelineRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PipelineRunStep struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Prompt        string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	ProfileId     string                 `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	StepId        string                 `protobuf:"bytes,1,opt,name=step_id,json=stepId,proto3" json:"step_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // 'WAITING', 'RUNNING', 'SUCCEEDED', 'FAILED', 'SKIPPED', 'CANCELLED'
+	JobId         string                 `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // Why the step failed or was skipped
+	StartedAt     string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    string                 `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePromptRequest) Reset() {
-	*x = CreatePromptRequest{}
-	mi := &file_jules_proto_msgTypes[28]
+func (x *PipelineRunStep) Reset() {
+	*x = PipelineRunStep{}
+	mi := &file_jules_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePromptRequest) String() string {
+func (x *PipelineRunStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePromptRequest) ProtoMessage() {}
+func (*PipelineRunStep) ProtoMessage() {}
 
-func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[28]
+func (x *PipelineRunStep) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,61 +6392,82 @@ func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePromptRequest.ProtoReflect.Descriptor instead.
-func (*CreatePromptRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use PipelineRunStep.ProtoReflect.Descriptor instead.
+func (*PipelineRunStep) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreatePromptRequest) GetId() string {
+func (x *PipelineRunStep) GetStepId() string {
 	if x != nil {
-		return x.Id
+		return x.StepId
 	}
 	return ""
 }
 
-func (x *CreatePromptRequest) GetTitle() string {
+func (x *PipelineRunStep) GetStatus() string {
 	if x != nil {
-		return x.Title
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreatePromptRequest) GetPrompt() string {
+func (x *PipelineRunStep) GetJobId() string {
 	if x != nil {
-		return x.Prompt
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *CreatePromptRequest) GetProfileId() string {
+func (x *PipelineRunStep) GetMessage() string {
 	if x != nil {
-		return x.ProfileId
+		return x.Message
 	}
 	return ""
 }
 
-type CreateManyPromptsRequest struct {
+func (x *PipelineRunStep) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *PipelineRunStep) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+type PipelineRun struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompts       []*CreatePromptRequest `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	PipelineName  string                 `protobuf:"bytes,3,opt,name=pipeline_name,json=pipelineName,proto3" json:"pipeline_name,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // 'RUNNING', 'SUCCEEDED', 'FAILED', 'CANCELLED'
+	Steps         []*PipelineRunStep     `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt    string                 `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateManyPromptsRequest) Reset() {
-	*x = CreateManyPromptsRequest{}
-	mi := &file_jules_proto_msgTypes[29]
+func (x *PipelineRun) Reset() {
+	*x = PipelineRun{}
+	mi := &file_jules_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateManyPromptsRequest) String() string {
+func (x *PipelineRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateManyPromptsRequest) ProtoMessage() {}
+func (*PipelineRun) ProtoMessage() {}
 
-func (x *CreateManyPromptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[29]
+func (x *PipelineRun) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2360,42 +6478,89 @@ func (x *CreateManyPromptsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateManyPromptsRequest.ProtoReflect.Descriptor instead.
-func (*CreateManyPromptsRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PipelineRun.ProtoReflect.Descriptor instead.
+func (*PipelineRun) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *CreateManyPromptsRequest) GetPrompts() []*CreatePromptRequest {
+func (x *PipelineRun) GetId() string {
 	if x != nil {
-		return x.Prompts
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PipelineRun) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *PipelineRun) GetPipelineName() string {
+	if x != nil {
+		return x.PipelineName
+	}
+	return ""
+}
+
+func (x *PipelineRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PipelineRun) GetSteps() []*PipelineRunStep {
+	if x != nil {
+		return x.Steps
 	}
 	return nil
 }
 
-type UpdatePromptRequest struct {
+func (x *PipelineRun) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PipelineRun) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PipelineRun) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+type ListPipelineRunsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Prompt        *string                `protobuf:"bytes,3,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	Runs          []*PipelineRun         `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePromptRequest) Reset() {
-	*x = UpdatePromptRequest{}
-	mi := &file_jules_proto_msgTypes[30]
+func (x *ListPipelineRunsResponse) Reset() {
+	*x = ListPipelineRunsResponse{}
+	mi := &file_jules_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePromptRequest) String() string {
+func (x *ListPipelineRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePromptRequest) ProtoMessage() {}
+func (*ListPipelineRunsResponse) ProtoMessage() {}
 
-func (x *UpdatePromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[30]
+func (x *ListPipelineRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,54 +6571,40 @@ func (x *UpdatePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePromptRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePromptRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListPipelineRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListPipelineRunsResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *UpdatePromptRequest) GetId() string {
+func (x *ListPipelineRunsResponse) GetRuns() []*PipelineRun {
 	if x != nil {
-		return x.Id
+		return x.Runs
 	}
-	return ""
-}
-
-func (x *UpdatePromptRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
-}
-
-func (x *UpdatePromptRequest) GetPrompt() string {
-	if x != nil && x.Prompt != nil {
-		return *x.Prompt
-	}
-	return ""
+	return nil
 }
 
-type DeletePromptRequest struct {
+type EnqueueJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Job           *CreateJobRequest      `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"` // Status is ignored, matrix jobs cannot be queued
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePromptRequest) Reset() {
-	*x = DeletePromptRequest{}
-	mi := &file_jules_proto_msgTypes[31]
+func (x *EnqueueJobRequest) Reset() {
+	*x = EnqueueJobRequest{}
+	mi := &file_jules_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePromptRequest) String() string {
+func (x *EnqueueJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePromptRequest) ProtoMessage() {}
+func (*EnqueueJobRequest) ProtoMessage() {}
 
-func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[31]
+func (x *EnqueueJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,41 +6615,40 @@ func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePromptRequest.ProtoReflect.Descriptor instead.
-func (*DeletePromptRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use EnqueueJobRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *DeletePromptRequest) GetId() string {
+func (x *EnqueueJobRequest) GetJob() *CreateJobRequest {
 	if x != nil {
-		return x.Id
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-// Global Prompt
-type GlobalPrompt struct {
+type ListQueueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"` // Optional, all repos with queued jobs if empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalPrompt) Reset() {
-	*x = GlobalPrompt{}
-	mi := &file_jules_proto_msgTypes[32]
+func (x *ListQueueRequest) Reset() {
+	*x = ListQueueRequest{}
+	mi := &file_jules_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalPrompt) String() string {
+func (x *ListQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobalPrompt) ProtoMessage() {}
+func (*ListQueueRequest) ProtoMessage() {}
 
-func (x *GlobalPrompt) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[32]
+func (x *ListQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2509,40 +6659,40 @@ func (x *GlobalPrompt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlobalPrompt.ProtoReflect.Descriptor instead.
-func (*GlobalPrompt) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListQueueRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GlobalPrompt) GetPrompt() string {
+func (x *ListQueueRequest) GetRepo() string {
 	if x != nil {
-		return x.Prompt
+		return x.Repo
 	}
 	return ""
 }
 
-type SaveGlobalPromptRequest struct {
+type ListQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Repos         []*RepoQueue           `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveGlobalPromptRequest) Reset() {
-	*x = SaveGlobalPromptRequest{}
-	mi := &file_jules_proto_msgTypes[33]
+func (x *ListQueueResponse) Reset() {
+	*x = ListQueueResponse{}
+	mi := &file_jules_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveGlobalPromptRequest) String() string {
+func (x *ListQueueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveGlobalPromptRequest) ProtoMessage() {}
+func (*ListQueueResponse) ProtoMessage() {}
 
-func (x *SaveGlobalPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[33]
+func (x *ListQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2553,44 +6703,43 @@ func (x *SaveGlobalPromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveGlobalPromptRequest.ProtoReflect.Descriptor instead.
-func (*SaveGlobalPromptRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListQueueResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *SaveGlobalPromptRequest) GetPrompt() string {
+func (x *ListQueueResponse) GetRepos() []*RepoQueue {
 	if x != nil {
-		return x.Prompt
+		return x.Repos
 	}
-	return ""
+	return nil
 }
 
-// History Prompts
-type HistoryPrompt struct {
+type RepoQueue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	LastUsedAt    string                 `protobuf:"bytes,3,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
-	ProfileId     string                 `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	MaxActive     int32                  `protobuf:"varint,2,opt,name=max_active,json=maxActive,proto3" json:"max_active,omitempty"` // Sessions/PRs allowed to be active at once
+	Active        int32                  `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Jobs          []*Job                 `protobuf:"bytes,4,rep,name=jobs,proto3" json:"jobs,omitempty"` // Queued jobs in start order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HistoryPrompt) Reset() {
-	*x = HistoryPrompt{}
-	mi := &file_jules_proto_msgTypes[34]
+func (x *RepoQueue) Reset() {
+	*x = RepoQueue{}
+	mi := &file_jules_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HistoryPrompt) String() string {
+func (x *RepoQueue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoryPrompt) ProtoMessage() {}
+func (*RepoQueue) ProtoMessage() {}
 
-func (x *HistoryPrompt) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[34]
+func (x *RepoQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2601,61 +6750,62 @@ func (x *HistoryPrompt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistoryPrompt.ProtoReflect.Descriptor instead.
-func (*HistoryPrompt) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use RepoQueue.ProtoReflect.Descriptor instead.
+func (*RepoQueue) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *HistoryPrompt) GetId() string {
+func (x *RepoQueue) GetRepo() string {
 	if x != nil {
-		return x.Id
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *HistoryPrompt) GetPrompt() string {
+func (x *RepoQueue) GetMaxActive() int32 {
 	if x != nil {
-		return x.Prompt
+		return x.MaxActive
 	}
-	return ""
+	return 0
 }
 
-func (x *HistoryPrompt) GetLastUsedAt() string {
+func (x *RepoQueue) GetActive() int32 {
 	if x != nil {
-		return x.LastUsedAt
+		return x.Active
 	}
-	return ""
+	return 0
 }
 
-func (x *HistoryPrompt) GetProfileId() string {
+func (x *RepoQueue) GetJobs() []*Job {
 	if x != nil {
-		return x.ProfileId
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-type ListHistoryPromptsResponse struct {
+type SetJobPriorityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompts       []*HistoryPrompt       `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHistoryPromptsResponse) Reset() {
-	*x = ListHistoryPromptsResponse{}
-	mi := &file_jules_proto_msgTypes[35]
+func (x *SetJobPriorityRequest) Reset() {
+	*x = SetJobPriorityRequest{}
+	mi := &file_jules_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHistoryPromptsResponse) String() string {
+func (x *SetJobPriorityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryPromptsResponse) ProtoMessage() {}
+func (*SetJobPriorityRequest) ProtoMessage() {}
 
-func (x *ListHistoryPromptsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[35]
+func (x *SetJobPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2666,40 +6816,48 @@ func (x *ListHistoryPromptsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryPromptsResponse.ProtoReflect.Descriptor instead.
-func (*ListHistoryPromptsResponse) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use SetJobPriorityRequest.ProtoReflect.Descriptor instead.
+func (*SetJobPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ListHistoryPromptsResponse) GetPrompts() []*HistoryPrompt {
+func (x *SetJobPriorityRequest) GetId() string {
 	if x != nil {
-		return x.Prompts
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetRecentRequest struct {
+func (x *SetJobPriorityRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type MoveQueuedJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"` // 0-based, among the queued jobs of the same repo and priority
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRecentRequest) Reset() {
-	*x = GetRecentRequest{}
-	mi := &file_jules_proto_msgTypes[36]
+func (x *MoveQueuedJobRequest) Reset() {
+	*x = MoveQueuedJobRequest{}
+	mi := &file_jules_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRecentRequest) String() string {
+func (x *MoveQueuedJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecentRequest) ProtoMessage() {}
+func (*MoveQueuedJobRequest) ProtoMessage() {}
 
-func (x *GetRecentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[36]
+func (x *MoveQueuedJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2710,40 +6868,48 @@ func (x *GetRecentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecentRequest.ProtoReflect.Descriptor instead.
-func (*GetRecentRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use MoveQueuedJobRequest.ProtoReflect.Descriptor instead.
+func (*MoveQueuedJobRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetRecentRequest) GetLimit() int32 {
+func (x *MoveQueuedJobRequest) GetId() string {
 	if x != nil {
-		return x.Limit
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveQueuedJobRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-type SaveHistoryPromptRequest struct {
+type SetRepoConcurrencyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	MaxActive     int32                  `protobuf:"varint,2,opt,name=max_active,json=maxActive,proto3" json:"max_active,omitempty"` // 0 resets to the default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveHistoryPromptRequest) Reset() {
-	*x = SaveHistoryPromptRequest{}
-	mi := &file_jules_proto_msgTypes[37]
+func (x *SetRepoConcurrencyRequest) Reset() {
+	*x = SetRepoConcurrencyRequest{}
+	mi := &file_jules_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveHistoryPromptRequest) String() string {
+func (x *SetRepoConcurrencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveHistoryPromptRequest) ProtoMessage() {}
+func (*SetRepoConcurrencyRequest) ProtoMessage() {}
 
-func (x *SaveHistoryPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[37]
+func (x *SetRepoConcurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2754,43 +6920,65 @@ func (x *SaveHistoryPromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveHistoryPromptRequest.ProtoReflect.Descriptor instead.
-func (*SaveHistoryPromptRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use SetRepoConcurrencyRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoConcurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *SaveHistoryPromptRequest) GetPrompt() string {
+func (x *SetRepoConcurrencyRequest) GetRepo() string {
 	if x != nil {
-		return x.Prompt
+		return x.Repo
 	}
 	return ""
 }
 
-// Repo Prompt
-type RepoPrompt struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	ProfileId     string                 `protobuf:"bytes,3,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetRepoConcurrencyRequest) GetMaxActive() int32 {
+	if x != nil {
+		return x.MaxActive
+	}
+	return 0
 }
 
-func (x *RepoPrompt) Reset() {
-	*x = RepoPrompt{}
-	mi := &file_jules_proto_msgTypes[38]
+// EventTrigger starts a job when a GitHub event happens on its repo. The prompt is a template:
+// {{repo}}, {{branch}}, {{url}} and the variables of the event (see README) are substituted.
+type EventTrigger struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Event               TriggerEvent           `protobuf:"varint,3,opt,name=event,proto3,enum=jules.TriggerEvent" json:"event,omitempty"`
+	Repo                string                 `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch              string                 `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"` // Branch of the job and the one CI_FAILED watches, defaults to main
+	Label               string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`   // Label of ISSUE_LABELED, defaults to jules
+	Prompt              string                 `protobuf:"bytes,7,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	AutoApproval        bool                   `protobuf:"varint,8,opt,name=auto_approval,json=autoApproval,proto3" json:"auto_approval,omitempty"`
+	AutomationMode      AutomationMode         `protobuf:"varint,9,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode" json:"automation_mode,omitempty"`
+	RequirePlanApproval bool                   `protobuf:"varint,10,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
+	SessionCount        int32                  `protobuf:"varint,11,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	ProfileId           string                 `protobuf:"bytes,12,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Enabled             bool                   `protobuf:"varint,13,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Priority            int32                  `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Events before this time are ignored
+	UpdatedAt           string                 `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastEventAt         string                 `protobuf:"bytes,17,opt,name=last_event_at,json=lastEventAt,proto3" json:"last_event_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EventTrigger) Reset() {
+	*x = EventTrigger{}
+	mi := &file_jules_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepoPrompt) String() string {
+func (x *EventTrigger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoPrompt) ProtoMessage() {}
+func (*EventTrigger) ProtoMessage() {}
 
-func (x *RepoPrompt) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[38]
+func (x *EventTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2801,99 +6989,152 @@ func (x *RepoPrompt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoPrompt.ProtoReflect.Descriptor instead.
-func (*RepoPrompt) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use EventTrigger.ProtoReflect.Descriptor instead.
+func (*EventTrigger) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *RepoPrompt) GetRepo() string {
+func (x *EventTrigger) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventTrigger) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventTrigger) GetEvent() TriggerEvent {
+	if x != nil {
+		return x.Event
+	}
+	return TriggerEvent_TRIGGER_EVENT_UNSPECIFIED
+}
+
+func (x *EventTrigger) GetRepo() string {
 	if x != nil {
 		return x.Repo
 	}
 	return ""
 }
 
-func (x *RepoPrompt) GetPrompt() string {
+func (x *EventTrigger) GetBranch() string {
 	if x != nil {
-		return x.Prompt
+		return x.Branch
 	}
 	return ""
 }
 
-func (x *RepoPrompt) GetProfileId() string {
+func (x *EventTrigger) GetLabel() string {
 	if x != nil {
-		return x.ProfileId
+		return x.Label
 	}
 	return ""
 }
 
-type GetRepoPromptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventTrigger) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
 }
 
-func (x *GetRepoPromptRequest) Reset() {
-	*x = GetRepoPromptRequest{}
-	mi := &file_jules_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventTrigger) GetAutoApproval() bool {
+	if x != nil {
+		return x.AutoApproval
+	}
+	return false
 }
 
-func (x *GetRepoPromptRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventTrigger) GetAutomationMode() AutomationMode {
+	if x != nil {
+		return x.AutomationMode
+	}
+	return AutomationMode_AUTOMATION_MODE_UNSPECIFIED
 }
 
-func (*GetRepoPromptRequest) ProtoMessage() {}
+func (x *EventTrigger) GetRequirePlanApproval() bool {
+	if x != nil {
+		return x.RequirePlanApproval
+	}
+	return false
+}
 
-func (x *GetRepoPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[39]
+func (x *EventTrigger) GetSessionCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SessionCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetRepoPromptRequest.ProtoReflect.Descriptor instead.
-func (*GetRepoPromptRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{39}
+func (x *EventTrigger) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
 }
 
-func (x *GetRepoPromptRequest) GetRepo() string {
+func (x *EventTrigger) GetEnabled() bool {
 	if x != nil {
-		return x.Repo
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EventTrigger) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *EventTrigger) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type SaveRepoPromptRequest struct {
+func (x *EventTrigger) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *EventTrigger) GetLastEventAt() string {
+	if x != nil {
+		return x.LastEventAt
+	}
+	return ""
+}
+
+type ListEventTriggersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Triggers      []*EventTrigger        `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SaveRepoPromptRequest) Reset() {
-	*x = SaveRepoPromptRequest{}
-	mi := &file_jules_proto_msgTypes[40]
+}
+
+func (x *ListEventTriggersResponse) Reset() {
+	*x = ListEventTriggersResponse{}
+	mi := &file_jules_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveRepoPromptRequest) String() string {
+func (x *ListEventTriggersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveRepoPromptRequest) ProtoMessage() {}
+func (*ListEventTriggersResponse) ProtoMessage() {}
 
-func (x *SaveRepoPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[40]
+func (x *ListEventTriggersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2904,63 +7145,52 @@ func (x *SaveRepoPromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveRepoPromptRequest.ProtoReflect.Descriptor instead.
-func (*SaveRepoPromptRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *SaveRepoPromptRequest) GetRepo() string {
-	if x != nil {
-		return x.Repo
-	}
-	return ""
+// Deprecated: Use ListEventTriggersResponse.ProtoReflect.Descriptor instead.
+func (*ListEventTriggersResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *SaveRepoPromptRequest) GetPrompt() string {
+func (x *ListEventTriggersResponse) GetTriggers() []*EventTrigger {
 	if x != nil {
-		return x.Prompt
+		return x.Triggers
 	}
-	return ""
+	return nil
 }
 
-// Sessions (Basic def for now to support Jobs)
-type Session struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Title      string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Prompt     string                 `protobuf:"bytes,4,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	CreateTime string                 `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime string                 `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	State      string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
-	Url        string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
-	// outputs skipped for brevity/complexity for now
-	RequirePlanApproval bool           `protobuf:"varint,9,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
-	AutomationMode      AutomationMode `protobuf:"varint,10,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode" json:"automation_mode,omitempty"`
-	LastUpdated         int64          `protobuf:"varint,11,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	RetryCount          int32          `protobuf:"varint,12,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
-	LastError           string         `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
-	LastInteractionAt   int64          `protobuf:"varint,14,opt,name=last_interaction_at,json=lastInteractionAt,proto3" json:"last_interaction_at,omitempty"`
-	ProfileId           string         `protobuf:"bytes,15,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+type CreateEventTriggerRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Optional, generated if empty
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Event               TriggerEvent           `protobuf:"varint,3,opt,name=event,proto3,enum=jules.TriggerEvent" json:"event,omitempty"`
+	Repo                string                 `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch              string                 `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`
+	Label               string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	Prompt              string                 `protobuf:"bytes,7,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	AutoApproval        bool                   `protobuf:"varint,8,opt,name=auto_approval,json=autoApproval,proto3" json:"auto_approval,omitempty"`
+	AutomationMode      AutomationMode         `protobuf:"varint,9,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode" json:"automation_mode,omitempty"`
+	RequirePlanApproval bool                   `protobuf:"varint,10,opt,name=require_plan_approval,json=requirePlanApproval,proto3" json:"require_plan_approval,omitempty"`
+	SessionCount        int32                  `protobuf:"varint,11,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	ProfileId           string                 `protobuf:"bytes,12,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Priority            int32                  `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *Session) Reset() {
-	*x = Session{}
-	mi := &file_jules_proto_msgTypes[41]
+func (x *CreateEventTriggerRequest) Reset() {
+	*x = CreateEventTriggerRequest{}
+	mi := &file_jules_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Session) String() string {
+func (x *CreateEventTriggerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Session) ProtoMessage() {}
+func (*CreateEventTriggerRequest) ProtoMessage() {}
 
-func (x *Session) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[41]
+func (x *CreateEventTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2971,138 +7201,256 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Session.ProtoReflect.Descriptor instead.
-func (*Session) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CreateEventTriggerRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *Session) GetId() string {
+func (x *CreateEventTriggerRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Session) GetName() string {
+func (x *CreateEventTriggerRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Session) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Session) GetPrompt() string {
+func (x *CreateEventTriggerRequest) GetEvent() TriggerEvent {
 	if x != nil {
-		return x.Prompt
+		return x.Event
 	}
-	return ""
+	return TriggerEvent_TRIGGER_EVENT_UNSPECIFIED
 }
 
-func (x *Session) GetCreateTime() string {
+func (x *CreateEventTriggerRequest) GetRepo() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *Session) GetUpdateTime() string {
+func (x *CreateEventTriggerRequest) GetBranch() string {
 	if x != nil {
-		return x.UpdateTime
+		return x.Branch
 	}
 	return ""
 }
 
-func (x *Session) GetState() string {
+func (x *CreateEventTriggerRequest) GetLabel() string {
 	if x != nil {
-		return x.State
+		return x.Label
 	}
 	return ""
 }
 
-func (x *Session) GetUrl() string {
+func (x *CreateEventTriggerRequest) GetPrompt() string {
 	if x != nil {
-		return x.Url
+		return x.Prompt
 	}
 	return ""
 }
 
-func (x *Session) GetRequirePlanApproval() bool {
+func (x *CreateEventTriggerRequest) GetAutoApproval() bool {
 	if x != nil {
-		return x.RequirePlanApproval
+		return x.AutoApproval
 	}
 	return false
 }
 
-func (x *Session) GetAutomationMode() AutomationMode {
+func (x *CreateEventTriggerRequest) GetAutomationMode() AutomationMode {
 	if x != nil {
 		return x.AutomationMode
 	}
 	return AutomationMode_AUTOMATION_MODE_UNSPECIFIED
 }
 
-func (x *Session) GetLastUpdated() int64 {
+func (x *CreateEventTriggerRequest) GetRequirePlanApproval() bool {
 	if x != nil {
-		return x.LastUpdated
+		return x.RequirePlanApproval
 	}
-	return 0
+	return false
 }
 
-func (x *Session) GetRetryCount() int32 {
+func (x *CreateEventTriggerRequest) GetSessionCount() int32 {
 	if x != nil {
-		return x.RetryCount
+		return x.SessionCount
 	}
 	return 0
 }
 
-func (x *Session) GetLastError() string {
+func (x *CreateEventTriggerRequest) GetProfileId() string {
 	if x != nil {
-		return x.LastError
+		return x.ProfileId
 	}
 	return ""
 }
 
-func (x *Session) GetLastInteractionAt() int64 {
+func (x *CreateEventTriggerRequest) GetPriority() int32 {
 	if x != nil {
-		return x.LastInteractionAt
+		return x.Priority
 	}
 	return 0
 }
 
-func (x *Session) GetProfileId() string {
+type UpdateEventTriggerRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Repo                *string                `protobuf:"bytes,3,opt,name=repo,proto3,oneof" json:"repo,omitempty"`
+	Branch              *string                `protobuf:"bytes,4,opt,name=branch,proto3,oneof" json:"branch,omitempty"`
+	Label               *string                `protobuf:"bytes,5,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Prompt              *string                `protobuf:"bytes,6,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	AutoApproval        *bool                  `protobuf:"varint,7,opt,name=auto_approval,json=autoApproval,proto3,oneof" json:"auto_approval,omitempty"`
+	AutomationMode      *AutomationMode        `protobuf:"varint,8,opt,name=automation_mode,json=automationMode,proto3,enum=jules.AutomationMode,oneof" json:"automation_mode,omitempty"`
+	RequirePlanApproval *bool                  `protobuf:"varint,9,opt,name=require_plan_approval,json=requirePlanApproval,proto3,oneof" json:"require_plan_approval,omitempty"`
+	SessionCount        *int32                 `protobuf:"varint,10,opt,name=session_count,json=sessionCount,proto3,oneof" json:"session_count,omitempty"`
+	Enabled             *bool                  `protobuf:"varint,11,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Priority            *int32                 `protobuf:"varint,12,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateEventTriggerRequest) Reset() {
+	*x = UpdateEventTriggerRequest{}
+	mi := &file_jules_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventTriggerRequest) ProtoMessage() {}
+
+func (x *UpdateEventTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[98]
 	if x != nil {
-		return x.ProfileId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventTriggerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateEventTriggerRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type ListSessionsRequest struct {
+func (x *UpdateEventTriggerRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEventTriggerRequest) GetRepo() string {
+	if x != nil && x.Repo != nil {
+		return *x.Repo
+	}
+	return ""
+}
+
+func (x *UpdateEventTriggerRequest) GetBranch() string {
+	if x != nil && x.Branch != nil {
+		return *x.Branch
+	}
+	return ""
+}
+
+func (x *UpdateEventTriggerRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *UpdateEventTriggerRequest) GetPrompt() string {
+	if x != nil && x.Prompt != nil {
+		return *x.Prompt
+	}
+	return ""
+}
+
+func (x *UpdateEventTriggerRequest) GetAutoApproval() bool {
+	if x != nil && x.AutoApproval != nil {
+		return *x.AutoApproval
+	}
+	return false
+}
+
+func (x *UpdateEventTriggerRequest) GetAutomationMode() AutomationMode {
+	if x != nil && x.AutomationMode != nil {
+		return *x.AutomationMode
+	}
+	return AutomationMode_AUTOMATION_MODE_UNSPECIFIED
+}
+
+func (x *UpdateEventTriggerRequest) GetRequirePlanApproval() bool {
+	if x != nil && x.RequirePlanApproval != nil {
+		return *x.RequirePlanApproval
+	}
+	return false
+}
+
+func (x *UpdateEventTriggerRequest) GetSessionCount() int32 {
+	if x != nil && x.SessionCount != nil {
+		return *x.SessionCount
+	}
+	return 0
+}
+
+func (x *UpdateEventTriggerRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateEventTriggerRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+type DeleteEventTriggerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProfileId     string                 `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"` // Filter by profile
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionsRequest) Reset() {
-	*x = ListSessionsRequest{}
-	mi := &file_jules_proto_msgTypes[42]
+func (x *DeleteEventTriggerRequest) Reset() {
+	*x = DeleteEventTriggerRequest{}
+	mi := &file_jules_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionsRequest) String() string {
+func (x *DeleteEventTriggerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionsRequest) ProtoMessage() {}
+func (*DeleteEventTriggerRequest) ProtoMessage() {}
 
-func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[42]
+func (x *DeleteEventTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3113,40 +7461,41 @@ func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
-func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DeleteEventTriggerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *ListSessionsRequest) GetProfileId() string {
+func (x *DeleteEventTriggerRequest) GetId() string {
 	if x != nil {
-		return x.ProfileId
+		return x.Id
 	}
 	return ""
 }
 
-type ListSessionsResponse struct {
+type ListTriggeredEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	TriggerId     string                 `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSessionsResponse) Reset() {
-	*x = ListSessionsResponse{}
-	mi := &file_jules_proto_msgTypes[43]
+func (x *ListTriggeredEventsRequest) Reset() {
+	*x = ListTriggeredEventsRequest{}
+	mi := &file_jules_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionsResponse) String() string {
+func (x *ListTriggeredEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSessionsResponse) ProtoMessage() {}
+func (*ListTriggeredEventsRequest) ProtoMessage() {}
 
-func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[43]
+func (x *ListTriggeredEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3157,40 +7506,47 @@ func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
-func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListTriggeredEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListTriggeredEventsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *ListSessionsResponse) GetSessions() []*Session {
+func (x *ListTriggeredEventsRequest) GetTriggerId() string {
 	if x != nil {
-		return x.Sessions
+		return x.TriggerId
 	}
-	return nil
+	return ""
 }
 
-type GetSessionRequest struct {
+func (x *ListTriggeredEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTriggeredEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Events        []*TriggeredEvent      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSessionRequest) Reset() {
-	*x = GetSessionRequest{}
-	mi := &file_jules_proto_msgTypes[44]
+func (x *ListTriggeredEventsResponse) Reset() {
+	*x = ListTriggeredEventsResponse{}
+	mi := &file_jules_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionRequest) String() string {
+func (x *ListTriggeredEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionRequest) ProtoMessage() {}
+func (*ListTriggeredEventsResponse) ProtoMessage() {}
 
-func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[44]
+func (x *ListTriggeredEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3201,44 +7557,46 @@ func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
-func (*GetSessionRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ListTriggeredEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListTriggeredEventsResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetSessionRequest) GetId() string {
+func (x *ListTriggeredEventsResponse) GetEvents() []*TriggeredEvent {
 	if x != nil {
-		return x.Id
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type CreateSessionRequest struct {
+// TriggeredEvent is an event a trigger handled.
+type TriggeredEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
-	Branch        string                 `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
-	ProfileId     string                 `protobuf:"bytes,5,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	TriggerId     string                 `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	EventKey      string                 `protobuf:"bytes,2,opt,name=event_key,json=eventKey,proto3" json:"event_key,omitempty"` // Identifies the event per trigger, e.g. 'issue/12' or 'release/v1.2.0'
+	Summary       string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	JobId         string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSessionRequest) Reset() {
-	*x = CreateSessionRequest{}
-	mi := &file_jules_proto_msgTypes[45]
+func (x *TriggeredEvent) Reset() {
+	*x = TriggeredEvent{}
+	mi := &file_jules_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSessionRequest) String() string {
+func (x *TriggeredEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionRequest) ProtoMessage() {}
+func (*TriggeredEvent) ProtoMessage() {}
 
-func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[45]
+func (x *TriggeredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3249,68 +7607,77 @@ func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use TriggeredEvent.ProtoReflect.Descriptor instead.
+func (*TriggeredEvent) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TriggeredEvent) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
 }
 
-func (x *CreateSessionRequest) GetName() string {
+func (x *TriggeredEvent) GetEventKey() string {
 	if x != nil {
-		return x.Name
+		return x.EventKey
 	}
 	return ""
 }
 
-func (x *CreateSessionRequest) GetPrompt() string {
+func (x *TriggeredEvent) GetSummary() string {
 	if x != nil {
-		return x.Prompt
+		return x.Summary
 	}
 	return ""
 }
 
-func (x *CreateSessionRequest) GetRepo() string {
+func (x *TriggeredEvent) GetUrl() string {
 	if x != nil {
-		return x.Repo
+		return x.Url
 	}
 	return ""
 }
 
-func (x *CreateSessionRequest) GetBranch() string {
+func (x *TriggeredEvent) GetJobId() string {
 	if x != nil {
-		return x.Branch
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *CreateSessionRequest) GetProfileId() string {
+func (x *TriggeredEvent) GetCreatedAt() string {
 	if x != nil {
-		return x.ProfileId
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type UpdateSessionRequest struct {
+type ListTrackedPullRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`    // Optional, 'owner/repo' or 'host/owner/repo'
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`  // Optional, 'open', 'closed' or 'merged'
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSessionRequest) Reset() {
-	*x = UpdateSessionRequest{}
-	mi := &file_jules_proto_msgTypes[46]
+func (x *ListTrackedPullRequestsRequest) Reset() {
+	*x = ListTrackedPullRequestsRequest{}
+	mi := &file_jules_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSessionRequest) String() string {
+func (x *ListTrackedPullRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSessionRequest) ProtoMessage() {}
+func (*ListTrackedPullRequestsRequest) ProtoMessage() {}
 
-func (x *UpdateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[46]
+func (x *ListTrackedPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3321,40 +7688,54 @@ func (x *UpdateSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSessionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ListTrackedPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrackedPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *UpdateSessionRequest) GetId() string {
+func (x *ListTrackedPullRequestsRequest) GetRepo() string {
 	if x != nil {
-		return x.Id
+		return x.Repo
 	}
 	return ""
 }
 
-type DeleteSessionRequest struct {
+func (x *ListTrackedPullRequestsRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListTrackedPullRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTrackedPullRequestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PullRequests  []*TrackedPullRequest  `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSessionRequest) Reset() {
-	*x = DeleteSessionRequest{}
-	mi := &file_jules_proto_msgTypes[47]
+func (x *ListTrackedPullRequestsResponse) Reset() {
+	*x = ListTrackedPullRequestsResponse{}
+	mi := &file_jules_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSessionRequest) String() string {
+func (x *ListTrackedPullRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSessionRequest) ProtoMessage() {}
+func (*ListTrackedPullRequestsResponse) ProtoMessage() {}
 
-func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[47]
+func (x *ListTrackedPullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,40 +7746,57 @@ func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListTrackedPullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrackedPullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *DeleteSessionRequest) GetId() string {
+func (x *ListTrackedPullRequestsResponse) GetPullRequests() []*TrackedPullRequest {
 	if x != nil {
-		return x.Id
+		return x.PullRequests
 	}
-	return ""
+	return nil
 }
 
-type ApprovePlanRequest struct {
+// TrackedPullRequest is a PR the PR monitor has seen, with what it did about it.
+type TrackedPullRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Number        int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	HeadSha       string                 `protobuf:"bytes,5,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+	State         string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`                             // 'open', 'closed' or 'merged'
+	LastStatus    string                 `protobuf:"bytes,7,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"` // Combined status of the head: 'success', 'pending' or 'failure'
+	NagCount      int32                  `protobuf:"varint,8,opt,name=nag_count,json=nagCount,proto3" json:"nag_count,omitempty"`      // Failure reports since the checks last passed
+	FirstFailedAt string                 `protobuf:"bytes,9,opt,name=first_failed_at,json=firstFailedAt,proto3" json:"first_failed_at,omitempty"`
+	LastAction    string                 `protobuf:"bytes,10,opt,name=last_action,json=lastAction,proto3" json:"last_action,omitempty"`
+	LastActionAt  string                 `protobuf:"bytes,11,opt,name=last_action_at,json=lastActionAt,proto3" json:"last_action_at,omitempty"`
+	MergedByHub   bool                   `protobuf:"varint,12,opt,name=merged_by_hub,json=mergedByHub,proto3" json:"merged_by_hub,omitempty"`
+	ClosedByHub   bool                   `protobuf:"varint,13,opt,name=closed_by_hub,json=closedByHub,proto3" json:"closed_by_hub,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastReportAt  string                 `protobuf:"bytes,16,opt,name=last_report_at,json=lastReportAt,proto3" json:"last_report_at,omitempty"`
+	EscalatedAt   string                 `protobuf:"bytes,17,opt,name=escalated_at,json=escalatedAt,proto3" json:"escalated_at,omitempty"` // When failure reports stopped and the PR was escalated
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApprovePlanRequest) Reset() {
-	*x = ApprovePlanRequest{}
-	mi := &file_jules_proto_msgTypes[48]
+func (x *TrackedPullRequest) Reset() {
+	*x = TrackedPullRequest{}
+	mi := &file_jules_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApprovePlanRequest) String() string {
+func (x *TrackedPullRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApprovePlanRequest) ProtoMessage() {}
+func (*TrackedPullRequest) ProtoMessage() {}
 
-func (x *ApprovePlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[48]
+func (x *TrackedPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3409,174 +7807,154 @@ func (x *ApprovePlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApprovePlanRequest.ProtoReflect.Descriptor instead.
-func (*ApprovePlanRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use TrackedPullRequest.ProtoReflect.Descriptor instead.
+func (*TrackedPullRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *ApprovePlanRequest) GetId() string {
+func (x *TrackedPullRequest) GetRepo() string {
 	if x != nil {
-		return x.Id
+		return x.Repo
 	}
 	return ""
 }
 
-type SendMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
-	mi := &file_jules_proto_msgTypes[49]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TrackedPullRequest) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
 }
 
-func (x *SendMessageRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TrackedPullRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
 }
 
-func (*SendMessageRequest) ProtoMessage() {}
-
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[49]
+func (x *TrackedPullRequest) GetSessionId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SessionId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{49}
+func (x *TrackedPullRequest) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
 }
 
-func (x *SendMessageRequest) GetId() string {
+func (x *TrackedPullRequest) GetState() string {
 	if x != nil {
-		return x.Id
+		return x.State
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetMessage() string {
+func (x *TrackedPullRequest) GetLastStatus() string {
 	if x != nil {
-		return x.Message
+		return x.LastStatus
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetForce() bool {
+func (x *TrackedPullRequest) GetNagCount() int32 {
 	if x != nil {
-		return x.Force
+		return x.NagCount
 	}
-	return false
+	return 0
 }
 
-type ChatConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	AgentName     string                 `protobuf:"bytes,2,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"` // Unique name for the agent in this job
-	ApiKey        string                 `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`          // Unique secret key for this agent
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TrackedPullRequest) GetFirstFailedAt() string {
+	if x != nil {
+		return x.FirstFailedAt
+	}
+	return ""
 }
 
-func (x *ChatConfig) Reset() {
-	*x = ChatConfig{}
-	mi := &file_jules_proto_msgTypes[50]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TrackedPullRequest) GetLastAction() string {
+	if x != nil {
+		return x.LastAction
+	}
+	return ""
 }
 
-func (x *ChatConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TrackedPullRequest) GetLastActionAt() string {
+	if x != nil {
+		return x.LastActionAt
+	}
+	return ""
 }
 
-func (*ChatConfig) ProtoMessage() {}
-
-func (x *ChatConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[50]
+func (x *TrackedPullRequest) GetMergedByHub() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MergedByHub
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ChatConfig.ProtoReflect.Descriptor instead.
-func (*ChatConfig) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{50}
+func (x *TrackedPullRequest) GetClosedByHub() bool {
+	if x != nil {
+		return x.ClosedByHub
+	}
+	return false
 }
 
-func (x *ChatConfig) GetJobId() string {
+func (x *TrackedPullRequest) GetCreatedAt() string {
 	if x != nil {
-		return x.JobId
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *ChatConfig) GetAgentName() string {
+func (x *TrackedPullRequest) GetUpdatedAt() string {
 	if x != nil {
-		return x.AgentName
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *ChatConfig) GetApiKey() string {
+func (x *TrackedPullRequest) GetLastReportAt() string {
 	if x != nil {
-		return x.ApiKey
+		return x.LastReportAt
 	}
 	return ""
 }
 
-func (x *ChatConfig) GetCreatedAt() string {
+func (x *TrackedPullRequest) GetEscalatedAt() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.EscalatedAt
 	}
 	return ""
 }
 
-type ChatMessage struct {
+type ListFlakyChecksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	SenderName    string                 `protobuf:"bytes,3,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IsHuman       bool                   `protobuf:"varint,6,opt,name=is_human,json=isHuman,proto3" json:"is_human,omitempty"`
-	Recipient     string                 `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"` // Optional: if set, only visible to this recipient (and sender)
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`                             // Optional
+	MinFlakes     int32                  `protobuf:"varint,2,opt,name=min_flakes,json=minFlakes,proto3" json:"min_flakes,omitempty"` // Defaults to 1
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                          // Defaults to 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatMessage) Reset() {
-	*x = ChatMessage{}
-	mi := &file_jules_proto_msgTypes[51]
+func (x *ListFlakyChecksRequest) Reset() {
+	*x = ListFlakyChecksRequest{}
+	mi := &file_jules_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatMessage) String() string {
+func (x *ListFlakyChecksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessage) ProtoMessage() {}
+func (*ListFlakyChecksRequest) ProtoMessage() {}
 
-func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[51]
+func (x *ListFlakyChecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3587,83 +7965,54 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
-func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *ChatMessage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ChatMessage) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
-}
-
-func (x *ChatMessage) GetSenderName() string {
-	if x != nil {
-		return x.SenderName
-	}
-	return ""
-}
-
-func (x *ChatMessage) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+// Deprecated: Use ListFlakyChecksRequest.ProtoReflect.Descriptor instead.
+func (*ListFlakyChecksRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ChatMessage) GetCreatedAt() string {
+func (x *ListFlakyChecksRequest) GetRepo() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetIsHuman() bool {
+func (x *ListFlakyChecksRequest) GetMinFlakes() int32 {
 	if x != nil {
-		return x.IsHuman
+		return x.MinFlakes
 	}
-	return false
+	return 0
 }
 
-func (x *ChatMessage) GetRecipient() string {
+func (x *ListFlakyChecksRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Recipient
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type GetChatConfigRequest struct {
+type ListFlakyChecksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	AgentName     string                 `protobuf:"bytes,2,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"` // Retrieve config for specific agent
+	Checks        []*FlakyCheck          `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChatConfigRequest) Reset() {
-	*x = GetChatConfigRequest{}
-	mi := &file_jules_proto_msgTypes[52]
+func (x *ListFlakyChecksResponse) Reset() {
+	*x = ListFlakyChecksResponse{}
+	mi := &file_jules_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChatConfigRequest) String() string {
+func (x *ListFlakyChecksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatConfigRequest) ProtoMessage() {}
+func (*ListFlakyChecksResponse) ProtoMessage() {}
 
-func (x *GetChatConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[52]
+func (x *ListFlakyChecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3671,51 +8020,45 @@ func (x *GetChatConfigRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetChatConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetChatConfigRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *GetChatConfigRequest) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetChatConfigRequest) GetAgentName() string {
+// Deprecated: Use ListFlakyChecksResponse.ProtoReflect.Descriptor instead.
+func (*ListFlakyChecksResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListFlakyChecksResponse) GetChecks() []*FlakyCheck {
 	if x != nil {
-		return x.AgentName
+		return x.Checks
 	}
-	return ""
+	return nil
 }
 
-type CreateChatConfigRequest struct {
+type SetFlakyCheckIgnoredRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	AgentName     string                 `protobuf:"bytes,2,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	CheckName     string                 `protobuf:"bytes,2,opt,name=check_name,json=checkName,proto3" json:"check_name,omitempty"`
+	Ignored       bool                   `protobuf:"varint,3,opt,name=ignored,proto3" json:"ignored,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChatConfigRequest) Reset() {
-	*x = CreateChatConfigRequest{}
-	mi := &file_jules_proto_msgTypes[53]
+func (x *SetFlakyCheckIgnoredRequest) Reset() {
+	*x = SetFlakyCheckIgnoredRequest{}
+	mi := &file_jules_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChatConfigRequest) String() string {
+func (x *SetFlakyCheckIgnoredRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChatConfigRequest) ProtoMessage() {}
+func (*SetFlakyCheckIgnoredRequest) ProtoMessage() {}
 
-func (x *CreateChatConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[53]
+func (x *SetFlakyCheckIgnoredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3726,52 +8069,63 @@ func (x *CreateChatConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChatConfigRequest.ProtoReflect.Descriptor instead.
-func (*CreateChatConfigRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use SetFlakyCheckIgnoredRequest.ProtoReflect.Descriptor instead.
+func (*SetFlakyCheckIgnoredRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CreateChatConfigRequest) GetJobId() string {
+func (x *SetFlakyCheckIgnoredRequest) GetRepo() string {
 	if x != nil {
-		return x.JobId
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *CreateChatConfigRequest) GetAgentName() string {
+func (x *SetFlakyCheckIgnoredRequest) GetCheckName() string {
 	if x != nil {
-		return x.AgentName
+		return x.CheckName
 	}
 	return ""
 }
 
-type SendChatMessageRequest struct {
+func (x *SetFlakyCheckIgnoredRequest) GetIgnored() bool {
+	if x != nil {
+		return x.Ignored
+	}
+	return false
+}
+
+// FlakyCheck holds the rerun statistics of a check in a repo.
+type FlakyCheck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	ApiKey        string                 `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // Used to identify sender
-	IsHuman       bool                   `protobuf:"varint,4,opt,name=is_human,json=isHuman,proto3" json:"is_human,omitempty"`
-	SenderName    string                 `protobuf:"bytes,5,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"` // Optional, only for Human
-	Recipient     string                 `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`                     // Optional: target specific agent or "Human"
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	CheckName     string                 `protobuf:"bytes,2,opt,name=check_name,json=checkName,proto3" json:"check_name,omitempty"`
+	Failures      int32                  `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"` // Commits the check failed on
+	Reruns        int32                  `protobuf:"varint,4,opt,name=reruns,proto3" json:"reruns,omitempty"`
+	Flakes        int32                  `protobuf:"varint,5,opt,name=flakes,proto3" json:"flakes,omitempty"`                         // Commits the check passed on after a rerun
+	FlakeRate     float64                `protobuf:"fixed64,6,opt,name=flake_rate,json=flakeRate,proto3" json:"flake_rate,omitempty"` // flakes / failures
+	Ignored       bool                   `protobuf:"varint,7,opt,name=ignored,proto3" json:"ignored,omitempty"`                       // Failures of the check are not reported
+	LastFailedAt  string                 `protobuf:"bytes,8,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"`
+	LastFlakeAt   string                 `protobuf:"bytes,9,opt,name=last_flake_at,json=lastFlakeAt,proto3" json:"last_flake_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendChatMessageRequest) Reset() {
-	*x = SendChatMessageRequest{}
-	mi := &file_jules_proto_msgTypes[54]
+func (x *FlakyCheck) Reset() {
+	*x = FlakyCheck{}
+	mi := &file_jules_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendChatMessageRequest) String() string {
+func (x *FlakyCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatMessageRequest) ProtoMessage() {}
+func (*FlakyCheck) ProtoMessage() {}
 
-func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[54]
+func (x *FlakyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3782,78 +8136,98 @@ func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use FlakyCheck.ProtoReflect.Descriptor instead.
+func (*FlakyCheck) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *SendChatMessageRequest) GetJobId() string {
+func (x *FlakyCheck) GetRepo() string {
 	if x != nil {
-		return x.JobId
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *SendChatMessageRequest) GetContent() string {
+func (x *FlakyCheck) GetCheckName() string {
 	if x != nil {
-		return x.Content
+		return x.CheckName
 	}
 	return ""
 }
 
-func (x *SendChatMessageRequest) GetApiKey() string {
+func (x *FlakyCheck) GetFailures() int32 {
 	if x != nil {
-		return x.ApiKey
+		return x.Failures
 	}
-	return ""
+	return 0
 }
 
-func (x *SendChatMessageRequest) GetIsHuman() bool {
+func (x *FlakyCheck) GetReruns() int32 {
 	if x != nil {
-		return x.IsHuman
+		return x.Reruns
+	}
+	return 0
+}
+
+func (x *FlakyCheck) GetFlakes() int32 {
+	if x != nil {
+		return x.Flakes
+	}
+	return 0
+}
+
+func (x *FlakyCheck) GetFlakeRate() float64 {
+	if x != nil {
+		return x.FlakeRate
+	}
+	return 0
+}
+
+func (x *FlakyCheck) GetIgnored() bool {
+	if x != nil {
+		return x.Ignored
 	}
 	return false
 }
 
-func (x *SendChatMessageRequest) GetSenderName() string {
+func (x *FlakyCheck) GetLastFailedAt() string {
 	if x != nil {
-		return x.SenderName
+		return x.LastFailedAt
 	}
 	return ""
 }
 
-func (x *SendChatMessageRequest) GetRecipient() string {
+func (x *FlakyCheck) GetLastFlakeAt() string {
 	if x != nil {
-		return x.Recipient
+		return x.LastFlakeAt
 	}
 	return ""
 }
 
-type ListChatMessagesRequest struct {
+type ListMergeDecisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Since         string                 `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"` // ISO timestamp to fetch only new messages
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	ViewerName    string                 `protobuf:"bytes,4,opt,name=viewer_name,json=viewerName,proto3" json:"viewer_name,omitempty"` // Optional: strictly filter messages visible to this viewer (Agent Name or "Human")
+	Repo          string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`      // Optional
+	Number        int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"` // Optional, with repo
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`   // Defaults to 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChatMessagesRequest) Reset() {
-	*x = ListChatMessagesRequest{}
-	mi := &file_jules_proto_msgTypes[55]
+func (x *ListMergeDecisionsRequest) Reset() {
+	*x = ListMergeDecisionsRequest{}
+	mi := &file_jules_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChatMessagesRequest) String() string {
+func (x *ListMergeDecisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChatMessagesRequest) ProtoMessage() {}
+func (*ListMergeDecisionsRequest) ProtoMessage() {}
 
-func (x *ListChatMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[55]
+func (x *ListMergeDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3864,61 +8238,105 @@ func (x *ListChatMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChatMessagesRequest.ProtoReflect.Descriptor instead.
-func (*ListChatMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListMergeDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMergeDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ListChatMessagesRequest) GetJobId() string {
+func (x *ListMergeDecisionsRequest) GetRepo() string {
 	if x != nil {
-		return x.JobId
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *ListChatMessagesRequest) GetSince() string {
+func (x *ListMergeDecisionsRequest) GetNumber() int32 {
 	if x != nil {
-		return x.Since
+		return x.Number
 	}
-	return ""
+	return 0
 }
 
-func (x *ListChatMessagesRequest) GetLimit() int32 {
+func (x *ListMergeDecisionsRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *ListChatMessagesRequest) GetViewerName() string {
+type ListMergeDecisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decisions     []*MergeDecision       `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMergeDecisionsResponse) Reset() {
+	*x = ListMergeDecisionsResponse{}
+	mi := &file_jules_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMergeDecisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMergeDecisionsResponse) ProtoMessage() {}
+
+func (x *ListMergeDecisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[111]
 	if x != nil {
-		return x.ViewerName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ListChatMessagesResponse struct {
+// Deprecated: Use ListMergeDecisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMergeDecisionsResponse) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListMergeDecisionsResponse) GetDecisions() []*MergeDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+// MergeDecision records the auto-merge policy's decision on a PR.
+type MergeDecision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	HeadSha       string                 `protobuf:"bytes,4,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+	Merged        bool                   `protobuf:"varint,5,opt,name=merged,proto3" json:"merged,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"` // Why the PR was merged or not
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChatMessagesResponse) Reset() {
-	*x = ListChatMessagesResponse{}
-	mi := &file_jules_proto_msgTypes[56]
+func (x *MergeDecision) Reset() {
+	*x = MergeDecision{}
+	mi := &file_jules_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChatMessagesResponse) String() string {
+func (x *MergeDecision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChatMessagesResponse) ProtoMessage() {}
+func (*MergeDecision) ProtoMessage() {}
 
-func (x *ListChatMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jules_proto_msgTypes[56]
+func (x *MergeDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_jules_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3929,23 +8347,65 @@ func (x *ListChatMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChatMessagesResponse.ProtoReflect.Descriptor instead.
-func (*ListChatMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_jules_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use MergeDecision.ProtoReflect.Descriptor instead.
+func (*MergeDecision) Descriptor() ([]byte, []int) {
+	return file_jules_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *ListChatMessagesResponse) GetMessages() []*ChatMessage {
+func (x *MergeDecision) GetId() string {
 	if x != nil {
-		return x.Messages
+		return x.Id
 	}
-	return nil
+	return ""
+}
+
+func (x *MergeDecision) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *MergeDecision) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *MergeDecision) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
+}
+
+func (x *MergeDecision) GetMerged() bool {
+	if x != nil {
+		return x.Merged
+	}
+	return false
+}
+
+func (x *MergeDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MergeDecision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
 var File_jules_proto protoreflect.FileDescriptor
 
 const file_jules_proto_rawDesc = "" +
 	"\n" +
-	"\vjules.proto\x12\x05jules\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x87\x11\n" +
+	"\vjules.proto\x12\x05jules\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe3\x18\n" +
 	"\bSettings\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
 	"\x12idle_poll_interval\x18\x02 \x01(\x05R\x10idlePollInterval\x120\n" +
@@ -3988,7 +8448,24 @@ const file_jules_proto_rawDesc = "" +
 	"\x11auto_merge_method\x18$ \x01(\tR\x0fautoMergeMethod\x12,\n" +
 	"\x12auto_merge_message\x18% \x01(\tR\x10autoMergeMessage\x12B\n" +
 	"\x1eauto_close_on_conflict_message\x18& \x01(\tR\x1aautoCloseOnConflictMessage\x12>\n" +
-	"\x1cclose_pr_on_conflict_enabled\x18' \x01(\bR\x18closePrOnConflictEnabled\"3\n" +
+	"\x1cclose_pr_on_conflict_enabled\x18' \x01(\bR\x18closePrOnConflictEnabled\x128\n" +
+	"\x18issue_automation_enabled\x18( \x01(\bR\x16issueAutomationEnabled\x124\n" +
+	"\x16issue_automation_label\x18) \x01(\tR\x14issueAutomationLabel\x124\n" +
+	"\x16issue_automation_repos\x18* \x01(\tR\x14issueAutomationRepos\x12(\n" +
+	"\x10chat_ops_enabled\x18+ \x01(\bR\x0echatOpsEnabled\x12G\n" +
+	" check_failing_actions_escalation\x18, \x01(\tR\x1dcheckFailingActionsEscalation\x12T\n" +
+	"'check_failing_actions_escalation_target\x18- \x01(\tR#checkFailingActionsEscalationTarget\x123\n" +
+	"\x16flaky_check_max_reruns\x18. \x01(\x05R\x13flakyCheckMaxReruns\x121\n" +
+	"\x14informational_checks\x18/ \x01(\tR\x13informationalChecks\x12(\n" +
+	"\x10auto_merge_scope\x180 \x01(\tR\x0eautoMergeScope\x12(\n" +
+	"\x10auto_merge_label\x181 \x01(\tR\x0eautoMergeLabel\x12A\n" +
+	"\x1dauto_merge_required_approvals\x182 \x01(\x05R\x1aautoMergeRequiredApprovals\x12J\n" +
+	"\"auto_merge_block_changes_requested\x183 \x01(\bR\x1eautoMergeBlockChangesRequested\x127\n" +
+	"\x18auto_merge_max_additions\x184 \x01(\x05R\x15autoMergeMaxAdditions\x127\n" +
+	"\x18auto_merge_max_deletions\x185 \x01(\x05R\x15autoMergeMaxDeletions\x12/\n" +
+	"\x14auto_merge_max_files\x186 \x01(\x05R\x11autoMergeMaxFiles\x12;\n" +
+	"\x1aauto_merge_protected_paths\x187 \x01(\tR\x17autoMergeProtectedPaths\x12@\n" +
+	"\x1dauto_merge_require_base_green\x188 \x01(\bR\x19autoMergeRequireBaseGreen\"3\n" +
 	"\x12GetSettingsRequest\x12\x1d\n" +
 	"\n" +
 	"profile_id\x18\x01 \x01(\tR\tprofileId\"D\n" +
@@ -4014,7 +8491,7 @@ const file_jules_proto_rawDesc = "" +
 	"\x0eGetLogsRequest\x12\x14\n" +
 	"\x05since\x18\x01 \x01(\tR\x05since\"6\n" +
 	"\x0fGetLogsResponse\x12#\n" +
-	"\x04logs\x18\x01 \x03(\v2\x0f.jules.LogEntryR\x04logs\"\xe2\x03\n" +
+	"\x04logs\x18\x01 \x03(\v2\x0f.jules.LogEntryR\x04logs\"\xc3\x05\n" +
 	"\aCronJob\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -4034,9 +8511,15 @@ const file_jules_proto_rawDesc = "" +
 	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x0e \x01(\tR\tupdatedAt\x12\x1e\n" +
-	"\vlast_run_at\x18\x0f \x01(\tR\tlastRunAt\"C\n" +
+	"\vlast_run_at\x18\x0f \x01(\tR\tlastRunAt\x12(\n" +
+	"\x06matrix\x18\x10 \x01(\v2\x10.jules.JobMatrixR\x06matrix\x12\x1a\n" +
+	"\bpriority\x18\x11 \x01(\x05R\bpriority\x12\x1b\n" +
+	"\ttime_zone\x18\x12 \x01(\tR\btimeZone\x12-\n" +
+	"\bcatch_up\x18\x13 \x01(\x0e2\x12.jules.CronCatchUpR\acatchUp\x12%\n" +
+	"\x0ejitter_seconds\x18\x14 \x01(\x05R\rjitterSeconds\x12&\n" +
+	"\x0fskip_if_running\x18\x15 \x01(\bR\rskipIfRunning\"C\n" +
 	"\x14ListCronJobsResponse\x12+\n" +
-	"\tcron_jobs\x18\x01 \x03(\v2\x0e.jules.CronJobR\bcronJobs\"\xe7\x02\n" +
+	"\tcron_jobs\x18\x01 \x03(\v2\x0e.jules.CronJobR\bcronJobs\"\xd8\x04\n" +
 	"\x14CreateCronJobRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bschedule\x18\x02 \x01(\tR\bschedule\x12\x16\n" +
@@ -4049,7 +8532,14 @@ const file_jules_proto_rawDesc = "" +
 	"\rsession_count\x18\t \x01(\x05R\fsessionCount\x12\x1d\n" +
 	"\n" +
 	"profile_id\x18\n" +
-	" \x01(\tR\tprofileId\"\xb7\x04\n" +
+	" \x01(\tR\tprofileId\x12\x0e\n" +
+	"\x02id\x18\v \x01(\tR\x02id\x12(\n" +
+	"\x06matrix\x18\f \x01(\v2\x10.jules.JobMatrixR\x06matrix\x12\x1a\n" +
+	"\bpriority\x18\r \x01(\x05R\bpriority\x12\x1b\n" +
+	"\ttime_zone\x18\x0e \x01(\tR\btimeZone\x12-\n" +
+	"\bcatch_up\x18\x0f \x01(\x0e2\x12.jules.CronCatchUpR\acatchUp\x12%\n" +
+	"\x0ejitter_seconds\x18\x10 \x01(\x05R\rjitterSeconds\x12&\n" +
+	"\x0fskip_if_running\x18\x11 \x01(\bR\rskipIfRunning\"\x80\a\n" +
 	"\x14UpdateCronJobRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
@@ -4062,7 +8552,14 @@ const file_jules_proto_rawDesc = "" +
 	"\x15require_plan_approval\x18\t \x01(\bH\aR\x13requirePlanApproval\x88\x01\x01\x12(\n" +
 	"\rsession_count\x18\n" +
 	" \x01(\x05H\bR\fsessionCount\x88\x01\x01\x12\x1d\n" +
-	"\aenabled\x18\v \x01(\bH\tR\aenabled\x88\x01\x01B\a\n" +
+	"\aenabled\x18\v \x01(\bH\tR\aenabled\x88\x01\x01\x12(\n" +
+	"\x06matrix\x18\f \x01(\v2\x10.jules.JobMatrixR\x06matrix\x12\x1f\n" +
+	"\bpriority\x18\r \x01(\x05H\n" +
+	"R\bpriority\x88\x01\x01\x12 \n" +
+	"\ttime_zone\x18\x0e \x01(\tH\vR\btimeZone\x88\x01\x01\x122\n" +
+	"\bcatch_up\x18\x0f \x01(\x0e2\x12.jules.CronCatchUpH\fR\acatchUp\x88\x01\x01\x12*\n" +
+	"\x0ejitter_seconds\x18\x10 \x01(\x05H\rR\rjitterSeconds\x88\x01\x01\x12+\n" +
+	"\x0fskip_if_running\x18\x11 \x01(\bH\x0eR\rskipIfRunning\x88\x01\x01B\a\n" +
 	"\x05_nameB\v\n" +
 	"\t_scheduleB\t\n" +
 	"\a_promptB\a\n" +
@@ -4073,14 +8570,47 @@ const file_jules_proto_rawDesc = "" +
 	"\x16_require_plan_approvalB\x10\n" +
 	"\x0e_session_countB\n" +
 	"\n" +
-	"\b_enabled\"&\n" +
+	"\b_enabledB\v\n" +
+	"\t_priorityB\f\n" +
+	"\n" +
+	"_time_zoneB\v\n" +
+	"\t_catch_upB\x11\n" +
+	"\x0f_jitter_secondsB\x12\n" +
+	"\x10_skip_if_running\"&\n" +
 	"\x14DeleteCronJobRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
 	"\x15ExecuteCronJobRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xdf\x02\n" +
+	"\aCronRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\vcron_job_id\x18\x02 \x01(\tR\tcronJobId\x12,\n" +
+	"\atrigger\x18\x03 \x01(\x0e2\x12.jules.CronTriggerR\atrigger\x12!\n" +
+	"\fscheduled_at\x18\x04 \x01(\tR\vscheduledAt\x12!\n" +
+	"\ftriggered_at\x18\x05 \x01(\tR\vtriggeredAt\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x15\n" +
+	"\x06job_id\x18\b \x01(\tR\x05jobId\x12/\n" +
+	"\n" +
+	"job_status\x18\t \x01(\x0e2\x10.jules.JobStatusR\tjobStatus\x12\x17\n" +
+	"\apr_urls\x18\n" +
+	" \x03(\tR\x06prUrls\x12\x1d\n" +
+	"\n" +
+	"prs_merged\x18\v \x01(\x05R\tprsMerged\"K\n" +
+	"\x13ListCronRunsRequest\x12\x1e\n" +
+	"\vcron_job_id\x18\x01 \x01(\tR\tcronJobId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\":\n" +
+	"\x14ListCronRunsResponse\x12\"\n" +
+	"\x04runs\x18\x01 \x03(\v2\x0e.jules.CronRunR\x04runs\"\x81\x01\n" +
+	"\x1aPreviewCronScheduleRequest\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05after\x18\x04 \x01(\tR\x05after\"3\n" +
+	"\x1bPreviewCronScheduleResponse\x12\x14\n" +
+	"\x05times\x18\x01 \x03(\tR\x05times\"@\n" +
 	"\x14ToggleCronJobRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\aenabled\x18\x02 \x01(\bR\aenabled\"\x85\x04\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"\x96\x06\n" +
 	"\x03Job\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
@@ -4103,12 +8633,50 @@ const file_jules_proto_rawDesc = "" +
 	"\vcron_job_id\x18\x0e \x01(\tR\tcronJobId\x12\x1d\n" +
 	"\n" +
 	"profile_id\x18\x0f \x01(\tR\tprofileId\x12!\n" +
-	"\fchat_enabled\x18\x10 \x01(\bR\vchatEnabled\"2\n" +
+	"\fchat_enabled\x18\x10 \x01(\bR\vchatEnabled\x12&\n" +
+	"\x05state\x18\x11 \x01(\x0e2\x10.jules.JobStatusR\x05state\x12:\n" +
+	"\rsession_slots\x18\x12 \x03(\v2\x15.jules.JobSessionSlotR\fsessionSlots\x12\"\n" +
+	"\rparent_job_id\x18\x13 \x01(\tR\vparentJobId\x12(\n" +
+	"\x06matrix\x18\x14 \x01(\v2\x10.jules.JobMatrixR\x06matrix\x12A\n" +
+	"\x0ftarget_progress\x18\x15 \x03(\v2\x18.jules.JobTargetProgressR\x0etargetProgress\x12\x1a\n" +
+	"\bpriority\x18\x16 \x01(\x05R\bpriority\"7\n" +
+	"\tJobMatrix\x12*\n" +
+	"\atargets\x18\x01 \x03(\v2\x10.jules.JobTargetR\atargets\"\xc5\x01\n" +
+	"\tJobTarget\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x16\n" +
+	"\x06branch\x18\x02 \x01(\tR\x06branch\x12.\n" +
+	"\x04vars\x18\x03 \x03(\v2\x1a.jules.JobTarget.VarsEntryR\x04vars\x12#\n" +
+	"\rsession_count\x18\x04 \x01(\x05R\fsessionCount\x1a7\n" +
+	"\tVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe5\x01\n" +
+	"\x11JobTargetProgress\x12!\n" +
+	"\ftarget_index\x18\x01 \x01(\x05R\vtargetIndex\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x16\n" +
+	"\x06branch\x18\x03 \x01(\tR\x06branch\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x05 \x01(\x05R\acreated\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\x12\x18\n" +
+	"\apending\x18\a \x01(\x05R\apending\x12\x1f\n" +
+	"\vsession_ids\x18\b \x03(\tR\n" +
+	"sessionIds\"\xe3\x01\n" +
+	"\x0eJobSessionSlot\x12\x1d\n" +
+	"\n" +
+	"slot_index\x18\x01 \x01(\x05R\tslotIndex\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12!\n" +
+	"\ftarget_index\x18\a \x01(\x05R\vtargetIndex\"2\n" +
 	"\x10ListJobsResponse\x12\x1e\n" +
 	"\x04jobs\x18\x01 \x03(\v2\n" +
 	".jules.JobR\x04jobs\"\x1f\n" +
 	"\rGetJobRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x92\x04\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xfc\x04\n" +
 	"\x10CreateJobRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
@@ -4131,7 +8699,10 @@ const file_jules_proto_rawDesc = "" +
 	"\vcron_job_id\x18\x0e \x01(\tR\tcronJobId\x12\x1d\n" +
 	"\n" +
 	"profile_id\x18\x0f \x01(\tR\tprofileId\x12!\n" +
-	"\fchat_enabled\x18\x10 \x01(\bR\vchatEnabled\"D\n" +
+	"\fchat_enabled\x18\x10 \x01(\bR\vchatEnabled\x12\"\n" +
+	"\rparent_job_id\x18\x11 \x01(\tR\vparentJobId\x12(\n" +
+	"\x06matrix\x18\x12 \x01(\v2\x10.jules.JobMatrixR\x06matrix\x12\x1a\n" +
+	"\bpriority\x18\x13 \x01(\x05R\bpriority\"D\n" +
 	"\x15CreateManyJobsRequest\x12+\n" +
 	"\x04jobs\x18\x01 \x03(\v2\x17.jules.CreateJobRequestR\x04jobs\"\xb6\x01\n" +
 	"\x10UpdateJobRequest\x12\x0e\n" +
@@ -4143,9 +8714,41 @@ const file_jules_proto_rawDesc = "" +
 	"\x05_nameB\t\n" +
 	"\a_statusB\a\n" +
 	"\x05_repoB\t\n" +
-	"\a_branch\"\"\n" +
+	"\a_branch\"X\n" +
 	"\x10DeleteJobRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"o\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x16delete_remote_sessions\x18\x02 \x01(\bR\x14deleteRemoteSessions\",\n" +
+	"\x1aRetryFailedSessionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xbc\x04\n" +
+	"\x0fRerunJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1b\n" +
+	"\x06prompt\x18\x03 \x01(\tH\x01R\x06prompt\x88\x01\x01\x12\x17\n" +
+	"\x04repo\x18\x04 \x01(\tH\x02R\x04repo\x88\x01\x01\x12\x1b\n" +
+	"\x06branch\x18\x05 \x01(\tH\x03R\x06branch\x88\x01\x01\x12(\n" +
+	"\rsession_count\x18\x06 \x01(\x05H\x04R\fsessionCount\x88\x01\x01\x12(\n" +
+	"\rauto_approval\x18\a \x01(\bH\x05R\fautoApproval\x88\x01\x01\x12C\n" +
+	"\x0fautomation_mode\x18\b \x01(\x0e2\x15.jules.AutomationModeH\x06R\x0eautomationMode\x88\x01\x01\x127\n" +
+	"\x15require_plan_approval\x18\t \x01(\bH\aR\x13requirePlanApproval\x88\x01\x01\x12&\n" +
+	"\fchat_enabled\x18\n" +
+	" \x01(\bH\bR\vchatEnabled\x88\x01\x01\x12(\n" +
+	"\x06matrix\x18\v \x01(\v2\x10.jules.JobMatrixR\x06matrixB\a\n" +
+	"\x05_nameB\t\n" +
+	"\a_promptB\a\n" +
+	"\x05_repoB\t\n" +
+	"\a_branchB\x10\n" +
+	"\x0e_session_countB\x10\n" +
+	"\x0e_auto_approvalB\x12\n" +
+	"\x10_automation_modeB\x18\n" +
+	"\x16_require_plan_approvalB\x0f\n" +
+	"\r_chat_enabled\",\n" +
+	"\x1aRerunFailedSessionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\"\n" +
+	"\x10CancelJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"u\n" +
+	"\x11CancelJobResponse\x122\n" +
+	"\x15cancelled_session_ids\x18\x01 \x03(\tR\x13cancelledSessionIds\x12,\n" +
+	"\x12failed_session_ids\x18\x02 \x03(\tR\x10failedSessionIds\"o\n" +
 	"\x10PredefinedPrompt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
@@ -4237,9 +8840,10 @@ const file_jules_proto_rawDesc = "" +
 	"\n" +
 	"profile_id\x18\x05 \x01(\tR\tprofileId\"&\n" +
 	"\x14UpdateSessionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
 	"\x14DeleteSessionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rdelete_remote\x18\x02 \x01(\bR\fdeleteRemote\"$\n" +
 	"\x12ApprovePlanRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
 	"\x12SendMessageRequest\x12\x0e\n" +
@@ -4287,7 +8891,273 @@ const file_jules_proto_rawDesc = "" +
 	"\vviewer_name\x18\x04 \x01(\tR\n" +
 	"viewerName\"J\n" +
 	"\x18ListChatMessagesResponse\x12.\n" +
-	"\bmessages\x18\x01 \x03(\v2\x12.jules.ChatMessageR\bmessages*Q\n" +
+	"\bmessages\x18\x01 \x03(\v2\x12.jules.ChatMessageR\bmessages\"X\n" +
+	"\x11ApplyStateRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05prune\x18\x03 \x01(\bR\x05prune\"\x80\x01\n" +
+	"\vStateChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x03 \x01(\tR\tprofileId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06fields\x18\x05 \x03(\tR\x06fields\"[\n" +
+	"\x12ApplyStateResponse\x12,\n" +
+	"\achanges\x18\x01 \x03(\v2\x12.jules.StateChangeR\achanges\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xa0\x01\n" +
+	"\fPipelineStep\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x03job\x18\x02 \x01(\v2\x17.jules.CreateJobRequestR\x03job\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x03 \x03(\tR\tdependsOn\x126\n" +
+	"\tcondition\x18\x04 \x01(\x0e2\x18.jules.PipelineConditionR\tcondition\"\xd6\x01\n" +
+	"\bPipeline\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x05steps\x18\x03 \x03(\v2\x13.jules.PipelineStepR\x05steps\x12\x1e\n" +
+	"\n" +
+	"sequential\x18\x04 \x01(\bR\n" +
+	"sequential\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x05 \x01(\tR\tprofileId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"F\n" +
+	"\x15ListPipelinesResponse\x12-\n" +
+	"\tpipelines\x18\x01 \x03(\v2\x0f.jules.PipelineR\tpipelines\"$\n" +
+	"\x12GetPipelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa5\x01\n" +
+	"\x15CreatePipelineRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x05steps\x18\x02 \x03(\v2\x13.jules.PipelineStepR\x05steps\x12\x1e\n" +
+	"\n" +
+	"sequential\x18\x03 \x01(\bR\n" +
+	"sequential\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x04 \x01(\tR\tprofileId\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\"\x86\x01\n" +
+	"\x15UpdatePipelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x05steps\x18\x03 \x03(\v2\x13.jules.PipelineStepR\x05steps\x12\x1e\n" +
+	"\n" +
+	"sequential\x18\x04 \x01(\bR\n" +
+	"sequential\"'\n" +
+	"\x15DeletePipelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x14StartPipelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x18CancelPipelineRunRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15GetPipelineRunRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x17ListPipelineRunsRequest\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\tR\n" +
+	"pipelineId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xb3\x01\n" +
+	"\x0fPipelineRunStep\x12\x17\n" +
+	"\astep_id\x18\x01 \x01(\tR\x06stepId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\tR\x05jobId\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x06 \x01(\tR\n" +
+	"finishedAt\"\x88\x02\n" +
+	"\vPipelineRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vpipeline_id\x18\x02 \x01(\tR\n" +
+	"pipelineId\x12#\n" +
+	"\rpipeline_name\x18\x03 \x01(\tR\fpipelineName\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12,\n" +
+	"\x05steps\x18\x05 \x03(\v2\x16.jules.PipelineRunStepR\x05steps\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vfinished_at\x18\b \x01(\tR\n" +
+	"finishedAt\"B\n" +
+	"\x18ListPipelineRunsResponse\x12&\n" +
+	"\x04runs\x18\x01 \x03(\v2\x12.jules.PipelineRunR\x04runs\">\n" +
+	"\x11EnqueueJobRequest\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.jules.CreateJobRequestR\x03job\"&\n" +
+	"\x10ListQueueRequest\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\";\n" +
+	"\x11ListQueueResponse\x12&\n" +
+	"\x05repos\x18\x01 \x03(\v2\x10.jules.RepoQueueR\x05repos\"v\n" +
+	"\tRepoQueue\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x1d\n" +
+	"\n" +
+	"max_active\x18\x02 \x01(\x05R\tmaxActive\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\x05R\x06active\x12\x1e\n" +
+	"\x04jobs\x18\x04 \x03(\v2\n" +
+	".jules.JobR\x04jobs\"C\n" +
+	"\x15SetJobPriorityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\"B\n" +
+	"\x14MoveQueuedJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"N\n" +
+	"\x19SetRepoConcurrencyRequest\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x1d\n" +
+	"\n" +
+	"max_active\x18\x02 \x01(\x05R\tmaxActive\"\xac\x04\n" +
+	"\fEventTrigger\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x05event\x18\x03 \x01(\x0e2\x13.jules.TriggerEventR\x05event\x12\x12\n" +
+	"\x04repo\x18\x04 \x01(\tR\x04repo\x12\x16\n" +
+	"\x06branch\x18\x05 \x01(\tR\x06branch\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\x12\x16\n" +
+	"\x06prompt\x18\a \x01(\tR\x06prompt\x12#\n" +
+	"\rauto_approval\x18\b \x01(\bR\fautoApproval\x12>\n" +
+	"\x0fautomation_mode\x18\t \x01(\x0e2\x15.jules.AutomationModeR\x0eautomationMode\x122\n" +
+	"\x15require_plan_approval\x18\n" +
+	" \x01(\bR\x13requirePlanApproval\x12#\n" +
+	"\rsession_count\x18\v \x01(\x05R\fsessionCount\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\f \x01(\tR\tprofileId\x12\x18\n" +
+	"\aenabled\x18\r \x01(\bR\aenabled\x12\x1a\n" +
+	"\bpriority\x18\x0e \x01(\x05R\bpriority\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\tR\tupdatedAt\x12\"\n" +
+	"\rlast_event_at\x18\x11 \x01(\tR\vlastEventAt\"L\n" +
+	"\x19ListEventTriggersResponse\x12/\n" +
+	"\btriggers\x18\x01 \x03(\v2\x13.jules.EventTriggerR\btriggers\"\xbd\x03\n" +
+	"\x19CreateEventTriggerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x05event\x18\x03 \x01(\x0e2\x13.jules.TriggerEventR\x05event\x12\x12\n" +
+	"\x04repo\x18\x04 \x01(\tR\x04repo\x12\x16\n" +
+	"\x06branch\x18\x05 \x01(\tR\x06branch\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\x12\x16\n" +
+	"\x06prompt\x18\a \x01(\tR\x06prompt\x12#\n" +
+	"\rauto_approval\x18\b \x01(\bR\fautoApproval\x12>\n" +
+	"\x0fautomation_mode\x18\t \x01(\x0e2\x15.jules.AutomationModeR\x0eautomationMode\x122\n" +
+	"\x15require_plan_approval\x18\n" +
+	" \x01(\bR\x13requirePlanApproval\x12#\n" +
+	"\rsession_count\x18\v \x01(\x05R\fsessionCount\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\f \x01(\tR\tprofileId\x12\x1a\n" +
+	"\bpriority\x18\r \x01(\x05R\bpriority\"\xe1\x04\n" +
+	"\x19UpdateEventTriggerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04repo\x18\x03 \x01(\tH\x01R\x04repo\x88\x01\x01\x12\x1b\n" +
+	"\x06branch\x18\x04 \x01(\tH\x02R\x06branch\x88\x01\x01\x12\x19\n" +
+	"\x05label\x18\x05 \x01(\tH\x03R\x05label\x88\x01\x01\x12\x1b\n" +
+	"\x06prompt\x18\x06 \x01(\tH\x04R\x06prompt\x88\x01\x01\x12(\n" +
+	"\rauto_approval\x18\a \x01(\bH\x05R\fautoApproval\x88\x01\x01\x12C\n" +
+	"\x0fautomation_mode\x18\b \x01(\x0e2\x15.jules.AutomationModeH\x06R\x0eautomationMode\x88\x01\x01\x127\n" +
+	"\x15require_plan_approval\x18\t \x01(\bH\aR\x13requirePlanApproval\x88\x01\x01\x12(\n" +
+	"\rsession_count\x18\n" +
+	" \x01(\x05H\bR\fsessionCount\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\v \x01(\bH\tR\aenabled\x88\x01\x01\x12\x1f\n" +
+	"\bpriority\x18\f \x01(\x05H\n" +
+	"R\bpriority\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_repoB\t\n" +
+	"\a_branchB\b\n" +
+	"\x06_labelB\t\n" +
+	"\a_promptB\x10\n" +
+	"\x0e_auto_approvalB\x12\n" +
+	"\x10_automation_modeB\x18\n" +
+	"\x16_require_plan_approvalB\x10\n" +
+	"\x0e_session_countB\n" +
+	"\n" +
+	"\b_enabledB\v\n" +
+	"\t_priority\"+\n" +
+	"\x19DeleteEventTriggerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x1aListTriggeredEventsRequest\x12\x1d\n" +
+	"\n" +
+	"trigger_id\x18\x01 \x01(\tR\ttriggerId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"L\n" +
+	"\x1bListTriggeredEventsResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.jules.TriggeredEventR\x06events\"\xae\x01\n" +
+	"\x0eTriggeredEvent\x12\x1d\n" +
+	"\n" +
+	"trigger_id\x18\x01 \x01(\tR\ttriggerId\x12\x1b\n" +
+	"\tevent_key\x18\x02 \x01(\tR\beventKey\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"`\n" +
+	"\x1eListTrackedPullRequestsRequest\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"a\n" +
+	"\x1fListTrackedPullRequestsResponse\x12>\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x19.jules.TrackedPullRequestR\fpullRequests\"\x9e\x04\n" +
+	"\x12TrackedPullRequest\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bhead_sha\x18\x05 \x01(\tR\aheadSha\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x1f\n" +
+	"\vlast_status\x18\a \x01(\tR\n" +
+	"lastStatus\x12\x1b\n" +
+	"\tnag_count\x18\b \x01(\x05R\bnagCount\x12&\n" +
+	"\x0ffirst_failed_at\x18\t \x01(\tR\rfirstFailedAt\x12\x1f\n" +
+	"\vlast_action\x18\n" +
+	" \x01(\tR\n" +
+	"lastAction\x12$\n" +
+	"\x0elast_action_at\x18\v \x01(\tR\flastActionAt\x12\"\n" +
+	"\rmerged_by_hub\x18\f \x01(\bR\vmergedByHub\x12\"\n" +
+	"\rclosed_by_hub\x18\r \x01(\bR\vclosedByHub\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12$\n" +
+	"\x0elast_report_at\x18\x10 \x01(\tR\flastReportAt\x12!\n" +
+	"\fescalated_at\x18\x11 \x01(\tR\vescalatedAt\"a\n" +
+	"\x16ListFlakyChecksRequest\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x1d\n" +
+	"\n" +
+	"min_flakes\x18\x02 \x01(\x05R\tminFlakes\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"D\n" +
+	"\x17ListFlakyChecksResponse\x12)\n" +
+	"\x06checks\x18\x01 \x03(\v2\x11.jules.FlakyCheckR\x06checks\"j\n" +
+	"\x1bSetFlakyCheckIgnoredRequest\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x1d\n" +
+	"\n" +
+	"check_name\x18\x02 \x01(\tR\tcheckName\x12\x18\n" +
+	"\aignored\x18\x03 \x01(\bR\aignored\"\x8e\x02\n" +
+	"\n" +
+	"FlakyCheck\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x1d\n" +
+	"\n" +
+	"check_name\x18\x02 \x01(\tR\tcheckName\x12\x1a\n" +
+	"\bfailures\x18\x03 \x01(\x05R\bfailures\x12\x16\n" +
+	"\x06reruns\x18\x04 \x01(\x05R\x06reruns\x12\x16\n" +
+	"\x06flakes\x18\x05 \x01(\x05R\x06flakes\x12\x1d\n" +
+	"\n" +
+	"flake_rate\x18\x06 \x01(\x01R\tflakeRate\x12\x18\n" +
+	"\aignored\x18\a \x01(\bR\aignored\x12$\n" +
+	"\x0elast_failed_at\x18\b \x01(\tR\flastFailedAt\x12\"\n" +
+	"\rlast_flake_at\x18\t \x01(\tR\vlastFlakeAt\"]\n" +
+	"\x19ListMergeDecisionsRequest\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"P\n" +
+	"\x1aListMergeDecisionsResponse\x122\n" +
+	"\tdecisions\x18\x01 \x03(\v2\x14.jules.MergeDecisionR\tdecisions\"\xb5\x01\n" +
+	"\rMergeDecision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x05R\x06number\x12\x19\n" +
+	"\bhead_sha\x18\x04 \x01(\tR\aheadSha\x12\x16\n" +
+	"\x06merged\x18\x05 \x01(\bR\x06merged\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt*Q\n" +
 	"\x05Theme\x12\x15\n" +
 	"\x11THEME_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vTHEME_LIGHT\x10\x01\x12\x0e\n" +
@@ -4296,7 +9166,36 @@ const file_jules_proto_rawDesc = "" +
 	"\fTHEME_SYSTEM\x10\x03*E\n" +
 	"\x0eAutomationMode\x12\x1f\n" +
 	"\x1bAUTOMATION_MODE_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eAUTO_CREATE_PR\x10\x012\x9f\x01\n" +
+	"\x0eAUTO_CREATE_PR\x10\x01*\xa8\x01\n" +
+	"\x11PipelineCondition\x12\"\n" +
+	"\x1ePIPELINE_CONDITION_UNSPECIFIED\x10\x00\x12)\n" +
+	"%PIPELINE_CONDITION_SESSIONS_COMPLETED\x10\x01\x12 \n" +
+	"\x1cPIPELINE_CONDITION_PR_MERGED\x10\x02\x12\"\n" +
+	"\x1ePIPELINE_CONDITION_ANY_SUCCESS\x10\x03*\xe0\x01\n" +
+	"\tJobStatus\x12\x1a\n" +
+	"\x16JOB_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12JOB_STATUS_PENDING\x10\x01\x12\x19\n" +
+	"\x15JOB_STATUS_PROCESSING\x10\x02\x12\x18\n" +
+	"\x14JOB_STATUS_COMPLETED\x10\x03\x12\x15\n" +
+	"\x11JOB_STATUS_FAILED\x10\x04\x12\x18\n" +
+	"\x14JOB_STATUS_CANCELLED\x10\x05\x12\"\n" +
+	"\x1eJOB_STATUS_PARTIALLY_SUCCEEDED\x10\x06\x12\x15\n" +
+	"\x11JOB_STATUS_QUEUED\x10\a*s\n" +
+	"\vCronCatchUp\x12\x1d\n" +
+	"\x19CRON_CATCH_UP_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CRON_CATCH_UP_SKIP\x10\x01\x12\x16\n" +
+	"\x12CRON_CATCH_UP_ONCE\x10\x02\x12\x15\n" +
+	"\x11CRON_CATCH_UP_ALL\x10\x03*\xa7\x01\n" +
+	"\fTriggerEvent\x12\x1d\n" +
+	"\x19TRIGGER_EVENT_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTRIGGER_EVENT_ISSUE_LABELED\x10\x01\x12\x1b\n" +
+	"\x17TRIGGER_EVENT_CI_FAILED\x10\x02\x12\x19\n" +
+	"\x15TRIGGER_EVENT_RELEASE\x10\x03\x12\x1f\n" +
+	"\x1bTRIGGER_EVENT_DEPENDABOT_PR\x10\x04*`\n" +
+	"\vCronTrigger\x12\x1c\n" +
+	"\x18CRON_TRIGGER_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CRON_TRIGGER_SCHEDULED\x10\x01\x12\x17\n" +
+	"\x13CRON_TRIGGER_MANUAL\x10\x022\x9f\x01\n" +
 	"\x0fSettingsService\x12;\n" +
 	"\vGetSettings\x12\x19.jules.GetSettingsRequest\x1a\x0f.jules.Settings\"\x00\x12O\n" +
 	"\x0eUpdateSettings\x12\x1c.jules.UpdateSettingsRequest\x1a\x1d.jules.UpdateSettingsResponse\"\x002\xd9\x01\n" +
@@ -4306,14 +9205,16 @@ const file_jules_proto_rawDesc = "" +
 	"\rDeleteProfile\x12\x1b.jules.DeleteProfileRequest\x1a\x16.google.protobuf.Empty2F\n" +
 	"\n" +
 	"LogService\x128\n" +
-	"\aGetLogs\x12\x15.jules.GetLogsRequest\x1a\x16.jules.GetLogsResponse2\xad\x03\n" +
+	"\aGetLogs\x12\x15.jules.GetLogsRequest\x1a\x16.jules.GetLogsResponse2\xd4\x04\n" +
 	"\x0eCronJobService\x12C\n" +
 	"\fListCronJobs\x12\x16.google.protobuf.Empty\x1a\x1b.jules.ListCronJobsResponse\x12<\n" +
 	"\rCreateCronJob\x12\x1b.jules.CreateCronJobRequest\x1a\x0e.jules.CronJob\x12D\n" +
 	"\rUpdateCronJob\x12\x1b.jules.UpdateCronJobRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
 	"\rDeleteCronJob\x12\x1b.jules.DeleteCronJobRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
 	"\x0eExecuteCronJob\x12\x1c.jules.ExecuteCronJobRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
-	"\rToggleCronJob\x12\x1b.jules.ToggleCronJobRequest\x1a\x16.google.protobuf.Empty2\xeb\x02\n" +
+	"\rToggleCronJob\x12\x1b.jules.ToggleCronJobRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\fListCronRuns\x12\x1a.jules.ListCronRunsRequest\x1a\x1b.jules.ListCronRunsResponse\x12\\\n" +
+	"\x13PreviewCronSchedule\x12!.jules.PreviewCronScheduleRequest\x1a\".jules.PreviewCronScheduleResponse2\xe7\x04\n" +
 	"\n" +
 	"JobService\x12;\n" +
 	"\bListJobs\x12\x16.google.protobuf.Empty\x1a\x17.jules.ListJobsResponse\x12*\n" +
@@ -4323,7 +9224,14 @@ const file_jules_proto_rawDesc = "" +
 	".jules.Job\x12F\n" +
 	"\x0eCreateManyJobs\x12\x1c.jules.CreateManyJobsRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
 	"\tUpdateJob\x12\x17.jules.UpdateJobRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
-	"\tDeleteJob\x12\x17.jules.DeleteJobRequest\x1a\x16.google.protobuf.Empty2\xbe\v\n" +
+	"\tDeleteJob\x12\x17.jules.DeleteJobRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\tCancelJob\x12\x17.jules.CancelJobRequest\x1a\x18.jules.CancelJobResponse\x12D\n" +
+	"\x13RetryFailedSessions\x12!.jules.RetryFailedSessionsRequest\x1a\n" +
+	".jules.Job\x12.\n" +
+	"\bRerunJob\x12\x16.jules.RerunJobRequest\x1a\n" +
+	".jules.Job\x12D\n" +
+	"\x13RerunFailedSessions\x12!.jules.RerunFailedSessionsRequest\x1a\n" +
+	".jules.Job2\xbe\v\n" +
 	"\rPromptService\x12U\n" +
 	"\x15ListPredefinedPrompts\x12\x16.google.protobuf.Empty\x1a$.jules.ListPredefinedPromptsResponse\x12G\n" +
 	"\x13GetPredefinedPrompt\x12\x17.jules.GetPromptRequest\x1a\x17.jules.PredefinedPrompt\x12M\n" +
@@ -4357,7 +9265,40 @@ const file_jules_proto_rawDesc = "" +
 	"\rGetChatConfig\x12\x1b.jules.GetChatConfigRequest\x1a\x11.jules.ChatConfig\x12E\n" +
 	"\x10CreateChatConfig\x12\x1e.jules.CreateChatConfigRequest\x1a\x11.jules.ChatConfig\x12H\n" +
 	"\x0fSendChatMessage\x12\x1d.jules.SendChatMessageRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
-	"\x10ListChatMessages\x12\x1e.jules.ListChatMessagesRequest\x1a\x1f.jules.ListChatMessagesResponseB\x1fZ\x1dgithub.com/mcpany/jules/protob\x06proto3"
+	"\x10ListChatMessages\x12\x1e.jules.ListChatMessagesRequest\x1a\x1f.jules.ListChatMessagesResponse2Q\n" +
+	"\fStateService\x12A\n" +
+	"\n" +
+	"ApplyState\x12\x18.jules.ApplyStateRequest\x1a\x19.jules.ApplyStateResponse2\xc8\x02\n" +
+	"\fQueueService\x122\n" +
+	"\n" +
+	"EnqueueJob\x12\x18.jules.EnqueueJobRequest\x1a\n" +
+	".jules.Job\x12>\n" +
+	"\tListQueue\x12\x17.jules.ListQueueRequest\x1a\x18.jules.ListQueueResponse\x12:\n" +
+	"\x0eSetJobPriority\x12\x1c.jules.SetJobPriorityRequest\x1a\n" +
+	".jules.Job\x12>\n" +
+	"\rMoveQueuedJob\x12\x1b.jules.MoveQueuedJobRequest\x1a\x10.jules.RepoQueue\x12H\n" +
+	"\x12SetRepoConcurrency\x12 .jules.SetRepoConcurrencyRequest\x1a\x10.jules.RepoQueue2\xac\x03\n" +
+	"\x13EventTriggerService\x12M\n" +
+	"\x11ListEventTriggers\x12\x16.google.protobuf.Empty\x1a .jules.ListEventTriggersResponse\x12K\n" +
+	"\x12CreateEventTrigger\x12 .jules.CreateEventTriggerRequest\x1a\x13.jules.EventTrigger\x12K\n" +
+	"\x12UpdateEventTrigger\x12 .jules.UpdateEventTriggerRequest\x1a\x13.jules.EventTrigger\x12N\n" +
+	"\x12DeleteEventTrigger\x12 .jules.DeleteEventTriggerRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x13ListTriggeredEvents\x12!.jules.ListTriggeredEventsRequest\x1a\".jules.ListTriggeredEventsResponse2\x82\x05\n" +
+	"\x0fPipelineService\x12E\n" +
+	"\rListPipelines\x12\x16.google.protobuf.Empty\x1a\x1c.jules.ListPipelinesResponse\x129\n" +
+	"\vGetPipeline\x12\x19.jules.GetPipelineRequest\x1a\x0f.jules.Pipeline\x12?\n" +
+	"\x0eCreatePipeline\x12\x1c.jules.CreatePipelineRequest\x1a\x0f.jules.Pipeline\x12?\n" +
+	"\x0eUpdatePipeline\x12\x1c.jules.UpdatePipelineRequest\x1a\x0f.jules.Pipeline\x12F\n" +
+	"\x0eDeletePipeline\x12\x1c.jules.DeletePipelineRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\rStartPipeline\x12\x1b.jules.StartPipelineRequest\x1a\x12.jules.PipelineRun\x12H\n" +
+	"\x11CancelPipelineRun\x12\x1f.jules.CancelPipelineRunRequest\x1a\x12.jules.PipelineRun\x12B\n" +
+	"\x0eGetPipelineRun\x12\x1c.jules.GetPipelineRunRequest\x1a\x12.jules.PipelineRun\x12S\n" +
+	"\x10ListPipelineRuns\x12\x1e.jules.ListPipelineRunsRequest\x1a\x1f.jules.ListPipelineRunsResponse2\xfa\x02\n" +
+	"\x12PullRequestService\x12h\n" +
+	"\x17ListTrackedPullRequests\x12%.jules.ListTrackedPullRequestsRequest\x1a&.jules.ListTrackedPullRequestsResponse\x12P\n" +
+	"\x0fListFlakyChecks\x12\x1d.jules.ListFlakyChecksRequest\x1a\x1e.jules.ListFlakyChecksResponse\x12M\n" +
+	"\x14SetFlakyCheckIgnored\x12\".jules.SetFlakyCheckIgnoredRequest\x1a\x11.jules.FlakyCheck\x12Y\n" +
+	"\x12ListMergeDecisions\x12 .jules.ListMergeDecisionsRequest\x1a!.jules.ListMergeDecisionsResponseB\x1fZ\x1dgithub.com/mcpany/jules/protob\x06proto3"
 
 var (
 	file_jules_proto_rawDescOnce sync.Once
@@ -4371,189 +9312,351 @@ func file_jules_proto_rawDescGZIP() []byte {
 	return file_jules_proto_rawDescData
 }
 
-var file_jules_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_jules_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_jules_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_jules_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_jules_proto_goTypes = []any{
-	(Theme)(0),                            // 0: jules.Theme
-	(AutomationMode)(0),                   // 1: jules.AutomationMode
-	(*Settings)(nil),                      // 2: jules.Settings
-	(*GetSettingsRequest)(nil),            // 3: jules.GetSettingsRequest
-	(*UpdateSettingsRequest)(nil),         // 4: jules.UpdateSettingsRequest
-	(*UpdateSettingsResponse)(nil),        // 5: jules.UpdateSettingsResponse
-	(*Profile)(nil),                       // 6: jules.Profile
-	(*ListProfilesResponse)(nil),          // 7: jules.ListProfilesResponse
-	(*CreateProfileRequest)(nil),          // 8: jules.CreateProfileRequest
-	(*DeleteProfileRequest)(nil),          // 9: jules.DeleteProfileRequest
-	(*LogEntry)(nil),                      // 10: jules.LogEntry
-	(*GetLogsRequest)(nil),                // 11: jules.GetLogsRequest
-	(*GetLogsResponse)(nil),               // 12: jules.GetLogsResponse
-	(*CronJob)(nil),                       // 13: jules.CronJob
-	(*ListCronJobsResponse)(nil),          // 14: jules.ListCronJobsResponse
-	(*CreateCronJobRequest)(nil),          // 15: jules.CreateCronJobRequest
-	(*UpdateCronJobRequest)(nil),          // 16: jules.UpdateCronJobRequest
-	(*DeleteCronJobRequest)(nil),          // 17: jules.DeleteCronJobRequest
-	(*ExecuteCronJobRequest)(nil),         // 18: jules.ExecuteCronJobRequest
-	(*ToggleCronJobRequest)(nil),          // 19: jules.ToggleCronJobRequest
-	(*Job)(nil),                           // 20: jules.Job
-	(*ListJobsResponse)(nil),              // 21: jules.ListJobsResponse
-	(*GetJobRequest)(nil),                 // 22: jules.GetJobRequest
-	(*CreateJobRequest)(nil),              // 23: jules.CreateJobRequest
-	(*CreateManyJobsRequest)(nil),         // 24: jules.CreateManyJobsRequest
-	(*UpdateJobRequest)(nil),              // 25: jules.UpdateJobRequest
-	(*DeleteJobRequest)(nil),              // 26: jules.DeleteJobRequest
-	(*PredefinedPrompt)(nil),              // 27: jules.PredefinedPrompt
-	(*ListPredefinedPromptsResponse)(nil), // 28: jules.ListPredefinedPromptsResponse
-	(*GetPromptRequest)(nil),              // 29: jules.GetPromptRequest
-	(*CreatePromptRequest)(nil),           // 30: jules.CreatePromptRequest
-	(*CreateManyPromptsRequest)(nil),      // 31: jules.CreateManyPromptsRequest
-	(*UpdatePromptRequest)(nil),           // 32: jules.UpdatePromptRequest
-	(*DeletePromptRequest)(nil),           // 33: jules.DeletePromptRequest
-	(*GlobalPrompt)(nil),                  // 34: jules.GlobalPrompt
-	(*SaveGlobalPromptRequest)(nil),       // 35: jules.SaveGlobalPromptRequest
-	(*HistoryPrompt)(nil),                 // 36: jules.HistoryPrompt
-	(*ListHistoryPromptsResponse)(nil),    // 37: jules.ListHistoryPromptsResponse
-	(*GetRecentRequest)(nil),              // 38: jules.GetRecentRequest
-	(*SaveHistoryPromptRequest)(nil),      // 39: jules.SaveHistoryPromptRequest
-	(*RepoPrompt)(nil),                    // 40: jules.RepoPrompt
-	(*GetRepoPromptRequest)(nil),          // 41: jules.GetRepoPromptRequest
-	(*SaveRepoPromptRequest)(nil),         // 42: jules.SaveRepoPromptRequest
-	(*Session)(nil),                       // 43: jules.Session
-	(*ListSessionsRequest)(nil),           // 44: jules.ListSessionsRequest
-	(*ListSessionsResponse)(nil),          // 45: jules.ListSessionsResponse
-	(*GetSessionRequest)(nil),             // 46: jules.GetSessionRequest
-	(*CreateSessionRequest)(nil),          // 47: jules.CreateSessionRequest
-	(*UpdateSessionRequest)(nil),          // 48: jules.UpdateSessionRequest
-	(*DeleteSessionRequest)(nil),          // 49: jules.DeleteSessionRequest
-	(*ApprovePlanRequest)(nil),            // 50: jules.ApprovePlanRequest
-	(*SendMessageRequest)(nil),            // 51: jules.SendMessageRequest
-	(*ChatConfig)(nil),                    // 52: jules.ChatConfig
-	(*ChatMessage)(nil),                   // 53: jules.ChatMessage
-	(*GetChatConfigRequest)(nil),          // 54: jules.GetChatConfigRequest
-	(*CreateChatConfigRequest)(nil),       // 55: jules.CreateChatConfigRequest
-	(*SendChatMessageRequest)(nil),        // 56: jules.SendChatMessageRequest
-	(*ListChatMessagesRequest)(nil),       // 57: jules.ListChatMessagesRequest
-	(*ListChatMessagesResponse)(nil),      // 58: jules.ListChatMessagesResponse
-	(*emptypb.Empty)(nil),                 // 59: google.protobuf.Empty
+	(Theme)(0),                              // 0: jules.Theme
+	(AutomationMode)(0),                     // 1: jules.AutomationMode
+	(PipelineCondition)(0),                  // 2: jules.PipelineCondition
+	(JobStatus)(0),                          // 3: jules.JobStatus
+	(CronCatchUp)(0),                        // 4: jules.CronCatchUp
+	(TriggerEvent)(0),                       // 5: jules.TriggerEvent
+	(CronTrigger)(0),                        // 6: jules.CronTrigger
+	(*Settings)(nil),                        // 7: jules.Settings
+	(*GetSettingsRequest)(nil),              // 8: jules.GetSettingsRequest
+	(*UpdateSettingsRequest)(nil),           // 9: jules.UpdateSettingsRequest
+	(*UpdateSettingsResponse)(nil),          // 10: jules.UpdateSettingsResponse
+	(*Profile)(nil),                         // 11: jules.Profile
+	(*ListProfilesResponse)(nil),            // 12: jules.ListProfilesResponse
+	(*CreateProfileRequest)(nil),            // 13: jules.CreateProfileRequest
+	(*DeleteProfileRequest)(nil),            // 14: jules.DeleteProfileRequest
+	(*LogEntry)(nil),                        // 15: jules.LogEntry
+	(*GetLogsRequest)(nil),                  // 16: jules.GetLogsRequest
+	(*GetLogsResponse)(nil),                 // 17: jules.GetLogsResponse
+	(*CronJob)(nil),                         // 18: jules.CronJob
+	(*ListCronJobsResponse)(nil),            // 19: jules.ListCronJobsResponse
+	(*CreateCronJobRequest)(nil),            // 20: jules.CreateCronJobRequest
+	(*UpdateCronJobRequest)(nil),            // 21: jules.UpdateCronJobRequest
+	(*DeleteCronJobRequest)(nil),            // 22: jules.DeleteCronJobRequest
+	(*ExecuteCronJobRequest)(nil),           // 23: jules.ExecuteCronJobRequest
+	(*CronRun)(nil),                         // 24: jules.CronRun
+	(*ListCronRunsRequest)(nil),             // 25: jules.ListCronRunsRequest
+	(*ListCronRunsResponse)(nil),            // 26: jules.ListCronRunsResponse
+	(*PreviewCronScheduleRequest)(nil),      // 27: jules.PreviewCronScheduleRequest
+	(*PreviewCronScheduleResponse)(nil),     // 28: jules.PreviewCronScheduleResponse
+	(*ToggleCronJobRequest)(nil),            // 29: jules.ToggleCronJobRequest
+	(*Job)(nil),                             // 30: jules.Job
+	(*JobMatrix)(nil),                       // 31: jules.JobMatrix
+	(*JobTarget)(nil),                       // 32: jules.JobTarget
+	(*JobTargetProgress)(nil),               // 33: jules.JobTargetProgress
+	(*JobSessionSlot)(nil),                  // 34: jules.JobSessionSlot
+	(*ListJobsResponse)(nil),                // 35: jules.ListJobsResponse
+	(*GetJobRequest)(nil),                   // 36: jules.GetJobRequest
+	(*CreateJobRequest)(nil),                // 37: jules.CreateJobRequest
+	(*CreateManyJobsRequest)(nil),           // 38: jules.CreateManyJobsRequest
+	(*UpdateJobRequest)(nil),                // 39: jules.UpdateJobRequest
+	(*DeleteJobRequest)(nil),                // 40: jules.DeleteJobRequest
+	(*RetryFailedSessionsRequest)(nil),      // 41: jules.RetryFailedSessionsRequest
+	(*RerunJobRequest)(nil),                 // 42: jules.RerunJobRequest
+	(*RerunFailedSessionsRequest)(nil),      // 43: jules.RerunFailedSessionsRequest
+	(*CancelJobRequest)(nil),                // 44: jules.CancelJobRequest
+	(*CancelJobResponse)(nil),               // 45: jules.CancelJobResponse
+	(*PredefinedPrompt)(nil),                // 46: jules.PredefinedPrompt
+	(*ListPredefinedPromptsResponse)(nil),   // 47: jules.ListPredefinedPromptsResponse
+	(*GetPromptRequest)(nil),                // 48: jules.GetPromptRequest
+	(*CreatePromptRequest)(nil),             // 49: jules.CreatePromptRequest
+	(*CreateManyPromptsRequest)(nil),        // 50: jules.CreateManyPromptsRequest
+	(*UpdatePromptRequest)(nil),             // 51: jules.UpdatePromptRequest
+	(*DeletePromptRequest)(nil),             // 52: jules.DeletePromptRequest
+	(*GlobalPrompt)(nil),                    // 53: jules.GlobalPrompt
+	(*SaveGlobalPromptRequest)(nil),         // 54: jules.SaveGlobalPromptRequest
+	(*HistoryPrompt)(nil),                   // 55: jules.HistoryPrompt
+	(*ListHistoryPromptsResponse)(nil),      // 56: jules.ListHistoryPromptsResponse
+	(*GetRecentRequest)(nil),                // 57: jules.GetRecentRequest
+	(*SaveHistoryPromptRequest)(nil),        // 58: jules.SaveHistoryPromptRequest
+	(*RepoPrompt)(nil),                      // 59: jules.RepoPrompt
+	(*GetRepoPromptRequest)(nil),            // 60: jules.GetRepoPromptRequest
+	(*SaveRepoPromptRequest)(nil),           // 61: jules.SaveRepoPromptRequest
+	(*Session)(nil),                         // 62: jules.Session
+	(*ListSessionsRequest)(nil),             // 63: jules.ListSessionsRequest
+	(*ListSessionsResponse)(nil),            // 64: jules.ListSessionsResponse
+	(*GetSessionRequest)(nil),               // 65: jules.GetSessionRequest
+	(*CreateSessionRequest)(nil),            // 66: jules.CreateSessionRequest
+	(*UpdateSessionRequest)(nil),            // 67: jules.UpdateSessionRequest
+	(*DeleteSessionRequest)(nil),            // 68: jules.DeleteSessionRequest
+	(*ApprovePlanRequest)(nil),              // 69: jules.ApprovePlanRequest
+	(*SendMessageRequest)(nil),              // 70: jules.SendMessageRequest
+	(*ChatConfig)(nil),                      // 71: jules.ChatConfig
+	(*ChatMessage)(nil),                     // 72: jules.ChatMessage
+	(*GetChatConfigRequest)(nil),            // 73: jules.GetChatConfigRequest
+	(*CreateChatConfigRequest)(nil),         // 74: jules.CreateChatConfigRequest
+	(*SendChatMessageRequest)(nil),          // 75: jules.SendChatMessageRequest
+	(*ListChatMessagesRequest)(nil),         // 76: jules.ListChatMessagesRequest
+	(*ListChatMessagesResponse)(nil),        // 77: jules.ListChatMessagesResponse
+	(*ApplyStateRequest)(nil),               // 78: jules.ApplyStateRequest
+	(*StateChange)(nil),                     // 79: jules.StateChange
+	(*ApplyStateResponse)(nil),              // 80: jules.ApplyStateResponse
+	(*PipelineStep)(nil),                    // 81: jules.PipelineStep
+	(*Pipeline)(nil),                        // 82: jules.Pipeline
+	(*ListPipelinesResponse)(nil),           // 83: jules.ListPipelinesResponse
+	(*GetPipelineRequest)(nil),              // 84: jules.GetPipelineRequest
+	(*CreatePipelineRequest)(nil),           // 85: jules.CreatePipelineRequest
+	(*UpdatePipelineRequest)(nil),           // 86: jules.UpdatePipelineRequest
+	(*DeletePipelineRequest)(nil),           // 87: jules.DeletePipelineRequest
+	(*StartPipelineRequest)(nil),            // 88: jules.StartPipelineRequest
+	(*CancelPipelineRunRequest)(nil),        // 89: jules.CancelPipelineRunRequest
+	(*GetPipelineRunRequest)(nil),           // 90: jules.GetPipelineRunRequest
+	(*ListPipelineRunsRequest)(nil),         // 91: jules.ListPipelineRunsRequest
+	(*PipelineRunStep)(nil),                 // 92: jules.PipelineRunStep
+	(*PipelineRun)(nil),                     // 93: jules.PipelineRun
+	(*ListPipelineRunsResponse)(nil),        // 94: jules.ListPipelineRunsResponse
+	(*EnqueueJobRequest)(nil),               // 95: jules.EnqueueJobRequest
+	(*ListQueueRequest)(nil),                // 96: jules.ListQueueRequest
+	(*ListQueueResponse)(nil),               // 97: jules.ListQueueResponse
+	(*RepoQueue)(nil),                       // 98: jules.RepoQueue
+	(*SetJobPriorityRequest)(nil),           // 99: jules.SetJobPriorityRequest
+	(*MoveQueuedJobRequest)(nil),            // 100: jules.MoveQueuedJobRequest
+	(*SetRepoConcurrencyRequest)(nil),       // 101: jules.SetRepoConcurrencyRequest
+	(*EventTrigger)(nil),                    // 102: jules.EventTrigger
+	(*ListEventTriggersResponse)(nil),       // 103: jules.ListEventTriggersResponse
+	(*CreateEventTriggerRequest)(nil),       // 104: jules.CreateEventTriggerRequest
+	(*UpdateEventTriggerRequest)(nil),       // 105: jules.UpdateEventTriggerRequest
+	(*DeleteEventTriggerRequest)(nil),       // 106: jules.DeleteEventTriggerRequest
+	(*ListTriggeredEventsRequest)(nil),      // 107: jules.ListTriggeredEventsRequest
+	(*ListTriggeredEventsResponse)(nil),     // 108: jules.ListTriggeredEventsResponse
+	(*TriggeredEvent)(nil),                  // 109: jules.TriggeredEvent
+	(*ListTrackedPullRequestsRequest)(nil),  // 110: jules.ListTrackedPullRequestsRequest
+	(*ListTrackedPullRequestsResponse)(nil), // 111: jules.ListTrackedPullRequestsResponse
+	(*TrackedPullRequest)(nil),              // 112: jules.TrackedPullRequest
+	(*ListFlakyChecksRequest)(nil),          // 113: jules.ListFlakyChecksRequest
+	(*ListFlakyChecksResponse)(nil),         // 114: jules.ListFlakyChecksResponse
+	(*SetFlakyCheckIgnoredRequest)(nil),     // 115: jules.SetFlakyCheckIgnoredRequest
+	(*FlakyCheck)(nil),                      // 116: jules.FlakyCheck
+	(*ListMergeDecisionsRequest)(nil),       // 117: jules.ListMergeDecisionsRequest
+	(*ListMergeDecisionsResponse)(nil),      // 118: jules.ListMergeDecisionsResponse
+	(*MergeDecision)(nil),                   // 119: jules.MergeDecision
+	nil,                                     // 120: jules.JobTarget.VarsEntry
+	(*emptypb.Empty)(nil),                   // 121: google.protobuf.Empty
 }
 var file_jules_proto_depIdxs = []int32{
-	2,  // 0: jules.UpdateSettingsRequest.settings:type_name -> jules.Settings
-	6,  // 1: jules.ListProfilesResponse.profiles:type_name -> jules.Profile
-	10, // 2: jules.GetLogsResponse.logs:type_name -> jules.LogEntry
-	1,  // 3: jules.CronJob.automation_mode:type_name -> jules.AutomationMode
-	13, // 4: jules.ListCronJobsResponse.cron_jobs:type_name -> jules.CronJob
-	1,  // 5: jules.CreateCronJobRequest.automation_mode:type_name -> jules.AutomationMode
-	1,  // 6: jules.UpdateCronJobRequest.automation_mode:type_name -> jules.AutomationMode
-	1,  // 7: jules.Job.automation_mode:type_name -> jules.AutomationMode
-	20, // 8: jules.ListJobsResponse.jobs:type_name -> jules.Job
-	1,  // 9: jules.CreateJobRequest.automation_mode:type_name -> jules.AutomationMode
-	23, // 10: jules.CreateManyJobsRequest.jobs:type_name -> jules.CreateJobRequest
-	27, // 11: jules.ListPredefinedPromptsResponse.prompts:type_name -> jules.PredefinedPrompt
-	30, // 12: jules.CreateManyPromptsRequest.prompts:type_name -> jules.CreatePromptRequest
-	36, // 13: jules.ListHistoryPromptsResponse.prompts:type_name -> jules.HistoryPrompt
-	1,  // 14: jules.Session.automation_mode:type_name -> jules.AutomationMode
-	43, // 15: jules.ListSessionsResponse.sessions:type_name -> jules.Session
-	53, // 16: jules.ListChatMessagesResponse.messages:type_name -> jules.ChatMessage
-	3,  // 17: jules.SettingsService.GetSettings:input_type -> jules.GetSettingsRequest
-	4,  // 18: jules.SettingsService.UpdateSettings:input_type -> jules.UpdateSettingsRequest
-	59, // 19: jules.ProfileService.ListProfiles:input_type -> google.protobuf.Empty
-	8,  // 20: jules.ProfileService.CreateProfile:input_type -> jules.CreateProfileRequest
-	9,  // 21: jules.ProfileService.DeleteProfile:input_type -> jules.DeleteProfileRequest
-	11, // 22: jules.LogService.GetLogs:input_type -> jules.GetLogsRequest
-	59, // 23: jules.CronJobService.ListCronJobs:input_type -> google.protobuf.Empty
-	15, // 24: jules.CronJobService.CreateCronJob:input_type -> jules.CreateCronJobRequest
-	16, // 25: jules.CronJobService.UpdateCronJob:input_type -> jules.UpdateCronJobRequest
-	17, // 26: jules.CronJobService.DeleteCronJob:input_type -> jules.DeleteCronJobRequest
-	18, // 27: jules.CronJobService.ExecuteCronJob:input_type -> jules.ExecuteCronJobRequest
-	19, // 28: jules.CronJobService.ToggleCronJob:input_type -> jules.ToggleCronJobRequest
-	59, // 29: jules.JobService.ListJobs:input_type -> google.protobuf.Empty
-	22, // 30: jules.JobService.GetJob:input_type -> jules.GetJobRequest
-	23, // 31: jules.JobService.CreateJob:input_type -> jules.CreateJobRequest
-	24, // 32: jules.JobService.CreateManyJobs:input_type -> jules.CreateManyJobsRequest
-	25, // 33: jules.JobService.UpdateJob:input_type -> jules.UpdateJobRequest
-	26, // 34: jules.JobService.DeleteJob:input_type -> jules.DeleteJobRequest
-	59, // 35: jules.PromptService.ListPredefinedPrompts:input_type -> google.protobuf.Empty
-	29, // 36: jules.PromptService.GetPredefinedPrompt:input_type -> jules.GetPromptRequest
-	30, // 37: jules.PromptService.CreatePredefinedPrompt:input_type -> jules.CreatePromptRequest
-	31, // 38: jules.PromptService.CreateManyPredefinedPrompts:input_type -> jules.CreateManyPromptsRequest
-	32, // 39: jules.PromptService.UpdatePredefinedPrompt:input_type -> jules.UpdatePromptRequest
-	33, // 40: jules.PromptService.DeletePredefinedPrompt:input_type -> jules.DeletePromptRequest
-	59, // 41: jules.PromptService.ListQuickReplies:input_type -> google.protobuf.Empty
-	29, // 42: jules.PromptService.GetQuickReply:input_type -> jules.GetPromptRequest
-	30, // 43: jules.PromptService.CreateQuickReply:input_type -> jules.CreatePromptRequest
-	31, // 44: jules.PromptService.CreateManyQuickReplies:input_type -> jules.CreateManyPromptsRequest
-	32, // 45: jules.PromptService.UpdateQuickReply:input_type -> jules.UpdatePromptRequest
-	33, // 46: jules.PromptService.DeleteQuickReply:input_type -> jules.DeletePromptRequest
-	59, // 47: jules.PromptService.GetGlobalPrompt:input_type -> google.protobuf.Empty
-	35, // 48: jules.PromptService.SaveGlobalPrompt:input_type -> jules.SaveGlobalPromptRequest
-	59, // 49: jules.PromptService.ListHistoryPrompts:input_type -> google.protobuf.Empty
-	38, // 50: jules.PromptService.GetRecentHistoryPrompts:input_type -> jules.GetRecentRequest
-	39, // 51: jules.PromptService.SaveHistoryPrompt:input_type -> jules.SaveHistoryPromptRequest
-	41, // 52: jules.PromptService.GetRepoPrompt:input_type -> jules.GetRepoPromptRequest
-	42, // 53: jules.PromptService.SaveRepoPrompt:input_type -> jules.SaveRepoPromptRequest
-	44, // 54: jules.SessionService.ListSessions:input_type -> jules.ListSessionsRequest
-	46, // 55: jules.SessionService.GetSession:input_type -> jules.GetSessionRequest
-	47, // 56: jules.SessionService.CreateSession:input_type -> jules.CreateSessionRequest
-	48, // 57: jules.SessionService.UpdateSession:input_type -> jules.UpdateSessionRequest
-	49, // 58: jules.SessionService.DeleteSession:input_type -> jules.DeleteSessionRequest
-	50, // 59: jules.SessionService.ApprovePlan:input_type -> jules.ApprovePlanRequest
-	51, // 60: jules.SessionService.SendMessage:input_type -> jules.SendMessageRequest
-	54, // 61: jules.ChatService.GetChatConfig:input_type -> jules.GetChatConfigRequest
-	55, // 62: jules.ChatService.CreateChatConfig:input_type -> jules.CreateChatConfigRequest
-	56, // 63: jules.ChatService.SendChatMessage:input_type -> jules.SendChatMessageRequest
-	57, // 64: jules.ChatService.ListChatMessages:input_type -> jules.ListChatMessagesRequest
-	2,  // 65: jules.SettingsService.GetSettings:output_type -> jules.Settings
-	5,  // 66: jules.SettingsService.UpdateSettings:output_type -> jules.UpdateSettingsResponse
-	7,  // 67: jules.ProfileService.ListProfiles:output_type -> jules.ListProfilesResponse
-	6,  // 68: jules.ProfileService.CreateProfile:output_type -> jules.Profile
-	59, // 69: jules.ProfileService.DeleteProfile:output_type -> google.protobuf.Empty
-	12, // 70: jules.LogService.GetLogs:output_type -> jules.GetLogsResponse
-	14, // 71: jules.CronJobService.ListCronJobs:output_type -> jules.ListCronJobsResponse
-	13, // 72: jules.CronJobService.CreateCronJob:output_type -> jules.CronJob
-	59, // 73: jules.CronJobService.UpdateCronJob:output_type -> google.protobuf.Empty
-	59, // 74: jules.CronJobService.DeleteCronJob:output_type -> google.protobuf.Empty
-	59, // 75: jules.CronJobService.ExecuteCronJob:output_type -> google.protobuf.Empty
-	59, // 76: jules.CronJobService.ToggleCronJob:output_type -> google.protobuf.Empty
-	21, // 77: jules.JobService.ListJobs:output_type -> jules.ListJobsResponse
-	20, // 78: jules.JobService.GetJob:output_type -> jules.Job
-	20, // 79: jules.JobService.CreateJob:output_type -> jules.Job
-	59, // 80: jules.JobService.CreateManyJobs:output_type -> google.protobuf.Empty
-	59, // 81: jules.JobService.UpdateJob:output_type -> google.protobuf.Empty
-	59, // 82: jules.JobService.DeleteJob:output_type -> google.protobuf.Empty
-	28, // 83: jules.PromptService.ListPredefinedPrompts:output_type -> jules.ListPredefinedPromptsResponse
-	27, // 84: jules.PromptService.GetPredefinedPrompt:output_type -> jules.PredefinedPrompt
-	27, // 85: jules.PromptService.CreatePredefinedPrompt:output_type -> jules.PredefinedPrompt
-	59, // 86: jules.PromptService.CreateManyPredefinedPrompts:output_type -> google.protobuf.Empty
-	59, // 87: jules.PromptService.UpdatePredefinedPrompt:output_type -> google.protobuf.Empty
-	59, // 88: jules.PromptService.DeletePredefinedPrompt:output_type -> google.protobuf.Empty
-	28, // 89: jules.PromptService.ListQuickReplies:output_type -> jules.ListPredefinedPromptsResponse
-	27, // 90: jules.PromptService.GetQuickReply:output_type -> jules.PredefinedPrompt
-	27, // 91: jules.PromptService.CreateQuickReply:output_type -> jules.PredefinedPrompt
-	59, // 92: jules.PromptService.CreateManyQuickReplies:output_type -> google.protobuf.Empty
-	59, // 93: jules.PromptService.UpdateQuickReply:output_type -> google.protobuf.Empty
-	59, // 94: jules.PromptService.DeleteQuickReply:output_type -> google.protobuf.Empty
-	34, // 95: jules.PromptService.GetGlobalPrompt:output_type -> jules.GlobalPrompt
-	59, // 96: jules.PromptService.SaveGlobalPrompt:output_type -> google.protobuf.Empty
-	37, // 97: jules.PromptService.ListHistoryPrompts:output_type -> jules.ListHistoryPromptsResponse
-	37, // 98: jules.PromptService.GetRecentHistoryPrompts:output_type -> jules.ListHistoryPromptsResponse
-	59, // 99: jules.PromptService.SaveHistoryPrompt:output_type -> google.protobuf.Empty
-	40, // 100: jules.PromptService.GetRepoPrompt:output_type -> jules.RepoPrompt
-	59, // 101: jules.PromptService.SaveRepoPrompt:output_type -> google.protobuf.Empty
-	45, // 102: jules.SessionService.ListSessions:output_type -> jules.ListSessionsResponse
-	43, // 103: jules.SessionService.GetSession:output_type -> jules.Session
-	43, // 104: jules.SessionService.CreateSession:output_type -> jules.Session
-	59, // 105: jules.SessionService.UpdateSession:output_type -> google.protobuf.Empty
-	59, // 106: jules.SessionService.DeleteSession:output_type -> google.protobuf.Empty
-	59, // 107: jules.SessionService.ApprovePlan:output_type -> google.protobuf.Empty
-	59, // 108: jules.SessionService.SendMessage:output_type -> google.protobuf.Empty
-	52, // 109: jules.ChatService.GetChatConfig:output_type -> jules.ChatConfig
-	52, // 110: jules.ChatService.CreateChatConfig:output_type -> jules.ChatConfig
-	59, // 111: jules.ChatService.SendChatMessage:output_type -> google.protobuf.Empty
-	58, // 112: jules.ChatService.ListChatMessages:output_type -> jules.ListChatMessagesResponse
-	65, // [65:113] is the sub-list for method output_type
-	17, // [17:65] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	7,   // 0: jules.UpdateSettingsRequest.settings:type_name -> jules.Settings
+	11,  // 1: jules.ListProfilesResponse.profiles:type_name -> jules.Profile
+	15,  // 2: jules.GetLogsResponse.logs:type_name -> jules.LogEntry
+	1,   // 3: jules.CronJob.automation_mode:type_name -> jules.AutomationMode
+	31,  // 4: jules.CronJob.matrix:type_name -> jules.JobMatrix
+	4,   // 5: jules.CronJob.catch_up:type_name -> jules.CronCatchUp
+	18,  // 6: jules.ListCronJobsResponse.cron_jobs:type_name -> jules.CronJob
+	1,   // 7: jules.CreateCronJobRequest.automation_mode:type_name -> jules.AutomationMode
+	31,  // 8: jules.CreateCronJobRequest.matrix:type_name -> jules.JobMatrix
+	4,   // 9: jules.CreateCronJobRequest.catch_up:type_name -> jules.CronCatchUp
+	1,   // 10: jules.UpdateCronJobRequest.automation_mode:type_name -> jules.AutomationMode
+	31,  // 11: jules.UpdateCronJobRequest.matrix:type_name -> jules.JobMatrix
+	4,   // 12: jules.UpdateCronJobRequest.catch_up:type_name -> jules.CronCatchUp
+	6,   // 13: jules.CronRun.trigger:type_name -> jules.CronTrigger
+	3,   // 14: jules.CronRun.job_status:type_name -> jules.JobStatus
+	24,  // 15: jules.ListCronRunsResponse.runs:type_name -> jules.CronRun
+	1,   // 16: jules.Job.automation_mode:type_name -> jules.AutomationMode
+	3,   // 17: jules.Job.state:type_name -> jules.JobStatus
+	34,  // 18: jules.Job.session_slots:type_name -> jules.JobSessionSlot
+	31,  // 19: jules.Job.matrix:type_name -> jules.JobMatrix
+	33,  // 20: jules.Job.target_progress:type_name -> jules.JobTargetProgress
+	32,  // 21: jules.JobMatrix.targets:type_name -> jules.JobTarget
+	120, // 22: jules.JobTarget.vars:type_name -> jules.JobTarget.VarsEntry
+	30,  // 23: jules.ListJobsResponse.jobs:type_name -> jules.Job
+	1,   // 24: jules.CreateJobRequest.automation_mode:type_name -> jules.AutomationMode
+	31,  // 25: jules.CreateJobRequest.matrix:type_name -> jules.JobMatrix
+	37,  // 26: jules.CreateManyJobsRequest.jobs:type_name -> jules.CreateJobRequest
+	1,   // 27: jules.RerunJobRequest.automation_mode:type_name -> jules.AutomationMode
+	31,  // 28: jules.RerunJobRequest.matrix:type_name -> jules.JobMatrix
+	46,  // 29: jules.ListPredefinedPromptsResponse.prompts:type_name -> jules.PredefinedPrompt
+	49,  // 30: jules.CreateManyPromptsRequest.prompts:type_name -> jules.CreatePromptRequest
+	55,  // 31: jules.ListHistoryPromptsResponse.prompts:type_name -> jules.HistoryPrompt
+	1,   // 32: jules.Session.automation_mode:type_name -> jules.AutomationMode
+	62,  // 33: jules.ListSessionsResponse.sessions:type_name -> jules.Session
+	72,  // 34: jules.ListChatMessagesResponse.messages:type_name -> jules.ChatMessage
+	79,  // 35: jules.ApplyStateResponse.changes:type_name -> jules.StateChange
+	37,  // 36: jules.PipelineStep.job:type_name -> jules.CreateJobRequest
+	2,   // 37: jules.PipelineStep.condition:type_name -> jules.PipelineCondition
+	81,  // 38: jules.Pipeline.steps:type_name -> jules.PipelineStep
+	82,  // 39: jules.ListPipelinesResponse.pipelines:type_name -> jules.Pipeline
+	81,  // 40: jules.CreatePipelineRequest.steps:type_name -> jules.PipelineStep
+	81,  // 41: jules.UpdatePipelineRequest.steps:type_name -> jules.PipelineStep
+	92,  // 42: jules.PipelineRun.steps:type_name -> jules.PipelineRunStep
+	93,  // 43: jules.ListPipelineRunsResponse.runs:type_name -> jules.PipelineRun
+	37,  // 44: jules.EnqueueJobRequest.job:type_name -> jules.CreateJobRequest
+	98,  // 45: jules.ListQueueResponse.repos:type_name -> jules.RepoQueue
+	30,  // 46: jules.RepoQueue.jobs:type_name -> jules.Job
+	5,   // 47: jules.EventTrigger.event:type_name -> jules.TriggerEvent
+	1,   // 48: jules.EventTrigger.automation_mode:type_name -> jules.AutomationMode
+	102, // 49: jules.ListEventTriggersResponse.triggers:type_name -> jules.EventTrigger
+	5,   // 50: jules.CreateEventTriggerRequest.event:type_name -> jules.TriggerEvent
+	1,   // 51: jules.CreateEventTriggerRequest.automation_mode:type_name -> jules.AutomationMode
+	1,   // 52: jules.UpdateEventTriggerRequest.automation_mode:type_name -> jules.AutomationMode
+	109, // 53: jules.ListTriggeredEventsResponse.events:type_name -> jules.TriggeredEvent
+	112, // 54: jules.ListTrackedPullRequestsResponse.pull_requests:type_name -> jules.TrackedPullRequest
+	116, // 55: jules.ListFlakyChecksResponse.checks:type_name -> jules.FlakyCheck
+	119, // 56: jules.ListMergeDecisionsResponse.decisions:type_name -> jules.MergeDecision
+	8,   // 57: jules.SettingsService.GetSettings:input_type -> jules.GetSettingsRequest
+	9,   // 58: jules.SettingsService.UpdateSettings:input_type -> jules.UpdateSettingsRequest
+	121, // 59: jules.ProfileService.ListProfiles:input_type -> google.protobuf.Empty
+	13,  // 60: jules.ProfileService.CreateProfile:input_type -> jules.CreateProfileRequest
+	14,  // 61: jules.ProfileService.DeleteProfile:input_type -> jules.DeleteProfileRequest
+	16,  // 62: jules.LogService.GetLogs:input_type -> jules.GetLogsRequest
+	121, // 63: jules.CronJobService.ListCronJobs:input_type -> google.protobuf.Empty
+	20,  // 64: jules.CronJobService.CreateCronJob:input_type -> jules.CreateCronJobRequest
+	21,  // 65: jules.CronJobService.UpdateCronJob:input_type -> jules.UpdateCronJobRequest
+	22,  // 66: jules.CronJobService.DeleteCronJob:input_type -> jules.DeleteCronJobRequest
+	23,  // 67: jules.CronJobService.ExecuteCronJob:input_type -> jules.ExecuteCronJobRequest
+	29,  // 68: jules.CronJobService.ToggleCronJob:input_type -> jules.ToggleCronJobRequest
+	25,  // 69: jules.CronJobService.ListCronRuns:input_type -> jules.ListCronRunsRequest
+	27,  // 70: jules.CronJobService.PreviewCronSchedule:input_type -> jules.PreviewCronScheduleRequest
+	121, // 71: jules.JobService.ListJobs:input_type -> google.protobuf.Empty
+	36,  // 72: jules.JobService.GetJob:input_type -> jules.GetJobRequest
+	37,  // 73: jules.JobService.CreateJob:input_type -> jules.CreateJobRequest
+	38,  // 74: jules.JobService.CreateManyJobs:input_type -> jules.CreateManyJobsRequest
+	39,  // 75: jules.JobService.UpdateJob:input_type -> jules.UpdateJobRequest
+	40,  // 76: jules.JobService.DeleteJob:input_type -> jules.DeleteJobRequest
+	44,  // 77: jules.JobService.CancelJob:input_type -> jules.CancelJobRequest
+	41,  // 78: jules.JobService.RetryFailedSessions:input_type -> jules.RetryFailedSessionsRequest
+	42,  // 79: jules.JobService.RerunJob:input_type -> jules.RerunJobRequest
+	43,  // 80: jules.JobService.RerunFailedSessions:input_type -> jules.RerunFailedSessionsRequest
+	121, // 81: jules.PromptService.ListPredefinedPrompts:input_type -> google.protobuf.Empty
+	48,  // 82: jules.PromptService.GetPredefinedPrompt:input_type -> jules.GetPromptRequest
+	49,  // 83: jules.PromptService.CreatePredefinedPrompt:input_type -> jules.CreatePromptRequest
+	50,  // 84: jules.PromptService.CreateManyPredefinedPrompts:input_type -> jules.CreateManyPromptsRequest
+	51,  // 85: jules.PromptService.UpdatePredefinedPrompt:input_type -> jules.UpdatePromptRequest
+	52,  // 86: jules.PromptService.DeletePredefinedPrompt:input_type -> jules.DeletePromptRequest
+	121, // 87: jules.PromptService.ListQuickReplies:input_type -> google.protobuf.Empty
+	48,  // 88: jules.PromptService.GetQuickReply:input_type -> jules.GetPromptRequest
+	49,  // 89: jules.PromptService.CreateQuickReply:input_type -> jules.CreatePromptRequest
+	50,  // 90: jules.PromptService.CreateManyQuickReplies:input_type -> jules.CreateManyPromptsRequest
+	51,  // 91: jules.PromptService.UpdateQuickReply:input_type -> jules.UpdatePromptRequest
+	52,  // 92: jules.PromptService.DeleteQuickReply:input_type -> jules.DeletePromptRequest
+	121, // 93: jules.PromptService.GetGlobalPrompt:input_type -> google.protobuf.Empty
+	54,  // 94: jules.PromptService.SaveGlobalPrompt:input_type -> jules.SaveGlobalPromptRequest
+	121, // 95: jules.PromptService.ListHistoryPrompts:input_type -> google.protobuf.Empty
+	57,  // 96: jules.PromptService.GetRecentHistoryPrompts:input_type -> jules.GetRecentRequest
+	58,  // 97: jules.PromptService.SaveHistoryPrompt:input_type -> jules.SaveHistoryPromptRequest
+	60,  // 98: jules.PromptService.GetRepoPrompt:input_type -> jules.GetRepoPromptRequest
+	61,  // 99: jules.PromptService.SaveRepoPrompt:input_type -> jules.SaveRepoPromptRequest
+	63,  // 100: jules.SessionService.ListSessions:input_type -> jules.ListSessionsRequest
+	65,  // 101: jules.SessionService.GetSession:input_type -> jules.GetSessionRequest
+	66,  // 102: jules.SessionService.CreateSession:input_type -> jules.CreateSessionRequest
+	67,  // 103: jules.SessionService.UpdateSession:input_type -> jules.UpdateSessionRequest
+	68,  // 104: jules.SessionService.DeleteSession:input_type -> jules.DeleteSessionRequest
+	69,  // 105: jules.SessionService.ApprovePlan:input_type -> jules.ApprovePlanRequest
+	70,  // 106: jules.SessionService.SendMessage:input_type -> jules.SendMessageRequest
+	73,  // 107: jules.ChatService.GetChatConfig:input_type -> jules.GetChatConfigRequest
+	74,  // 108: jules.ChatService.CreateChatConfig:input_type -> jules.CreateChatConfigRequest
+	75,  // 109: jules.ChatService.SendChatMessage:input_type -> jules.SendChatMessageRequest
+	76,  // 110: jules.ChatService.ListChatMessages:input_type -> jules.ListChatMessagesRequest
+	78,  // 111: jules.StateService.ApplyState:input_type -> jules.ApplyStateRequest
+	95,  // 112: jules.QueueService.EnqueueJob:input_type -> jules.EnqueueJobRequest
+	96,  // 113: jules.QueueService.ListQueue:input_type -> jules.ListQueueRequest
+	99,  // 114: jules.QueueService.SetJobPriority:input_type -> jules.SetJobPriorityRequest
+	100, // 115: jules.QueueService.MoveQueuedJob:input_type -> jules.MoveQueuedJobRequest
+	101, // 116: jules.QueueService.SetRepoConcurrency:input_type -> jules.SetRepoConcurrencyRequest
+	121, // 117: jules.EventTriggerService.ListEventTriggers:input_type -> google.protobuf.Empty
+	104, // 118: jules.EventTriggerService.CreateEventTrigger:input_type -> jules.CreateEventTriggerRequest
+	105, // 119: jules.EventTriggerService.UpdateEventTrigger:input_type -> jules.UpdateEventTriggerRequest
+	106, // 120: jules.EventTriggerService.DeleteEventTrigger:input_type -> jules.DeleteEventTriggerRequest
+	107, // 121: jules.EventTriggerService.ListTriggeredEvents:input_type -> jules.ListTriggeredEventsRequest
+	121, // 122: jules.PipelineService.ListPipelines:input_type -> google.protobuf.Empty
+	84,  // 123: jules.PipelineService.GetPipeline:input_type -> jules.GetPipelineRequest
+	85,  // 124: jules.PipelineService.CreatePipeline:input_type -> jules.CreatePipelineRequest
+	86,  // 125: jules.PipelineService.UpdatePipeline:input_type -> jules.UpdatePipelineRequest
+	87,  // 126: jules.PipelineService.DeletePipeline:input_type -> jules.DeletePipelineRequest
+	88,  // 127: jules.PipelineService.StartPipeline:input_type -> jules.StartPipelineRequest
+	89,  // 128: jules.PipelineService.CancelPipelineRun:input_type -> jules.CancelPipelineRunRequest
+	90,  // 129: jules.PipelineService.GetPipelineRun:input_type -> jules.GetPipelineRunRequest
+	91,  // 130: jules.PipelineService.ListPipelineRuns:input_type -> jules.ListPipelineRunsRequest
+	110, // 131: jules.PullRequestService.ListTrackedPullRequests:input_type -> jules.ListTrackedPullRequestsRequest
+	113, // 132: jules.PullRequestService.ListFlakyChecks:input_type -> jules.ListFlakyChecksRequest
+	115, // 133: jules.PullRequestService.SetFlakyCheckIgnored:input_type -> jules.SetFlakyCheckIgnoredRequest
+	117, // 134: jules.PullRequestService.ListMergeDecisions:input_type -> jules.ListMergeDecisionsRequest
+	7,   // 135: jules.SettingsService.GetSettings:output_type -> jules.Settings
+	10,  // 136: jules.SettingsService.UpdateSettings:output_type -> jules.UpdateSettingsResponse
+	12,  // 137: jules.ProfileService.ListProfiles:output_type -> jules.ListProfilesResponse
+	11,  // 138: jules.ProfileService.CreateProfile:output_type -> jules.Profile
+	121, // 139: jules.ProfileService.DeleteProfile:output_type -> google.protobuf.Empty
+	17,  // 140: jules.LogService.GetLogs:output_type -> jules.GetLogsResponse
+	19,  // 141: jules.CronJobService.ListCronJobs:output_type -> jules.ListCronJobsResponse
+	18,  // 142: jules.CronJobService.CreateCronJob:output_type -> jules.CronJob
+	121, // 143: jules.CronJobService.UpdateCronJob:output_type -> google.protobuf.Empty
+	121, // 144: jules.CronJobService.DeleteCronJob:output_type -> google.protobuf.Empty
+	121, // 145: jules.CronJobService.ExecuteCronJob:output_type -> google.protobuf.Empty
+	121, // 146: jules.CronJobService.ToggleCronJob:output_type -> google.protobuf.Empty
+	26,  // 147: jules.CronJobService.ListCronRuns:output_type -> jules.ListCronRunsResponse
+	28,  // 148: jules.CronJobService.PreviewCronSchedule:output_type -> jules.PreviewCronScheduleResponse
+	35,  // 149: jules.JobService.ListJobs:output_type -> jules.ListJobsResponse
+	30,  // 150: jules.JobService.GetJob:output_type -> jules.Job
+	30,  // 151: jules.JobService.CreateJob:output_type -> jules.Job
+	121, // 152: jules.JobService.CreateManyJobs:output_type -> google.protobuf.Empty
+	121, // 153: jules.JobService.UpdateJob:output_type -> google.protobuf.Empty
+	121, // 154: jules.JobService.DeleteJob:output_type -> google.protobuf.Empty
+	45,  // 155: jules.JobService.CancelJob:output_type -> jules.CancelJobResponse
+	30,  // 156: jules.JobService.RetryFailedSessions:output_type -> jules.Job
+	30,  // 157: jules.JobService.RerunJob:output_type -> jules.Job
+	30,  // 158: jules.JobService.RerunFailedSessions:output_type -> jules.Job
+	47,  // 159: jules.PromptService.ListPredefinedPrompts:output_type -> jules.ListPredefinedPromptsResponse
+	46,  // 160: jules.PromptService.GetPredefinedPrompt:output_type -> jules.PredefinedPrompt
+	46,  // 161: jules.PromptService.CreatePredefinedPrompt:output_type -> jules.PredefinedPrompt
+	121, // 162: jules.PromptService.CreateManyPredefinedPrompts:output_type -> google.protobuf.Empty
+	121, // 163: jules.PromptService.UpdatePredefinedPrompt:output_type -> google.protobuf.Empty
+	121, // 164: jules.PromptService.DeletePredefinedPrompt:output_type -> google.protobuf.Empty
+	47,  // 165: jules.PromptService.ListQuickReplies:output_type -> jules.ListPredefinedPromptsResponse
+	46,  // 166: jules.PromptService.GetQuickReply:output_type -> jules.PredefinedPrompt
+	46,  // 167: jules.PromptService.CreateQuickReply:output_type -> jules.PredefinedPrompt
+	121, // 168: jules.PromptService.CreateManyQuickReplies:output_type -> google.protobuf.Empty
+	121, // 169: jules.PromptService.UpdateQuickReply:output_type -> google.protobuf.Empty
+	121, // 170: jules.PromptService.DeleteQuickReply:output_type -> google.protobuf.Empty
+	53,  // 171: jules.PromptService.GetGlobalPrompt:output_type -> jules.GlobalPrompt
+	121, // 172: jules.PromptService.SaveGlobalPrompt:output_type -> google.protobuf.Empty
+	56,  // 173: jules.PromptService.ListHistoryPrompts:output_type -> jules.ListHistoryPromptsResponse
+	56,  // 174: jules.PromptService.GetRecentHistoryPrompts:output_type -> jules.ListHistoryPromptsResponse
+	121, // 175: jules.PromptService.SaveHistoryPrompt:output_type -> google.protobuf.Empty
+	59,  // 176: jules.PromptService.GetRepoPrompt:output_type -> jules.RepoPrompt
+	121, // 177: jules.PromptService.SaveRepoPrompt:output_type -> google.protobuf.Empty
+	64,  // 178: jules.SessionService.ListSessions:output_type -> jules.ListSessionsResponse
+	62,  // 179: jules.SessionService.GetSession:output_type -> jules.Session
+	62,  // 180: jules.SessionService.CreateSession:output_type -> jules.Session
+	121, // 181: jules.SessionService.UpdateSession:output_type -> google.protobuf.Empty
+	121, // 182: jules.SessionService.DeleteSession:output_type -> google.protobuf.Empty
+	121, // 183: jules.SessionService.ApprovePlan:output_type -> google.protobuf.Empty
+	121, // 184: jules.SessionService.SendMessage:output_type -> google.protobuf.Empty
+	71,  // 185: jules.ChatService.GetChatConfig:output_type -> jules.ChatConfig
+	71,  // 186: jules.ChatService.CreateChatConfig:output_type -> jules.ChatConfig
+	121, // 187: jules.ChatService.SendChatMessage:output_type -> google.protobuf.Empty
+	77,  // 188: jules.ChatService.ListChatMessages:output_type -> jules.ListChatMessagesResponse
+	80,  // 189: jules.StateService.ApplyState:output_type -> jules.ApplyStateResponse
+	30,  // 190: jules.QueueService.EnqueueJob:output_type -> jules.Job
+	97,  // 191: jules.QueueService.ListQueue:output_type -> jules.ListQueueResponse
+	30,  // 192: jules.QueueService.SetJobPriority:output_type -> jules.Job
+	98,  // 193: jules.QueueService.MoveQueuedJob:output_type -> jules.RepoQueue
+	98,  // 194: jules.QueueService.SetRepoConcurrency:output_type -> jules.RepoQueue
+	103, // 195: jules.EventTriggerService.ListEventTriggers:output_type -> jules.ListEventTriggersResponse
+	102, // 196: jules.EventTriggerService.CreateEventTrigger:output_type -> jules.EventTrigger
+	102, // 197: jules.EventTriggerService.UpdateEventTrigger:output_type -> jules.EventTrigger
+	121, // 198: jules.EventTriggerService.DeleteEventTrigger:output_type -> google.protobuf.Empty
+	108, // 199: jules.EventTriggerService.ListTriggeredEvents:output_type -> jules.ListTriggeredEventsResponse
+	83,  // 200: jules.PipelineService.ListPipelines:output_type -> jules.ListPipelinesResponse
+	82,  // 201: jules.PipelineService.GetPipeline:output_type -> jules.Pipeline
+	82,  // 202: jules.PipelineService.CreatePipeline:output_type -> jules.Pipeline
+	82,  // 203: jules.PipelineService.UpdatePipeline:output_type -> jules.Pipeline
+	121, // 204: jules.PipelineService.DeletePipeline:output_type -> google.protobuf.Empty
+	93,  // 205: jules.PipelineService.StartPipeline:output_type -> jules.PipelineRun
+	93,  // 206: jules.PipelineService.CancelPipelineRun:output_type -> jules.PipelineRun
+	93,  // 207: jules.PipelineService.GetPipelineRun:output_type -> jules.PipelineRun
+	94,  // 208: jules.PipelineService.ListPipelineRuns:output_type -> jules.ListPipelineRunsResponse
+	111, // 209: jules.PullRequestService.ListTrackedPullRequests:output_type -> jules.ListTrackedPullRequestsResponse
+	114, // 210: jules.PullRequestService.ListFlakyChecks:output_type -> jules.ListFlakyChecksResponse
+	116, // 211: jules.PullRequestService.SetFlakyCheckIgnored:output_type -> jules.FlakyCheck
+	118, // 212: jules.PullRequestService.ListMergeDecisions:output_type -> jules.ListMergeDecisionsResponse
+	135, // [135:213] is the sub-list for method output_type
+	57,  // [57:135] is the sub-list for method input_type
+	57,  // [57:57] is the sub-list for extension type_name
+	57,  // [57:57] is the sub-list for extension extendee
+	0,   // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_jules_proto_init() }
@@ -4562,17 +9665,19 @@ func file_jules_proto_init() {
 		return
 	}
 	file_jules_proto_msgTypes[14].OneofWrappers = []any{}
-	file_jules_proto_msgTypes[23].OneofWrappers = []any{}
-	file_jules_proto_msgTypes[30].OneofWrappers = []any{}
+	file_jules_proto_msgTypes[32].OneofWrappers = []any{}
+	file_jules_proto_msgTypes[35].OneofWrappers = []any{}
+	file_jules_proto_msgTypes[44].OneofWrappers = []any{}
+	file_jules_proto_msgTypes[98].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jules_proto_rawDesc), len(file_jules_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   57,
+			NumEnums:      7,
+			NumMessages:   114,
 			NumExtensions: 0,
-			NumServices:   8,
+			NumServices:   13,
 		},
 		GoTypes:           file_jules_proto_goTypes,
 		DependencyIndexes: file_jules_proto_depIdxs,
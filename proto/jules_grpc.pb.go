@@ -440,12 +440,14 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CronJobService_ListCronJobs_FullMethodName   = "/jules.CronJobService/ListCronJobs"
-	CronJobService_CreateCronJob_FullMethodName  = "/jules.CronJobService/CreateCronJob"
-	CronJobService_UpdateCronJob_FullMethodName  = "/jules.CronJobService/UpdateCronJob"
-	CronJobService_DeleteCronJob_FullMethodName  = "/jules.CronJobService/DeleteCronJob"
-	CronJobService_ExecuteCronJob_FullMethodName = "/jules.CronJobService/ExecuteCronJob"
-	CronJobService_ToggleCronJob_FullMethodName  = "/jules.CronJobService/ToggleCronJob"
+	CronJobService_ListCronJobs_FullMethodName        = "/jules.CronJobService/ListCronJobs"
+	CronJobService_CreateCronJob_FullMethodName       = "/jules.CronJobService/CreateCronJob"
+	CronJobService_UpdateCronJob_FullMethodName       = "/jules.CronJobService/UpdateCronJob"
+	CronJobService_DeleteCronJob_FullMethodName       = "/jules.CronJobService/DeleteCronJob"
+	CronJobService_ExecuteCronJob_FullMethodName      = "/jules.CronJobService/ExecuteCronJob"
+	CronJobService_ToggleCronJob_FullMethodName       = "/jules.CronJobService/ToggleCronJob"
+	CronJobService_ListCronRuns_FullMethodName        = "/jules.CronJobService/ListCronRuns"
+	CronJobService_PreviewCronSchedule_FullMethodName = "/jules.CronJobService/PreviewCronSchedule"
 )
 
 // CronJobServiceClient is the client API for CronJobService service.
@@ -458,6 +460,8 @@ type CronJobServiceClient interface {
 	DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ExecuteCronJob(ctx context.Context, in *ExecuteCronJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ToggleCronJob(ctx context.Context, in *ToggleCronJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListCronRuns(ctx context.Context, in *ListCronRunsRequest, opts ...grpc.CallOption) (*ListCronRunsResponse, error)
+	PreviewCronSchedule(ctx context.Context, in *PreviewCronScheduleRequest, opts ...grpc.CallOption) (*PreviewCronScheduleResponse, error)
 }
 
 type cronJobServiceClient struct {
@@ -528,6 +532,26 @@ func (c *cronJobServiceClient) ToggleCronJob(ctx context.Context, in *ToggleCron
 	return out, nil
 }
 
+func (c *cronJobServiceClient) ListCronRuns(ctx context.Context, in *ListCronRunsRequest, opts ...grpc.CallOption) (*ListCronRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCronRunsResponse)
+	err := c.cc.Invoke(ctx, CronJobService_ListCronRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronJobServiceClient) PreviewCronSchedule(ctx context.Context, in *PreviewCronScheduleRequest, opts ...grpc.CallOption) (*PreviewCronScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewCronScheduleResponse)
+	err := c.cc.Invoke(ctx, CronJobService_PreviewCronSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CronJobServiceServer is the server API for CronJobService service.
 // All implementations must embed UnimplementedCronJobServiceServer
 // for forward compatibility.
@@ -538,6 +562,8 @@ type CronJobServiceServer interface {
 	DeleteCronJob(context.Context, *DeleteCronJobRequest) (*emptypb.Empty, error)
 	ExecuteCronJob(context.Context, *ExecuteCronJobRequest) (*emptypb.Empty, error)
 	ToggleCronJob(context.Context, *ToggleCronJobRequest) (*emptypb.Empty, error)
+	ListCronRuns(context.Context, *ListCronRunsRequest) (*ListCronRunsResponse, error)
+	PreviewCronSchedule(context.Context, *PreviewCronScheduleRequest) (*PreviewCronScheduleResponse, error)
 	mustEmbedUnimplementedCronJobServiceServer()
 }
 
@@ -566,6 +592,12 @@ func (UnimplementedCronJobServiceServer) ExecuteCronJob(context.Context, *Execut
 func (UnimplementedCronJobServiceServer) ToggleCronJob(context.Context, *ToggleCronJobRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ToggleCronJob not implemented")
 }
+func (UnimplementedCronJobServiceServer) ListCronRuns(context.Context, *ListCronRunsRequest) (*ListCronRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCronRuns not implemented")
+}
+func (UnimplementedCronJobServiceServer) PreviewCronSchedule(context.Context, *PreviewCronScheduleRequest) (*PreviewCronScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewCronSchedule not implemented")
+}
 func (UnimplementedCronJobServiceServer) mustEmbedUnimplementedCronJobServiceServer() {}
 func (UnimplementedCronJobServiceServer) testEmbeddedByValue()                        {}
 
@@ -695,6 +727,42 @@ func _CronJobService_ToggleCronJob_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CronJobService_ListCronRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCronRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronJobServiceServer).ListCronRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CronJobService_ListCronRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronJobServiceServer).ListCronRuns(ctx, req.(*ListCronRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CronJobService_PreviewCronSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCronScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronJobServiceServer).PreviewCronSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CronJobService_PreviewCronSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronJobServiceServer).PreviewCronSchedule(ctx, req.(*PreviewCronScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CronJobService_ServiceDesc is the grpc.ServiceDesc for CronJobService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -726,18 +794,30 @@ var CronJobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleCronJob",
 			Handler:    _CronJobService_ToggleCronJob_Handler,
 		},
+		{
+			MethodName: "ListCronRuns",
+			Handler:    _CronJobService_ListCronRuns_Handler,
+		},
+		{
+			MethodName: "PreviewCronSchedule",
+			Handler:    _CronJobService_PreviewCronSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jules.proto",
 }
 
 const (
-	JobService_ListJobs_FullMethodName       = "/jules.JobService/ListJobs"
-	JobService_GetJob_FullMethodName         = "/jules.JobService/GetJob"
-	JobService_CreateJob_FullMethodName      = "/jules.JobService/CreateJob"
-	JobService_CreateManyJobs_FullMethodName = "/jules.JobService/CreateManyJobs"
-	JobService_UpdateJob_FullMethodName      = "/jules.JobService/UpdateJob"
-	JobService_DeleteJob_FullMethodName      = "/jules.JobService/DeleteJob"
+	JobService_ListJobs_FullMethodName            = "/jules.JobService/ListJobs"
+	JobService_GetJob_FullMethodName              = "/jules.JobService/GetJob"
+	JobService_CreateJob_FullMethodName           = "/jules.JobService/CreateJob"
+	JobService_CreateManyJobs_FullMethodName      = "/jules.JobService/CreateManyJobs"
+	JobService_UpdateJob_FullMethodName           = "/jules.JobService/UpdateJob"
+	JobService_DeleteJob_FullMethodName           = "/jules.JobService/DeleteJob"
+	JobService_CancelJob_FullMethodName           = "/jules.JobService/CancelJob"
+	JobService_RetryFailedSessions_FullMethodName = "/jules.JobService/RetryFailedSessions"
+	JobService_RerunJob_FullMethodName            = "/jules.JobService/RerunJob"
+	JobService_RerunFailedSessions_FullMethodName = "/jules.JobService/RerunFailedSessions"
 )
 
 // JobServiceClient is the client API for JobService service.
@@ -750,6 +830,14 @@ type JobServiceClient interface {
 	CreateManyJobs(ctx context.Context, in *CreateManyJobsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateJob(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CancelJob stops a job from creating more sessions and tears down the remote sessions it spawned.
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	// RetryFailedSessions re-queues the sessions of a job whose creation failed.
+	RetryFailedSessions(ctx context.Context, in *RetryFailedSessionsRequest, opts ...grpc.CallOption) (*Job, error)
+	// RerunJob creates a new job cloned from an existing one, with optional overrides.
+	RerunJob(ctx context.Context, in *RerunJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// RerunFailedSessions creates a new job with one session per failed session (or session without a PR) of an existing job.
+	RerunFailedSessions(ctx context.Context, in *RerunFailedSessionsRequest, opts ...grpc.CallOption) (*Job, error)
 }
 
 type jobServiceClient struct {
@@ -820,6 +908,46 @@ func (c *jobServiceClient) DeleteJob(ctx context.Context, in *DeleteJobRequest,
 	return out, nil
 }
 
+func (c *jobServiceClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, JobService_CancelJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RetryFailedSessions(ctx context.Context, in *RetryFailedSessionsRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, JobService_RetryFailedSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RerunJob(ctx context.Context, in *RerunJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, JobService_RerunJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RerunFailedSessions(ctx context.Context, in *RerunFailedSessionsRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, JobService_RerunFailedSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobServiceServer is the server API for JobService service.
 // All implementations must embed UnimplementedJobServiceServer
 // for forward compatibility.
@@ -830,6 +958,14 @@ type JobServiceServer interface {
 	CreateManyJobs(context.Context, *CreateManyJobsRequest) (*emptypb.Empty, error)
 	UpdateJob(context.Context, *UpdateJobRequest) (*emptypb.Empty, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*emptypb.Empty, error)
+	// CancelJob stops a job from creating more sessions and tears down the remote sessions it spawned.
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	// RetryFailedSessions re-queues the sessions of a job whose creation failed.
+	RetryFailedSessions(context.Context, *RetryFailedSessionsRequest) (*Job, error)
+	// RerunJob creates a new job cloned from an existing one, with optional overrides.
+	RerunJob(context.Context, *RerunJobRequest) (*Job, error)
+	// RerunFailedSessions creates a new job with one session per failed session (or session without a PR) of an existing job.
+	RerunFailedSessions(context.Context, *RerunFailedSessionsRequest) (*Job, error)
 	mustEmbedUnimplementedJobServiceServer()
 }
 
@@ -858,6 +994,18 @@ func (UnimplementedJobServiceServer) UpdateJob(context.Context, *UpdateJobReques
 func (UnimplementedJobServiceServer) DeleteJob(context.Context, *DeleteJobRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteJob not implemented")
 }
+func (UnimplementedJobServiceServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedJobServiceServer) RetryFailedSessions(context.Context, *RetryFailedSessionsRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryFailedSessions not implemented")
+}
+func (UnimplementedJobServiceServer) RerunJob(context.Context, *RerunJobRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method RerunJob not implemented")
+}
+func (UnimplementedJobServiceServer) RerunFailedSessions(context.Context, *RerunFailedSessionsRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method RerunFailedSessions not implemented")
+}
 func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
 func (UnimplementedJobServiceServer) testEmbeddedByValue()                    {}
 
@@ -987,6 +1135,78 @@ func _JobService_DeleteJob_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RetryFailedSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryFailedSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RetryFailedSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RetryFailedSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RetryFailedSessions(ctx, req.(*RetryFailedSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RerunJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RerunJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RerunJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RerunJob(ctx, req.(*RerunJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RerunFailedSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunFailedSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RerunFailedSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RerunFailedSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RerunFailedSessions(ctx, req.(*RerunFailedSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1018,6 +1238,22 @@ var JobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJob",
 			Handler:    _JobService_DeleteJob_Handler,
 		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _JobService_CancelJob_Handler,
+		},
+		{
+			MethodName: "RetryFailedSessions",
+			Handler:    _JobService_RetryFailedSessions_Handler,
+		},
+		{
+			MethodName: "RerunJob",
+			Handler:    _JobService_RerunJob_Handler,
+		},
+		{
+			MethodName: "RerunFailedSessions",
+			Handler:    _JobService_RerunFailedSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jules.proto",
@@ -2364,3 +2600,1253 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jules.proto",
 }
+
+const (
+	StateService_ApplyState_FullMethodName = "/jules.StateService/ApplyState"
+)
+
+// StateServiceClient is the client API for StateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StateServiceClient interface {
+	// ApplyState upserts profiles, settings, prompts and cron jobs from a declarative YAML state file.
+	ApplyState(ctx context.Context, in *ApplyStateRequest, opts ...grpc.CallOption) (*ApplyStateResponse, error)
+}
+
+type stateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStateServiceClient(cc grpc.ClientConnInterface) StateServiceClient {
+	return &stateServiceClient{cc}
+}
+
+func (c *stateServiceClient) ApplyState(ctx context.Context, in *ApplyStateRequest, opts ...grpc.CallOption) (*ApplyStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyStateResponse)
+	err := c.cc.Invoke(ctx, StateService_ApplyState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StateServiceServer is the server API for StateService service.
+// All implementations must embed UnimplementedStateServiceServer
+// for forward compatibility.
+type StateServiceServer interface {
+	// ApplyState upserts profiles, settings, prompts and cron jobs from a declarative YAML state file.
+	ApplyState(context.Context, *ApplyStateRequest) (*ApplyStateResponse, error)
+	mustEmbedUnimplementedStateServiceServer()
+}
+
+// UnimplementedStateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStateServiceServer struct{}
+
+func (UnimplementedStateServiceServer) ApplyState(context.Context, *ApplyStateRequest) (*ApplyStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyState not implemented")
+}
+func (UnimplementedStateServiceServer) mustEmbedUnimplementedStateServiceServer() {}
+func (UnimplementedStateServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeStateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StateServiceServer will
+// result in compilation errors.
+type UnsafeStateServiceServer interface {
+	mustEmbedUnimplementedStateServiceServer()
+}
+
+func RegisterStateServiceServer(s grpc.ServiceRegistrar, srv StateServiceServer) {
+	// If the following call panics, it indicates UnimplementedStateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StateService_ServiceDesc, srv)
+}
+
+func _StateService_ApplyState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).ApplyState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StateService_ApplyState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).ApplyState(ctx, req.(*ApplyStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StateService_ServiceDesc is the grpc.ServiceDesc for StateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jules.StateService",
+	HandlerType: (*StateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplyState",
+			Handler:    _StateService_ApplyState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "jules.proto",
+}
+
+const (
+	QueueService_EnqueueJob_FullMethodName         = "/jules.QueueService/EnqueueJob"
+	QueueService_ListQueue_FullMethodName          = "/jules.QueueService/ListQueue"
+	QueueService_SetJobPriority_FullMethodName     = "/jules.QueueService/SetJobPriority"
+	QueueService_MoveQueuedJob_FullMethodName      = "/jules.QueueService/MoveQueuedJob"
+	QueueService_SetRepoConcurrency_FullMethodName = "/jules.QueueService/SetRepoConcurrency"
+)
+
+// QueueServiceClient is the client API for QueueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueueServiceClient interface {
+	// EnqueueJob adds a job to its repo queue. It starts once the repo has fewer active sessions/PRs than its limit.
+	EnqueueJob(ctx context.Context, in *EnqueueJobRequest, opts ...grpc.CallOption) (*Job, error)
+	ListQueue(ctx context.Context, in *ListQueueRequest, opts ...grpc.CallOption) (*ListQueueResponse, error)
+	SetJobPriority(ctx context.Context, in *SetJobPriorityRequest, opts ...grpc.CallOption) (*Job, error)
+	MoveQueuedJob(ctx context.Context, in *MoveQueuedJobRequest, opts ...grpc.CallOption) (*RepoQueue, error)
+	SetRepoConcurrency(ctx context.Context, in *SetRepoConcurrencyRequest, opts ...grpc.CallOption) (*RepoQueue, error)
+}
+
+type queueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueueServiceClient(cc grpc.ClientConnInterface) QueueServiceClient {
+	return &queueServiceClient{cc}
+}
+
+func (c *queueServiceClient) EnqueueJob(ctx context.Context, in *EnqueueJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, QueueService_EnqueueJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) ListQueue(ctx context.Context, in *ListQueueRequest, opts ...grpc.CallOption) (*ListQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueueResponse)
+	err := c.cc.Invoke(ctx, QueueService_ListQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) SetJobPriority(ctx context.Context, in *SetJobPriorityRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, QueueService_SetJobPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) MoveQueuedJob(ctx context.Context, in *MoveQueuedJobRequest, opts ...grpc.CallOption) (*RepoQueue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepoQueue)
+	err := c.cc.Invoke(ctx, QueueService_MoveQueuedJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) SetRepoConcurrency(ctx context.Context, in *SetRepoConcurrencyRequest, opts ...grpc.CallOption) (*RepoQueue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepoQueue)
+	err := c.cc.Invoke(ctx, QueueService_SetRepoConcurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueueServiceServer is the server API for QueueService service.
+// All implementations must embed UnimplementedQueueServiceServer
+// for forward compatibility.
+type QueueServiceServer interface {
+	// EnqueueJob adds a job to its repo queue. It starts once the repo has fewer active sessions/PRs than its limit.
+	EnqueueJob(context.Context, *EnqueueJobRequest) (*Job, error)
+	ListQueue(context.Context, *ListQueueRequest) (*ListQueueResponse, error)
+	SetJobPriority(context.Context, *SetJobPriorityRequest) (*Job, error)
+	MoveQueuedJob(context.Context, *MoveQueuedJobRequest) (*RepoQueue, error)
+	SetRepoConcurrency(context.Context, *SetRepoConcurrencyRequest) (*RepoQueue, error)
+	mustEmbedUnimplementedQueueServiceServer()
+}
+
+// UnimplementedQueueServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueueServiceServer struct{}
+
+func (UnimplementedQueueServiceServer) EnqueueJob(context.Context, *EnqueueJobRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnqueueJob not implemented")
+}
+func (UnimplementedQueueServiceServer) ListQueue(context.Context, *ListQueueRequest) (*ListQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueue not implemented")
+}
+func (UnimplementedQueueServiceServer) SetJobPriority(context.Context, *SetJobPriorityRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetJobPriority not implemented")
+}
+func (UnimplementedQueueServiceServer) MoveQueuedJob(context.Context, *MoveQueuedJobRequest) (*RepoQueue, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveQueuedJob not implemented")
+}
+func (UnimplementedQueueServiceServer) SetRepoConcurrency(context.Context, *SetRepoConcurrencyRequest) (*RepoQueue, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoConcurrency not implemented")
+}
+func (UnimplementedQueueServiceServer) mustEmbedUnimplementedQueueServiceServer() {}
+func (UnimplementedQueueServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeQueueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueueServiceServer will
+// result in compilation errors.
+type UnsafeQueueServiceServer interface {
+	mustEmbedUnimplementedQueueServiceServer()
+}
+
+func RegisterQueueServiceServer(s grpc.ServiceRegistrar, srv QueueServiceServer) {
+	// If the following call panics, it indicates UnimplementedQueueServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QueueService_ServiceDesc, srv)
+}
+
+func _QueueService_EnqueueJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).EnqueueJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueueService_EnqueueJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).EnqueueJob(ctx, req.(*EnqueueJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_ListQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).ListQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueueService_ListQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).ListQueue(ctx, req.(*ListQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_SetJobPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJobPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).SetJobPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueueService_SetJobPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).SetJobPriority(ctx, req.(*SetJobPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_MoveQueuedJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveQueuedJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).MoveQueuedJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueueService_MoveQueuedJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).MoveQueuedJob(ctx, req.(*MoveQueuedJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_SetRepoConcurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoConcurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).SetRepoConcurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueueService_SetRepoConcurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).SetRepoConcurrency(ctx, req.(*SetRepoConcurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QueueService_ServiceDesc is the grpc.ServiceDesc for QueueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QueueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jules.QueueService",
+	HandlerType: (*QueueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnqueueJob",
+			Handler:    _QueueService_EnqueueJob_Handler,
+		},
+		{
+			MethodName: "ListQueue",
+			Handler:    _QueueService_ListQueue_Handler,
+		},
+		{
+			MethodName: "SetJobPriority",
+			Handler:    _QueueService_SetJobPriority_Handler,
+		},
+		{
+			MethodName: "MoveQueuedJob",
+			Handler:    _QueueService_MoveQueuedJob_Handler,
+		},
+		{
+			MethodName: "SetRepoConcurrency",
+			Handler:    _QueueService_SetRepoConcurrency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "jules.proto",
+}
+
+const (
+	EventTriggerService_ListEventTriggers_FullMethodName   = "/jules.EventTriggerService/ListEventTriggers"
+	EventTriggerService_CreateEventTrigger_FullMethodName  = "/jules.EventTriggerService/CreateEventTrigger"
+	EventTriggerService_UpdateEventTrigger_FullMethodName  = "/jules.EventTriggerService/UpdateEventTrigger"
+	EventTriggerService_DeleteEventTrigger_FullMethodName  = "/jules.EventTriggerService/DeleteEventTrigger"
+	EventTriggerService_ListTriggeredEvents_FullMethodName = "/jules.EventTriggerService/ListTriggeredEvents"
+)
+
+// EventTriggerServiceClient is the client API for EventTriggerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventTriggerServiceClient interface {
+	ListEventTriggers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEventTriggersResponse, error)
+	CreateEventTrigger(ctx context.Context, in *CreateEventTriggerRequest, opts ...grpc.CallOption) (*EventTrigger, error)
+	UpdateEventTrigger(ctx context.Context, in *UpdateEventTriggerRequest, opts ...grpc.CallOption) (*EventTrigger, error)
+	DeleteEventTrigger(ctx context.Context, in *DeleteEventTriggerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListTriggeredEvents returns the events a trigger started jobs for, newest first.
+	ListTriggeredEvents(ctx context.Context, in *ListTriggeredEventsRequest, opts ...grpc.CallOption) (*ListTriggeredEventsResponse, error)
+}
+
+type eventTriggerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventTriggerServiceClient(cc grpc.ClientConnInterface) EventTriggerServiceClient {
+	return &eventTriggerServiceClient{cc}
+}
+
+func (c *eventTriggerServiceClient) ListEventTriggers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEventTriggersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventTriggersResponse)
+	err := c.cc.Invoke(ctx, EventTriggerService_ListEventTriggers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventTriggerServiceClient) CreateEventTrigger(ctx context.Context, in *CreateEventTriggerRequest, opts ...grpc.CallOption) (*EventTrigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventTrigger)
+	err := c.cc.Invoke(ctx, EventTriggerService_CreateEventTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventTriggerServiceClient) UpdateEventTrigger(ctx context.Context, in *UpdateEventTriggerRequest, opts ...grpc.CallOption) (*EventTrigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventTrigger)
+	err := c.cc.Invoke(ctx, EventTriggerService_UpdateEventTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventTriggerServiceClient) DeleteEventTrigger(ctx context.Context, in *DeleteEventTriggerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventTriggerService_DeleteEventTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventTriggerServiceClient) ListTriggeredEvents(ctx context.Context, in *ListTriggeredEventsRequest, opts ...grpc.CallOption) (*ListTriggeredEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTriggeredEventsResponse)
+	err := c.cc.Invoke(ctx, EventTriggerService_ListTriggeredEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventTriggerServiceServer is the server API for EventTriggerService service.
+// All implementations must embed UnimplementedEventTriggerServiceServer
+// for forward compatibility.
+type EventTriggerServiceServer interface {
+	ListEventTriggers(context.Context, *emptypb.Empty) (*ListEventTriggersResponse, error)
+	CreateEventTrigger(context.Context, *CreateEventTriggerRequest) (*EventTrigger, error)
+	UpdateEventTrigger(context.Context, *UpdateEventTriggerRequest) (*EventTrigger, error)
+	DeleteEventTrigger(context.Context, *DeleteEventTriggerRequest) (*emptypb.Empty, error)
+	// ListTriggeredEvents returns the events a trigger started jobs for, newest first.
+	ListTriggeredEvents(context.Context, *ListTriggeredEventsRequest) (*ListTriggeredEventsResponse, error)
+	mustEmbedUnimplementedEventTriggerServiceServer()
+}
+
+// UnimplementedEventTriggerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventTriggerServiceServer struct{}
+
+func (UnimplementedEventTriggerServiceServer) ListEventTriggers(context.Context, *emptypb.Empty) (*ListEventTriggersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEventTriggers not implemented")
+}
+func (UnimplementedEventTriggerServiceServer) CreateEventTrigger(context.Context, *CreateEventTriggerRequest) (*EventTrigger, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEventTrigger not implemented")
+}
+func (UnimplementedEventTriggerServiceServer) UpdateEventTrigger(context.Context, *UpdateEventTriggerRequest) (*EventTrigger, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEventTrigger not implemented")
+}
+func (UnimplementedEventTriggerServiceServer) DeleteEventTrigger(context.Context, *DeleteEventTriggerRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEventTrigger not implemented")
+}
+func (UnimplementedEventTriggerServiceServer) ListTriggeredEvents(context.Context, *ListTriggeredEventsRequest) (*ListTriggeredEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTriggeredEvents not implemented")
+}
+func (UnimplementedEventTriggerServiceServer) mustEmbedUnimplementedEventTriggerServiceServer() {}
+func (UnimplementedEventTriggerServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeEventTriggerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventTriggerServiceServer will
+// result in compilation errors.
+type UnsafeEventTriggerServiceServer interface {
+	mustEmbedUnimplementedEventTriggerServiceServer()
+}
+
+func RegisterEventTriggerServiceServer(s grpc.ServiceRegistrar, srv EventTriggerServiceServer) {
+	// If the following call panics, it indicates UnimplementedEventTriggerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventTriggerService_ServiceDesc, srv)
+}
+
+func _EventTriggerService_ListEventTriggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventTriggerServiceServer).ListEventTriggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventTriggerService_ListEventTriggers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventTriggerServiceServer).ListEventTriggers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventTriggerService_CreateEventTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventTriggerServiceServer).CreateEventTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventTriggerService_CreateEventTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventTriggerServiceServer).CreateEventTrigger(ctx, req.(*CreateEventTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventTriggerService_UpdateEventTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventTriggerServiceServer).UpdateEventTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventTriggerService_UpdateEventTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventTriggerServiceServer).UpdateEventTrigger(ctx, req.(*UpdateEventTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventTriggerService_DeleteEventTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventTriggerServiceServer).DeleteEventTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventTriggerService_DeleteEventTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventTriggerServiceServer).DeleteEventTrigger(ctx, req.(*DeleteEventTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventTriggerService_ListTriggeredEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTriggeredEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventTriggerServiceServer).ListTriggeredEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventTriggerService_ListTriggeredEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventTriggerServiceServer).ListTriggeredEvents(ctx, req.(*ListTriggeredEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventTriggerService_ServiceDesc is the grpc.ServiceDesc for EventTriggerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventTriggerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jules.EventTriggerService",
+	HandlerType: (*EventTriggerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEventTriggers",
+			Handler:    _EventTriggerService_ListEventTriggers_Handler,
+		},
+		{
+			MethodName: "CreateEventTrigger",
+			Handler:    _EventTriggerService_CreateEventTrigger_Handler,
+		},
+		{
+			MethodName: "UpdateEventTrigger",
+			Handler:    _EventTriggerService_UpdateEventTrigger_Handler,
+		},
+		{
+			MethodName: "DeleteEventTrigger",
+			Handler:    _EventTriggerService_DeleteEventTrigger_Handler,
+		},
+		{
+			MethodName: "ListTriggeredEvents",
+			Handler:    _EventTriggerService_ListTriggeredEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "jules.proto",
+}
+
+const (
+	PipelineService_ListPipelines_FullMethodName     = "/jules.PipelineService/ListPipelines"
+	PipelineService_GetPipeline_FullMethodName       = "/jules.PipelineService/GetPipeline"
+	PipelineService_CreatePipeline_FullMethodName    = "/jules.PipelineService/CreatePipeline"
+	PipelineService_UpdatePipeline_FullMethodName    = "/jules.PipelineService/UpdatePipeline"
+	PipelineService_DeletePipeline_FullMethodName    = "/jules.PipelineService/DeletePipeline"
+	PipelineService_StartPipeline_FullMethodName     = "/jules.PipelineService/StartPipeline"
+	PipelineService_CancelPipelineRun_FullMethodName = "/jules.PipelineService/CancelPipelineRun"
+	PipelineService_GetPipelineRun_FullMethodName    = "/jules.PipelineService/GetPipelineRun"
+	PipelineService_ListPipelineRuns_FullMethodName  = "/jules.PipelineService/ListPipelineRuns"
+)
+
+// PipelineServiceClient is the client API for PipelineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PipelineServiceClient interface {
+	ListPipelines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPipelinesResponse, error)
+	GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// StartPipeline starts a new run and creates the jobs of the steps without dependencies.
+	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*PipelineRun, error)
+	CancelPipelineRun(ctx context.Context, in *CancelPipelineRunRequest, opts ...grpc.CallOption) (*PipelineRun, error)
+	GetPipelineRun(ctx context.Context, in *GetPipelineRunRequest, opts ...grpc.CallOption) (*PipelineRun, error)
+	// ListPipelineRuns returns the run history of a pipeline, newest first.
+	ListPipelineRuns(ctx context.Context, in *ListPipelineRunsRequest, opts ...grpc.CallOption) (*ListPipelineRunsResponse, error)
+}
+
+type pipelineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPipelineServiceClient(cc grpc.ClientConnInterface) PipelineServiceClient {
+	return &pipelineServiceClient{cc}
+}
+
+func (c *pipelineServiceClient) ListPipelines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPipelinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPipelinesResponse)
+	err := c.cc.Invoke(ctx, PipelineService_ListPipelines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, PipelineService_GetPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, PipelineService_CreatePipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, PipelineService_UpdatePipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PipelineService_DeletePipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*PipelineRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PipelineRun)
+	err := c.cc.Invoke(ctx, PipelineService_StartPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) CancelPipelineRun(ctx context.Context, in *CancelPipelineRunRequest, opts ...grpc.CallOption) (*PipelineRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PipelineRun)
+	err := c.cc.Invoke(ctx, PipelineService_CancelPipelineRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) GetPipelineRun(ctx context.Context, in *GetPipelineRunRequest, opts ...grpc.CallOption) (*PipelineRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PipelineRun)
+	err := c.cc.Invoke(ctx, PipelineService_GetPipelineRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) ListPipelineRuns(ctx context.Context, in *ListPipelineRunsRequest, opts ...grpc.CallOption) (*ListPipelineRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPipelineRunsResponse)
+	err := c.cc.Invoke(ctx, PipelineService_ListPipelineRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PipelineServiceServer is the server API for PipelineService service.
+// All implementations must embed UnimplementedPipelineServiceServer
+// for forward compatibility.
+type PipelineServiceServer interface {
+	ListPipelines(context.Context, *emptypb.Empty) (*ListPipelinesResponse, error)
+	GetPipeline(context.Context, *GetPipelineRequest) (*Pipeline, error)
+	CreatePipeline(context.Context, *CreatePipelineRequest) (*Pipeline, error)
+	UpdatePipeline(context.Context, *UpdatePipelineRequest) (*Pipeline, error)
+	DeletePipeline(context.Context, *DeletePipelineRequest) (*emptypb.Empty, error)
+	// StartPipeline starts a new run and creates the jobs of the steps without dependencies.
+	StartPipeline(context.Context, *StartPipelineRequest) (*PipelineRun, error)
+	CancelPipelineRun(context.Context, *CancelPipelineRunRequest) (*PipelineRun, error)
+	GetPipelineRun(context.Context, *GetPipelineRunRequest) (*PipelineRun, error)
+	// ListPipelineRuns returns the run history of a pipeline, newest first.
+	ListPipelineRuns(context.Context, *ListPipelineRunsRequest) (*ListPipelineRunsResponse, error)
+	mustEmbedUnimplementedPipelineServiceServer()
+}
+
+// UnimplementedPipelineServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPipelineServiceServer struct{}
+
+func (UnimplementedPipelineServiceServer) ListPipelines(context.Context, *emptypb.Empty) (*ListPipelinesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPipelines not implemented")
+}
+func (UnimplementedPipelineServiceServer) GetPipeline(context.Context, *GetPipelineRequest) (*Pipeline, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPipeline not implemented")
+}
+func (UnimplementedPipelineServiceServer) CreatePipeline(context.Context, *CreatePipelineRequest) (*Pipeline, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePipeline not implemented")
+}
+func (UnimplementedPipelineServiceServer) UpdatePipeline(context.Context, *UpdatePipelineRequest) (*Pipeline, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePipeline not implemented")
+}
+func (UnimplementedPipelineServiceServer) DeletePipeline(context.Context, *DeletePipelineRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePipeline not implemented")
+}
+func (UnimplementedPipelineServiceServer) StartPipeline(context.Context, *StartPipelineRequest) (*PipelineRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartPipeline not implemented")
+}
+func (UnimplementedPipelineServiceServer) CancelPipelineRun(context.Context, *CancelPipelineRunRequest) (*PipelineRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPipelineRun not implemented")
+}
+func (UnimplementedPipelineServiceServer) GetPipelineRun(context.Context, *GetPipelineRunRequest) (*PipelineRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPipelineRun not implemented")
+}
+func (UnimplementedPipelineServiceServer) ListPipelineRuns(context.Context, *ListPipelineRunsRequest) (*ListPipelineRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPipelineRuns not implemented")
+}
+func (UnimplementedPipelineServiceServer) mustEmbedUnimplementedPipelineServiceServer() {}
+func (UnimplementedPipelineServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePipelineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PipelineServiceServer will
+// result in compilation errors.
+type UnsafePipelineServiceServer interface {
+	mustEmbedUnimplementedPipelineServiceServer()
+}
+
+func RegisterPipelineServiceServer(s grpc.ServiceRegistrar, srv PipelineServiceServer) {
+	// If the following call panics, it indicates UnimplementedPipelineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PipelineService_ServiceDesc, srv)
+}
+
+func _PipelineService_ListPipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).ListPipelines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_ListPipelines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).ListPipelines(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_GetPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).GetPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_GetPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).GetPipeline(ctx, req.(*GetPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).CreatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_CreatePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).CreatePipeline(ctx, req.(*CreatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_UpdatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).UpdatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_UpdatePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).UpdatePipeline(ctx, req.(*UpdatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).DeletePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_DeletePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).DeletePipeline(ctx, req.(*DeletePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_StartPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).StartPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_StartPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).StartPipeline(ctx, req.(*StartPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_CancelPipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPipelineRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).CancelPipelineRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_CancelPipelineRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).CancelPipelineRun(ctx, req.(*CancelPipelineRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_GetPipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).GetPipelineRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_GetPipelineRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).GetPipelineRun(ctx, req.(*GetPipelineRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_ListPipelineRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipelineRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).ListPipelineRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_ListPipelineRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).ListPipelineRuns(ctx, req.(*ListPipelineRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PipelineService_ServiceDesc is the grpc.ServiceDesc for PipelineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PipelineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jules.PipelineService",
+	HandlerType: (*PipelineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPipelines",
+			Handler:    _PipelineService_ListPipelines_Handler,
+		},
+		{
+			MethodName: "GetPipeline",
+			Handler:    _PipelineService_GetPipeline_Handler,
+		},
+		{
+			MethodName: "CreatePipeline",
+			Handler:    _PipelineService_CreatePipeline_Handler,
+		},
+		{
+			MethodName: "UpdatePipeline",
+			Handler:    _PipelineService_UpdatePipeline_Handler,
+		},
+		{
+			MethodName: "DeletePipeline",
+			Handler:    _PipelineService_DeletePipeline_Handler,
+		},
+		{
+			MethodName: "StartPipeline",
+			Handler:    _PipelineService_StartPipeline_Handler,
+		},
+		{
+			MethodName: "CancelPipelineRun",
+			Handler:    _PipelineService_CancelPipelineRun_Handler,
+		},
+		{
+			MethodName: "GetPipelineRun",
+			Handler:    _PipelineService_GetPipelineRun_Handler,
+		},
+		{
+			MethodName: "ListPipelineRuns",
+			Handler:    _PipelineService_ListPipelineRuns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "jules.proto",
+}
+
+const (
+	PullRequestService_ListTrackedPullRequests_FullMethodName = "/jules.PullRequestService/ListTrackedPullRequests"
+	PullRequestService_ListFlakyChecks_FullMethodName         = "/jules.PullRequestService/ListFlakyChecks"
+	PullRequestService_SetFlakyCheckIgnored_FullMethodName    = "/jules.PullRequestService/SetFlakyCheckIgnored"
+	PullRequestService_ListMergeDecisions_FullMethodName      = "/jules.PullRequestService/ListMergeDecisions"
+)
+
+// PullRequestServiceClient is the client API for PullRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PullRequestServiceClient interface {
+	// ListTrackedPullRequests returns the PRs the PR monitor tracks, most recently updated first.
+	ListTrackedPullRequests(ctx context.Context, in *ListTrackedPullRequestsRequest, opts ...grpc.CallOption) (*ListTrackedPullRequestsResponse, error)
+	// ListFlakyChecks returns the checks that passed when rerun after failing, flakiest first.
+	ListFlakyChecks(ctx context.Context, in *ListFlakyChecksRequest, opts ...grpc.CallOption) (*ListFlakyChecksResponse, error)
+	// SetFlakyCheckIgnored makes the PR monitor ignore the failures of a check, or stop ignoring them.
+	SetFlakyCheckIgnored(ctx context.Context, in *SetFlakyCheckIgnoredRequest, opts ...grpc.CallOption) (*FlakyCheck, error)
+	// ListMergeDecisions explains why the PR monitor merged PRs or didn't, newest first.
+	ListMergeDecisions(ctx context.Context, in *ListMergeDecisionsRequest, opts ...grpc.CallOption) (*ListMergeDecisionsResponse, error)
+}
+
+type pullRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPullRequestServiceClient(cc grpc.ClientConnInterface) PullRequestServiceClient {
+	return &pullRequestServiceClient{cc}
+}
+
+func (c *pullRequestServiceClient) ListTrackedPullRequests(ctx context.Context, in *ListTrackedPullRequestsRequest, opts ...grpc.CallOption) (*ListTrackedPullRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrackedPullRequestsResponse)
+	err := c.cc.Invoke(ctx, PullRequestService_ListTrackedPullRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) ListFlakyChecks(ctx context.Context, in *ListFlakyChecksRequest, opts ...grpc.CallOption) (*ListFlakyChecksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlakyChecksResponse)
+	err := c.cc.Invoke(ctx, PullRequestService_ListFlakyChecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) SetFlakyCheckIgnored(ctx context.Context, in *SetFlakyCheckIgnoredRequest, opts ...grpc.CallOption) (*FlakyCheck, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlakyCheck)
+	err := c.cc.Invoke(ctx, PullRequestService_SetFlakyCheckIgnored_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) ListMergeDecisions(ctx context.Context, in *ListMergeDecisionsRequest, opts ...grpc.CallOption) (*ListMergeDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMergeDecisionsResponse)
+	err := c.cc.Invoke(ctx, PullRequestService_ListMergeDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PullRequestServiceServer is the server API for PullRequestService service.
+// All implementations must embed UnimplementedPullRequestServiceServer
+// for forward compatibility.
+type PullRequestServiceServer interface {
+	// ListTrackedPullRequests returns the PRs the PR monitor tracks, most recently updated first.
+	ListTrackedPullRequests(context.Context, *ListTrackedPullRequestsRequest) (*ListTrackedPullRequestsResponse, error)
+	// ListFlakyChecks returns the checks that passed when rerun after failing, flakiest first.
+	ListFlakyChecks(context.Context, *ListFlakyChecksRequest) (*ListFlakyChecksResponse, error)
+	// SetFlakyCheckIgnored makes the PR monitor ignore the failures of a check, or stop ignoring them.
+	SetFlakyCheckIgnored(context.Context, *SetFlakyCheckIgnoredRequest) (*FlakyCheck, error)
+	// ListMergeDecisions explains why the PR monitor merged PRs or didn't, newest first.
+	ListMergeDecisions(context.Context, *ListMergeDecisionsRequest) (*ListMergeDecisionsResponse, error)
+	mustEmbedUnimplementedPullRequestServiceServer()
+}
+
+// UnimplementedPullRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPullRequestServiceServer struct{}
+
+func (UnimplementedPullRequestServiceServer) ListTrackedPullRequests(context.Context, *ListTrackedPullRequestsRequest) (*ListTrackedPullRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrackedPullRequests not implemented")
+}
+func (UnimplementedPullRequestServiceServer) ListFlakyChecks(context.Context, *ListFlakyChecksRequest) (*ListFlakyChecksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFlakyChecks not implemented")
+}
+func (UnimplementedPullRequestServiceServer) SetFlakyCheckIgnored(context.Context, *SetFlakyCheckIgnoredRequest) (*FlakyCheck, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFlakyCheckIgnored not implemented")
+}
+func (UnimplementedPullRequestServiceServer) ListMergeDecisions(context.Context, *ListMergeDecisionsRequest) (*ListMergeDecisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMergeDecisions not implemented")
+}
+func (UnimplementedPullRequestServiceServer) mustEmbedUnimplementedPullRequestServiceServer() {}
+func (UnimplementedPullRequestServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePullRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PullRequestServiceServer will
+// result in compilation errors.
+type UnsafePullRequestServiceServer interface {
+	mustEmbedUnimplementedPullRequestServiceServer()
+}
+
+func RegisterPullRequestServiceServer(s grpc.ServiceRegistrar, srv PullRequestServiceServer) {
+	// If the following call panics, it indicates UnimplementedPullRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PullRequestService_ServiceDesc, srv)
+}
+
+func _PullRequestService_ListTrackedPullRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrackedPullRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ListTrackedPullRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ListTrackedPullRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ListTrackedPullRequests(ctx, req.(*ListTrackedPullRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_ListFlakyChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlakyChecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ListFlakyChecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ListFlakyChecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ListFlakyChecks(ctx, req.(*ListFlakyChecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_SetFlakyCheckIgnored_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFlakyCheckIgnoredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).SetFlakyCheckIgnored(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_SetFlakyCheckIgnored_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).SetFlakyCheckIgnored(ctx, req.(*SetFlakyCheckIgnoredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_ListMergeDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMergeDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ListMergeDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ListMergeDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ListMergeDecisions(ctx, req.(*ListMergeDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PullRequestService_ServiceDesc is the grpc.ServiceDesc for PullRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PullRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jules.PullRequestService",
+	HandlerType: (*PullRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrackedPullRequests",
+			Handler:    _PullRequestService_ListTrackedPullRequests_Handler,
+		},
+		{
+			MethodName: "ListFlakyChecks",
+			Handler:    _PullRequestService_ListFlakyChecks_Handler,
+		},
+		{
+			MethodName: "SetFlakyCheckIgnored",
+			Handler:    _PullRequestService_SetFlakyCheckIgnored_Handler,
+		},
+		{
+			MethodName: "ListMergeDecisions",
+			Handler:    _PullRequestService_ListMergeDecisions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "jules.proto",
+}
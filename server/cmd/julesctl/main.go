@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/mcpany/jules/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: julesctl apply -f <state.yaml> [-dry-run] [-prune] [-addr host:port]\n")
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "apply":
+		apply(os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+func apply(args []string) {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+	file := fs.String("f", "", "Path to the YAML state file")
+	dryRun := fs.Bool("dry-run", false, "Print the diff without applying it")
+	prune := fs.Bool("prune", false, "Delete items that are not declared in the state file")
+	addr := fs.String("addr", "localhost:50051", "Address of the Jules backend")
+	_ = fs.Parse(args)
+
+	if *file == "" {
+		usage()
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("failed to read state file: %v", err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if token := os.Getenv("JULES_INTERNAL_TOKEN"); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+
+	resp, err := pb.NewStateServiceClient(conn).ApplyState(ctx, &pb.ApplyStateRequest{
+		State:  string(data),
+		DryRun: *dryRun,
+		Prune:  *prune,
+	})
+	if err != nil {
+		log.Fatalf("could not apply state: %v", err)
+	}
+
+	if len(resp.Changes) == 0 {
+		fmt.Println("No changes.")
+		return
+	}
+	for _, c := range resp.Changes {
+		line := fmt.Sprintf("%-7s %-17s %s (profile %s)", c.Action, c.Kind, c.Id, c.ProfileId)
+		if len(c.Fields) > 0 {
+			line += ": " + strings.Join(c.Fields, ", ")
+		}
+		fmt.Println(line)
+	}
+	if resp.DryRun {
+		fmt.Printf("%d changes (dry run, nothing applied)\n", len(resp.Changes))
+	} else {
+		fmt.Printf("%d changes applied\n", len(resp.Changes))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	gclient "github.com/mcpany/jules/internal/github"
 	"github.com/mcpany/jules/internal/ratelimit"
 	"github.com/mcpany/jules/internal/service"
+	"github.com/mcpany/jules/internal/webhook"
 	"github.com/mcpany/jules/internal/worker"
 	pb "github.com/mcpany/jules/proto"
 
@@ -81,22 +83,72 @@ func main() {
 		DB:      dbConn,
 		Limiter: ratelimit.New(100 * time.Millisecond),
 	}
+	jobService.Sessions = sessionService
+	stateService := &service.StateServer{DB: dbConn}
+	pipelineService := &service.PipelineServer{DB: dbConn, Jobs: jobService}
+	queueService := &service.QueueServer{DB: dbConn, Jobs: jobService}
+	eventTriggerService := &service.EventTriggerServer{DB: dbConn, Jobs: jobService}
+
+	// Apply declarative state before workers start
+	if stateFile := os.Getenv("JULES_STATE_FILE"); stateFile != "" {
+		data, err := os.ReadFile(stateFile)
+		if err != nil {
+			log.Fatalf("failed to read state file: %v", err)
+		}
+		resp, err := stateService.ApplyState(context.Background(), &pb.ApplyStateRequest{
+			State: string(data),
+			Prune: os.Getenv("JULES_STATE_PRUNE") == "true",
+		})
+		if err != nil {
+			log.Fatalf("failed to apply state file: %v", err)
+		}
+		log.Printf("Applied state file %s (%d changes)", stateFile, len(resp.Changes))
+	}
 
 	// Initialize Worker Manager
 	workerManager := worker.NewManager()
 	workerManager.Register(worker.NewAutoApprovalWorker(dbConn, settingsService, sessionService))
 	workerManager.Register(worker.NewBackgroundJobWorker(dbConn, jobService, sessionService, settingsService))
 	workerManager.Register(worker.NewAutoDeleteStaleBranchWorker(dbConn, settingsService))
-	ghClient := gclient.NewClient(os.Getenv("GITHUB_TOKEN"))
+	ghClient, err := gclient.NewClientFromEnv()
+	if err != nil {
+		log.Fatalf("failed to create GitHub client: %v", err)
+	}
 	fetcher := worker.NewRetryableRemoteSessionFetcher()
 	workerManager.Register(worker.NewAutoContinueWorker(dbConn, settingsService, sessionService, fetcher, os.Getenv("JULES_API_KEY")))
-	workerManager.Register(worker.NewPRMonitorWorker(dbConn, settingsService, sessionService, ghClient, fetcher, os.Getenv("JULES_API_KEY")))
+	prMonitor := worker.NewPRMonitorWorker(dbConn, settingsService, sessionService, ghClient, fetcher, os.Getenv("JULES_API_KEY"))
+	prMonitor.SetHosts(ghClient)
+	if os.Getenv("GITHUB_WEBHOOK_SECRET") != "" {
+		prMonitor.EnableWebhooks()
+	}
+	workerManager.Register(prMonitor)
 	workerManager.Register(worker.NewAutoRetryWorker(dbConn, settingsService, sessionService))
 	workerManager.Register(worker.NewCronWorker(dbConn, cronService, jobService))
+	workerManager.Register(worker.NewPipelineWorker(dbConn, pipelineService))
+	workerManager.Register(worker.NewEventTriggerWorker(dbConn, eventTriggerService, ghClient))
+	workerManager.Register(worker.NewIssueAutomationWorker(dbConn, settingsService, promptService, jobService, ghClient))
 	workerManager.Register(worker.NewSessionCacheWorker(dbConn, settingsService, sessionService))
 	workerManager.Start()
 	defer workerManager.Stop()
 
+	// GitHub webhooks drive PR evaluations; polling becomes a slow fallback
+	if secret := os.Getenv("GITHUB_WEBHOOK_SECRET"); secret != "" {
+		webhookPort := os.Getenv("WEBHOOK_PORT")
+		if webhookPort == "" {
+			webhookPort = "8081"
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/webhooks/github", webhook.NewGitHubHandler(secret, prMonitor))
+		webhookServer := &http.Server{Addr: ":" + webhookPort, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			log.Printf("webhook receiver listening at :%s", webhookPort)
+			if err := webhookServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("failed to serve webhooks: %v", err)
+			}
+		}()
+		defer webhookServer.Close()
+	}
+
 	// Create gRPC Server
 	opts := []grpc.ServerOption{}
 	token := os.Getenv("JULES_INTERNAL_TOKEN")
@@ -134,6 +186,11 @@ func main() {
 	pb.RegisterJobServiceServer(grpcServer, jobService)
 	pb.RegisterPromptServiceServer(grpcServer, promptService)
 	pb.RegisterSessionServiceServer(grpcServer, sessionService)
+	pb.RegisterStateServiceServer(grpcServer, stateService)
+	pb.RegisterPipelineServiceServer(grpcServer, pipelineService)
+	pb.RegisterQueueServiceServer(grpcServer, queueService)
+	pb.RegisterEventTriggerServiceServer(grpcServer, eventTriggerService)
+	pb.RegisterPullRequestServiceServer(grpcServer, &service.PullRequestServer{DB: dbConn})
 	pb.RegisterChatServiceServer(grpcServer, &service.ChatServer{
 		DB:      dbConn,
 		Limiter: ratelimit.New(100 * time.Millisecond),
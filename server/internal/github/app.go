@@ -0,0 +1,276 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+)
+
+const (
+	// appJWTLifetime stays below GitHub's 10 minute limit.
+	appJWTLifetime = 9 * time.Minute
+	// tokenRefreshMargin renews installation tokens (valid for an hour) before they expire.
+	tokenRefreshMargin = 5 * time.Minute
+	// missingInstallationTTL is how long a repository the app is not installed on is
+	// remembered, so that its calls don't look the installation up each time.
+	missingInstallationTTL = 10 * time.Minute
+)
+
+var (
+	repoPathRegex  = regexp.MustCompile(`^/(?:api/v3/)?repos/([^/]+)/([^/]+)`)
+	repoQueryRegex = regexp.MustCompile(`\brepo:([^/\s]+)/([^\s]+)`)
+)
+
+// requestRepoKey carries the repository of API requests whose path and query don't name one,
+// such as GraphQL queries.
+type requestRepoKey struct{}
+
+type repoRef struct {
+	owner, repo string
+}
+
+// withRequestRepo makes the API requests made with the context act on the repository.
+func withRequestRepo(ctx context.Context, owner, repo string) context.Context {
+	return context.WithValue(ctx, requestRepoKey{}, repoRef{owner: owner, repo: repo})
+}
+
+type installationToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// App authenticates as a GitHub App. It finds the installation of each repository
+// and mints installation tokens, refreshing them before they expire.
+type App struct {
+	appID int64
+	key   *rsa.PrivateKey
+	api   *github.Client
+	now   func() time.Time
+
+	mu            sync.Mutex
+	installations map[string]int64 // "owner/repo" -> installation ID
+	missing       map[string]missingInstallation
+	tokens        map[int64]installationToken
+}
+
+// missingInstallation is a failed installation lookup of a repository.
+type missingInstallation struct {
+	err     error
+	expires time.Time
+}
+
+// NewApp creates an App from its ID and PEM encoded private key.
+func NewApp(appID int64, privateKeyPEM []byte) (*App, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("invalid GitHub App private key: no PEM data")
+	}
+	var key *rsa.PrivateKey
+	if k, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		key = k
+	} else if k, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		rsaKey, ok := k.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("invalid GitHub App private key: not an RSA key")
+		}
+		key = rsaKey
+	} else {
+		return nil, fmt.Errorf("invalid GitHub App private key: %w", err)
+	}
+
+	a := &App{
+		appID:         appID,
+		key:           key,
+		now:           time.Now,
+		installations: make(map[string]int64),
+		missing:       make(map[string]missingInstallation),
+		tokens:        make(map[int64]installationToken),
+	}
+	a.setTransport(http.DefaultTransport)
+	return a, nil
+}
+
+func (a *App) SetBaseURL(u *url.URL) {
+	a.api.BaseURL = u
+}
+
+// setTransport sends the app's own API calls through base, keeping the base URL.
+func (a *App) setTransport(base http.RoundTripper) {
+	api := github.NewClient(&http.Client{Transport: &appJWTTransport{app: a, base: base}})
+	if a.api != nil {
+		api.BaseURL = a.api.BaseURL
+	}
+	a.api = api
+}
+
+// jwt signs the RS256 token that authenticates as the app itself.
+func (a *App) jwt() (string, error) {
+	now := a.now()
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]interface{}{
+		// Backdated to allow for clock drift
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": a.appID,
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(nil, a.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign GitHub App JWT: %w", err)
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// RepoToken returns an installation token that can act on the repository. Failed lookups
+// of the repository's installation are cached for missingInstallationTTL, and an installation
+// that no longer exists is looked up again.
+func (a *App) RepoToken(ctx context.Context, owner, repo string) (string, error) {
+	key := strings.ToLower(owner + "/" + repo)
+	a.mu.Lock()
+	id, ok := a.installations[key]
+	miss, missing := a.missing[key]
+	a.mu.Unlock()
+	if !ok && missing && a.now().Before(miss.expires) {
+		return "", miss.err
+	}
+
+	if !ok {
+		inst, _, err := a.api.Apps.FindRepositoryInstallation(ctx, owner, repo)
+		if err != nil {
+			err = fmt.Errorf("failed to find GitHub App installation for %s/%s: %w", owner, repo, err)
+			a.mu.Lock()
+			a.missing[key] = missingInstallation{err: err, expires: a.now().Add(missingInstallationTTL)}
+			a.mu.Unlock()
+			return "", err
+		}
+		id = inst.GetID()
+		a.mu.Lock()
+		a.installations[key] = id
+		delete(a.missing, key)
+		a.mu.Unlock()
+	}
+
+	token, err := a.InstallationToken(ctx, id)
+	if isNotFound(err) {
+		// The app was uninstalled, or reinstalled with a new ID
+		a.mu.Lock()
+		if a.installations[key] == id {
+			delete(a.installations, key)
+		}
+		a.mu.Unlock()
+	}
+	return token, err
+}
+
+// InstallationToken returns a cached token of the installation, minting a new one when it is about to expire.
+func (a *App) InstallationToken(ctx context.Context, installationID int64) (string, error) {
+	a.mu.Lock()
+	t, ok := a.tokens[installationID]
+	a.mu.Unlock()
+	if ok && a.now().Add(tokenRefreshMargin).Before(t.expiresAt) {
+		return t.token, nil
+	}
+
+	tok, _, err := a.api.Apps.CreateInstallationToken(ctx, installationID, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create token for GitHub App installation %d: %w", installationID, err)
+	}
+	t = installationToken{token: tok.GetToken(), expiresAt: tok.GetExpiresAt().Time}
+	a.mu.Lock()
+	a.tokens[installationID] = t
+	a.mu.Unlock()
+	return t.token, nil
+}
+
+// appJWTTransport authenticates requests to the /app endpoints as the app.
+type appJWTTransport struct {
+	app  *App
+	base http.RoundTripper
+}
+
+func (t *appJWTTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	jwt, err := t.app.jwt()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	return t.base.RoundTrip(req)
+}
+
+// installationTransport authenticates each API request with the installation token of
+// the repository it targets. Requests without a repository use the fallback token, if any.
+type installationTransport struct {
+	app      *App
+	fallback string
+	base     http.RoundTripper
+}
+
+func (t *installationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := t.fallback
+	if owner, repo, ok := requestRepo(req); ok {
+		tok, err := t.app.RepoToken(req.Context(), owner, repo)
+		if err != nil && t.fallback == "" {
+			return nil, err
+		}
+		if err == nil {
+			token = tok
+		}
+	}
+
+	req = req.Clone(req.Context())
+	if token != "" {
+		req.Header.Set("Authorization", "token "+token)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// requestRepo finds the repository an API request targets, from its path, a search query
+// or its context.
+func requestRepo(req *http.Request) (string, string, bool) {
+	if m := repoPathRegex.FindStringSubmatch(req.URL.Path); m != nil {
+		return m[1], m[2], true
+	}
+	if m := repoQueryRegex.FindStringSubmatch(req.URL.Query().Get("q")); m != nil {
+		return m[1], m[2], true
+	}
+	if r, ok := req.Context().Value(requestRepoKey{}).(repoRef); ok {
+		return r.owner, r.repo, true
+	}
+	return "", "", false
+}
+
+// NewAppClient creates a client that authenticates as the GitHub App installation of
+// each repository it calls. Calls that don't target a repository, or target one the app
+// is not installed on, use fallbackToken (a personal access token) when it is set.
+func NewAppClient(app *App, fallbackToken string) *Client {
+	return newAppClient(app, fallbackToken, newCachingTransport(http.DefaultTransport))
+}
+
+func newAppClient(app *App, fallbackToken string, base http.RoundTripper) *Client {
+	app.setTransport(base)
+	transport := &installationTransport{app: app, fallback: fallbackToken, base: base}
+	return &Client{
+		client: github.NewClient(&http.Client{Transport: transport}),
+		app:    app,
+		host:   DefaultHost,
+	}
+}
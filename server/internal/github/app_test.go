@@ -0,0 +1,249 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestApp(t *testing.T) (*App, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	app, err := NewApp(42, pemKey)
+	assert.NoError(t, err)
+	return app, key
+}
+
+// verifyAppJWT checks the request is signed by the app and returns its claims.
+func verifyAppJWT(t *testing.T, r *http.Request, key *rsa.PrivateKey) map[string]interface{} {
+	jwt := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	parts := strings.Split(jwt, ".")
+	if !assert.Len(t, parts, 3) {
+		return nil
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], sig))
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.NoError(t, err)
+	var claims map[string]interface{}
+	assert.NoError(t, json.Unmarshal(payload, &claims))
+	return claims
+}
+
+func TestNewApp_InvalidKey(t *testing.T) {
+	_, err := NewApp(1, []byte("not a key"))
+	assert.Error(t, err)
+}
+
+func TestAppClient_UsesInstallationTokenPerRepo(t *testing.T) {
+	app, key := newTestApp(t)
+	var minted int32
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/o/r/installation":
+			claims := verifyAppJWT(t, r, key)
+			assert.Equal(t, float64(42), claims["iss"])
+			fmt.Fprint(w, `{"id":7}`)
+		case "/repos/other/r/installation":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not Found"}`)
+		case "/app/installations/7/access_tokens":
+			verifyAppJWT(t, r, key)
+			n := atomic.AddInt32(&minted, 1)
+			fmt.Fprintf(w, `{"token":"inst-token-%d","expires_at":%q}`, n, time.Now().Add(time.Hour).Format(time.RFC3339))
+		case "/repos/o/r/pulls/1":
+			assert.Equal(t, "token inst-token-1", r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{"number":1}`)
+		case "/repos/other/r/pulls/1":
+			assert.Equal(t, "token pat", r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{"number":1}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	c := NewAppClient(app, "pat")
+	assert.NoError(t, c.SetBaseURL(server.URL+"/"))
+	ctx := context.Background()
+
+	// The token is minted once and reused while it is valid
+	for i := 0; i < 2; i++ {
+		_, _, err := c.GetPullRequest(ctx, "o", "r", 1)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&minted))
+
+	// Repositories the app is not installed on fall back to the PAT
+	_, _, err := c.GetPullRequest(ctx, "other", "r", 1)
+	assert.NoError(t, err)
+}
+
+func TestAppClient_GraphQLUsesInstallationToken(t *testing.T) {
+	app, _ := newTestApp(t)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/o/r/installation":
+			fmt.Fprint(w, `{"id":7}`)
+		case "/app/installations/7/access_tokens":
+			fmt.Fprintf(w, `{"token":"inst-token","expires_at":%q}`, time.Now().Add(time.Hour).Format(time.RFC3339))
+		case "/graphql":
+			assert.Equal(t, "token inst-token", r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{"data":{"repository":{"pullRequests":{"pageInfo":{"hasNextPage":false},"nodes":[]}}}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// GraphQL requests name no repository; the snapshot query authenticates as its installation
+	c := NewAppClient(app, "pat")
+	assert.NoError(t, c.SetBaseURL(server.URL+"/"))
+	_, err := c.ListOpenPullRequestSnapshots(context.Background(), "o", "r")
+	assert.NoError(t, err)
+}
+
+func TestAppClient_NoFallback(t *testing.T) {
+	app, _ := newTestApp(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	}))
+	defer server.Close()
+
+	c := NewAppClient(app, "")
+	assert.NoError(t, c.SetBaseURL(server.URL+"/"))
+	_, _, err := c.GetPullRequest(context.Background(), "o", "r", 1)
+	assert.ErrorContains(t, err, "failed to find GitHub App installation for o/r")
+}
+
+func TestApp_CachesMissingInstallations(t *testing.T) {
+	app, _ := newTestApp(t)
+	var lookups int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&lookups, 1)
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	}))
+	defer server.Close()
+	c := NewAppClient(app, "")
+	assert.NoError(t, c.SetBaseURL(server.URL+"/"))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := app.RepoToken(ctx, "o", "r")
+		assert.ErrorContains(t, err, "failed to find GitHub App installation for o/r")
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&lookups))
+
+	// The app may have been installed since
+	now := time.Now()
+	app.now = func() time.Time { return now.Add(missingInstallationTTL + time.Second) }
+	_, err := app.RepoToken(ctx, "o", "r")
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&lookups))
+}
+
+func TestApp_ForgetsRemovedInstallation(t *testing.T) {
+	app, _ := newTestApp(t)
+	var installation int32 = 7
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := atomic.LoadInt32(&installation)
+		switch r.URL.Path {
+		case "/repos/o/r/installation":
+			fmt.Fprintf(w, `{"id":%d}`, id)
+		case fmt.Sprintf("/app/installations/%d/access_tokens", id):
+			fmt.Fprintf(w, `{"token":"token-%d","expires_at":%q}`, id, time.Now().Add(time.Hour).Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not Found"}`)
+		}
+	}))
+	defer server.Close()
+	c := NewAppClient(app, "")
+	assert.NoError(t, c.SetBaseURL(server.URL+"/"))
+	ctx := context.Background()
+
+	token, err := app.RepoToken(ctx, "o", "r")
+	assert.NoError(t, err)
+	assert.Equal(t, "token-7", token)
+
+	// The app is reinstalled: once the old token expires, minting a new one fails, and the
+	// installation is looked up again on the next call
+	atomic.StoreInt32(&installation, 8)
+	now := time.Now()
+	app.now = func() time.Time { return now.Add(2 * time.Hour) }
+	_, err = app.RepoToken(ctx, "o", "r")
+	assert.ErrorContains(t, err, "failed to create token for GitHub App installation 7")
+	token, err = app.RepoToken(ctx, "o", "r")
+	assert.NoError(t, err)
+	assert.Equal(t, "token-8", token)
+}
+
+func TestApp_RefreshesExpiringToken(t *testing.T) {
+	app, _ := newTestApp(t)
+	var minted int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&minted, 1)
+		// Expires within the refresh margin, so every call mints a new token
+		fmt.Fprintf(w, `{"token":"t%d","expires_at":%q}`, n, time.Now().Add(2*time.Minute).Format(time.RFC3339))
+	}))
+	defer server.Close()
+	c := NewAppClient(app, "")
+	assert.NoError(t, c.SetBaseURL(server.URL+"/"))
+
+	first, err := app.InstallationToken(context.Background(), 7)
+	assert.NoError(t, err)
+	second, err := app.InstallationToken(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", first)
+	assert.Equal(t, "t2", second)
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "pat")
+	t.Setenv("GITHUB_APP_ID", "")
+	c, err := NewClientFromEnv()
+	assert.NoError(t, err)
+	assert.Nil(t, c.app)
+
+	t.Setenv("GITHUB_APP_ID", "abc")
+	_, err = NewClientFromEnv()
+	assert.ErrorContains(t, err, "invalid GITHUB_APP_ID")
+
+	t.Setenv("GITHUB_APP_ID", "42")
+	t.Setenv("GITHUB_APP_PRIVATE_KEY", "")
+	t.Setenv("GITHUB_APP_PRIVATE_KEY_PATH", "")
+	_, err = NewClientFromEnv()
+	assert.Error(t, err)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	t.Setenv("GITHUB_APP_PRIVATE_KEY", string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})))
+	c, err = NewClientFromEnv()
+	assert.NoError(t, err)
+	assert.NotNil(t, c.app)
+}
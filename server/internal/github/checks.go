@@ -0,0 +1,80 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// ListCheckRunAnnotations returns the annotations of a check run, e.g. compiler errors or failed tests.
+func (c *Client) ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64) ([]*github.CheckRunAnnotation, error) {
+	annotations, _, err := c.api(ctx).Checks.ListCheckRunAnnotations(ctx, owner, repo, checkRunID, &github.ListOptions{PerPage: 50})
+	return annotations, err
+}
+
+// GetJobLogTail returns the last maxBytes of the log of a GitHub Actions job. The check runs of
+// Actions have the ID of their job; other check runs have no log. Only the tail is downloaded,
+// unless the log server ignores the range.
+func (c *Client) GetJobLogTail(ctx context.Context, owner, repo string, jobID int64, maxBytes int) (string, error) {
+	u, _, err := c.api(ctx).Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, 1)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=-%d", maxBytes))
+	download := c.ForHost(HostFromContext(ctx)).download
+	if download == nil {
+		download = http.DefaultClient
+	}
+	resp, err := download.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download log of job %d: %w", jobID, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return readTail(resp.Body, maxBytes)
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The log is empty
+		return "", nil
+	}
+	return "", fmt.Errorf("failed to download log of job %d: %s", jobID, resp.Status)
+}
+
+// RerunCheckRun reruns a failed check run. GitHub Actions jobs are rerun directly; the check
+// runs of other apps are re-requested, which asks the app to run the check again.
+func (c *Client) RerunCheckRun(ctx context.Context, owner, repo string, checkRunID int64) error {
+	_, err := c.api(ctx).Actions.RerunJobByID(ctx, owner, repo, checkRunID)
+	if err == nil {
+		return nil
+	}
+	if _, rerr := c.api(ctx).Checks.ReRequestCheckRun(ctx, owner, repo, checkRunID); rerr != nil {
+		return fmt.Errorf("failed to rerun check run %d: %v; %w", checkRunID, err, rerr)
+	}
+	return nil
+}
+
+// readTail reads r to the end, keeping only its last maxBytes.
+func readTail(r io.Reader, maxBytes int) (string, error) {
+	buf := make([]byte, 0, 2*maxBytes)
+	chunk := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(chunk)
+		buf = append(buf, chunk[:n]...)
+		if len(buf) > maxBytes {
+			buf = append(buf[:0], buf[len(buf)-maxBytes:]...)
+		}
+		if err == io.EOF {
+			return string(buf), nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
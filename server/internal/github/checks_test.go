@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCheckRunAnnotations(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/o/r/check-runs/7/annotations", r.URL.Path)
+		fmt.Fprint(w, `[{"path":"a.go","start_line":3,"annotation_level":"failure","message":"undefined: x"}]`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	annotations, err := c.ListCheckRunAnnotations(context.Background(), "o", "r", 7)
+	assert.NoError(t, err)
+	if assert.Len(t, annotations, 1) {
+		assert.Equal(t, "a.go", annotations[0].GetPath())
+		assert.Equal(t, 3, annotations[0].GetStartLine())
+		assert.Equal(t, "undefined: x", annotations[0].GetMessage())
+	}
+}
+
+func TestGetJobLogTail(t *testing.T) {
+	log := strings.Repeat("noise\n", 1000) + "--- FAIL: TestX\n"
+	var serverURL string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/o/r/actions/jobs/7/logs":
+			w.Header().Set("Location", serverURL+"/blob/7.txt")
+			w.WriteHeader(http.StatusFound)
+		case "/blob/7.txt":
+			// Pre-signed URLs must not get the token
+			assert.Empty(t, r.Header.Get("Authorization"))
+			assert.Equal(t, "bytes=-30", r.Header.Get("Range"))
+			http.ServeContent(w, r, "7.txt", time.Time{}, strings.NewReader(log))
+		case "/repos/o/r/actions/jobs/9/logs":
+			w.Header().Set("Location", serverURL+"/blob/9.txt")
+			w.WriteHeader(http.StatusFound)
+		case "/blob/9.txt":
+			// Servers that ignore the range send the whole log
+			fmt.Fprint(w, log)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+	serverURL = server.URL
+
+	tail, err := c.GetJobLogTail(context.Background(), "o", "r", 7, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, log[len(log)-30:], tail)
+	tail, err = c.GetJobLogTail(context.Background(), "o", "r", 9, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, log[len(log)-30:], tail)
+
+	// Check runs of other apps have no log
+	_, err = c.GetJobLogTail(context.Background(), "o", "r", 8, 30)
+	assert.Error(t, err)
+}
+
+func TestRerunCheckRun(t *testing.T) {
+	var calls []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/repos/o/r/actions/jobs/7/rerun", "/repos/o/r/check-runs/8/rerequest":
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	assert.NoError(t, c.RerunCheckRun(context.Background(), "o", "r", 7))
+	assert.Equal(t, []string{"POST /repos/o/r/actions/jobs/7/rerun"}, calls)
+
+	// Check runs of other apps are re-requested
+	calls = nil
+	assert.NoError(t, c.RerunCheckRun(context.Background(), "o", "r", 8))
+	assert.Equal(t, []string{"POST /repos/o/r/actions/jobs/8/rerun", "POST /repos/o/r/check-runs/8/rerequest"}, calls)
+
+	assert.Error(t, c.RerunCheckRun(context.Background(), "o", "r", 9))
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 
 	"github.com/google/go-github/v69/github"
@@ -9,17 +10,29 @@ import (
 )
 
 type Client struct {
-	client *github.Client
+	client   *github.Client
+	app      *App
+	host     string
+	botLogin string
+	repos    []string
+	// Clients of the other GitHub hosts, see ForHost
+	hosts map[string]*Client
+	// download fetches the pre-signed URLs GitHub redirects to, e.g. of logs, without credentials
+	download *http.Client
 }
 
 func NewClient(token string) *Client {
-	ctx := context.Background()
+	return newTokenClient(token, newCachingTransport(http.DefaultTransport))
+}
+
+func newTokenClient(token string, base http.RoundTripper) *Client {
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
-	tc := oauth2.NewClient(ctx, ts)
+	tc := &http.Client{Transport: &oauth2.Transport{Source: ts, Base: base}}
 	return &Client{
 		client: github.NewClient(tc),
+		host:   DefaultHost,
 	}
 }
 
@@ -30,6 +43,9 @@ func (c *Client) SetBaseURL(urlStr string) error {
 	}
 	c.client.BaseURL = u
 	c.client.UploadURL = u
+	if c.app != nil {
+		c.app.SetBaseURL(u)
+	}
 	return nil
 }
 
@@ -40,7 +56,7 @@ func (c *Client) ListBranches(ctx context.Context, owner, repo string) ([]*githu
 
 	var allBranches []*github.Branch
 	for {
-		branches, resp, err := c.client.Repositories.ListBranches(ctx, owner, repo, opts)
+		branches, resp, err := c.api(ctx).Repositories.ListBranches(ctx, owner, repo, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -54,68 +70,81 @@ func (c *Client) ListBranches(ctx context.Context, owner, repo string) ([]*githu
 }
 
 func (c *Client) DeleteBranch(ctx context.Context, owner, repo, branch string) error {
-	_, err := c.client.Git.DeleteRef(ctx, owner, repo, "heads/"+branch)
+	_, err := c.api(ctx).Git.DeleteRef(ctx, owner, repo, "heads/"+branch)
 	return err
 }
 
 func (c *Client) GetBranch(ctx context.Context, owner, repo, branch string) (*github.Branch, error) {
-	b, _, err := c.client.Repositories.GetBranch(ctx, owner, repo, branch, 0)
+	b, _, err := c.api(ctx).Repositories.GetBranch(ctx, owner, repo, branch, 0)
 	return b, err
 }
 
 func (c *Client) ListPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
-	return c.client.PullRequests.List(ctx, owner, repo, opts)
+	return c.api(ctx).PullRequests.List(ctx, owner, repo, opts)
 }
 
 func (c *Client) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error) {
-	return c.client.PullRequests.Get(ctx, owner, repo, number)
+	return c.api(ctx).PullRequests.Get(ctx, owner, repo, number)
 }
 
 func (c *Client) ListCheckRunsForRef(ctx context.Context, owner, repo, ref string, opts *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error) {
-	return c.client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
+	return c.api(ctx).Checks.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
 }
 
 func (c *Client) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*github.CombinedStatus, error) {
-	s, _, err := c.client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, nil)
+	s, _, err := c.api(ctx).Repositories.GetCombinedStatus(ctx, owner, repo, ref, nil)
 	return s, err
 }
 
 func (c *Client) CreateComment(ctx context.Context, owner, repo string, number int, body string) error {
 	comment := &github.IssueComment{Body: &body}
-	_, _, err := c.client.Issues.CreateComment(ctx, owner, repo, number, comment)
+	_, _, err := c.api(ctx).Issues.CreateComment(ctx, owner, repo, number, comment)
 	return err
 }
 
+// ListComments returns all comments of an issue or pull request, oldest first.
 func (c *Client) ListComments(ctx context.Context, owner, repo string, number int) ([]*github.IssueComment, error) {
-	comments, _, err := c.client.Issues.ListComments(ctx, owner, repo, number, nil)
-	return comments, err
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	var allComments []*github.IssueComment
+	for {
+		comments, resp, err := c.api(ctx).Issues.ListComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		allComments = append(allComments, comments...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return allComments, nil
 }
 
 func (c *Client) GetUser(ctx context.Context, username string) (*github.User, error) {
 	// If username is empty, get authenticated user
-	user, _, err := c.client.Users.Get(ctx, username)
+	user, _, err := c.api(ctx).Users.Get(ctx, username)
 	return user, err
 }
 
 func (c *Client) ClosePullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
 	// Edit PR state to "closed"
 	pr := &github.PullRequest{State: github.String("closed")}
-	ret, _, err := c.client.PullRequests.Edit(ctx, owner, repo, number, pr)
+	ret, _, err := c.api(ctx).PullRequests.Edit(ctx, owner, repo, number, pr)
 	return ret, err
 }
 func (c *Client) UpdateBranch(ctx context.Context, owner, repo string, number int) error {
-	_, _, err := c.client.PullRequests.UpdateBranch(ctx, owner, repo, number, nil)
+	_, _, err := c.api(ctx).PullRequests.UpdateBranch(ctx, owner, repo, number, nil)
 	return err
 }
 
 func (c *Client) MarkPullRequestReadyForReview(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
 	pr := &github.PullRequest{Draft: github.Bool(false)}
-	ret, _, err := c.client.PullRequests.Edit(ctx, owner, repo, number, pr)
+	ret, _, err := c.api(ctx).PullRequests.Edit(ctx, owner, repo, number, pr)
 	return ret, err
 }
 
 func (c *Client) ListFiles(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.CommitFile, error) {
-	files, _, err := c.client.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+	files, _, err := c.api(ctx).PullRequests.ListFiles(ctx, owner, repo, number, opts)
 	return files, err
 }
 
@@ -123,10 +152,66 @@ func (c *Client) MergePullRequest(ctx context.Context, owner, repo string, numbe
 	options := &github.PullRequestOptions{
 		MergeMethod: method,
 	}
-	_, _, err := c.client.PullRequests.Merge(ctx, owner, repo, number, message, options)
+	_, _, err := c.api(ctx).PullRequests.Merge(ctx, owner, repo, number, message, options)
 	return err
 }
 
 func (c *Client) SearchIssues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
-	return c.client.Search.Issues(ctx, query, opts)
+	return c.api(ctx).Search.Issues(ctx, query, opts)
+}
+
+func (c *Client) ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return c.api(ctx).Issues.ListByRepo(ctx, owner, repo, opts)
+}
+
+func (c *Client) ListIssueEvents(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error) {
+	return c.api(ctx).Issues.ListIssueEvents(ctx, owner, repo, number, opts)
+}
+
+func (c *Client) ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+	return c.api(ctx).Repositories.ListReleases(ctx, owner, repo, opts)
+}
+
+func (c *Client) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	issue, _, err := c.api(ctx).Issues.Get(ctx, owner, repo, number)
+	return issue, err
+}
+
+func (c *Client) AddLabelsToIssue(ctx context.Context, owner, repo string, number int, labels []string) error {
+	_, _, err := c.api(ctx).Issues.AddLabelsToIssue(ctx, owner, repo, number, labels)
+	return err
+}
+
+func (c *Client) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers []string) error {
+	_, _, err := c.api(ctx).PullRequests.RequestReviewers(ctx, owner, repo, number, github.ReviewersRequest{Reviewers: reviewers})
+	return err
+}
+
+// ListReviews returns all reviews of a pull request, oldest first.
+func (c *Client) ListReviews(ctx context.Context, owner, repo string, number int) ([]*github.PullRequestReview, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	var allReviews []*github.PullRequestReview
+	for {
+		reviews, resp, err := c.api(ctx).PullRequests.ListReviews(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		allReviews = append(allReviews, reviews...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return allReviews, nil
+}
+
+func (c *Client) UpdatePullRequestBody(ctx context.Context, owner, repo string, number int, body string) (*github.PullRequest, error) {
+	pr := &github.PullRequest{Body: &body}
+	ret, _, err := c.api(ctx).PullRequests.Edit(ctx, owner, repo, number, pr)
+	return ret, err
+}
+
+func (c *Client) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, error) {
+	r, _, err := c.api(ctx).Repositories.Get(ctx, owner, repo)
+	return r, err
 }
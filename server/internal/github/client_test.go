@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/google/go-github/v69/github"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -205,17 +207,31 @@ func TestGetCombinedStatus(t *testing.T) {
 }
 
 func TestListComments(t *testing.T) {
+	// Busy PRs have more comments than fit on a page
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "GET", r.Method)
 		assert.Equal(t, "/repos/o/r/issues/123/comments", r.URL.Path)
-		fmt.Fprint(w, `[{"id":1, "body":"comment"}]`)
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+		first, last := 1, 100
+		if r.URL.Query().Get("page") == "2" {
+			first, last = 101, 130
+		} else {
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/issues/123/comments?page=2>; rel="next"`)
+		}
+		var comments []string
+		for id := first; id <= last; id++ {
+			comments = append(comments, fmt.Sprintf(`{"id":%d,"body":"comment %d"}`, id, id))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(comments, ","))
 	})
 	c, server := newTestClient(t, handler)
 	defer server.Close()
 
 	comments, err := c.ListComments(context.Background(), "o", "r", 123)
 	assert.NoError(t, err)
-	assert.Len(t, comments, 1)
+	if assert.Len(t, comments, 130) {
+		assert.Equal(t, "comment 130", comments[129].GetBody())
+	}
 }
 
 func TestSearchIssues(t *testing.T) {
@@ -232,3 +248,110 @@ func TestSearchIssues(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 1, *res.Total)
 }
+
+func TestListIssues(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/repos/o/r/issues", r.URL.Path)
+		assert.Equal(t, "jules", r.URL.Query().Get("labels"))
+		fmt.Fprint(w, `[{"number":7, "title":"Bug"}]`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	issues, _, err := c.ListIssues(context.Background(), "o", "r", &github.IssueListByRepoOptions{Labels: []string{"jules"}})
+	assert.NoError(t, err)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, "Bug", issues[0].GetTitle())
+}
+
+func TestListReleases(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/repos/o/r/releases", r.URL.Path)
+		fmt.Fprint(w, `[{"tag_name":"v1.0.0"}]`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	releases, _, err := c.ListReleases(context.Background(), "o", "r", nil)
+	assert.NoError(t, err)
+	assert.Len(t, releases, 1)
+	assert.Equal(t, "v1.0.0", releases[0].GetTagName())
+}
+
+func TestGetIssue(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/repos/o/r/issues/7", r.URL.Path)
+		fmt.Fprint(w, `{"number":7, "state":"open", "title":"Bug"}`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	issue, err := c.GetIssue(context.Background(), "o", "r", 7)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bug", issue.GetTitle())
+}
+
+func TestAddLabelsToIssue(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/repos/o/r/issues/7/labels", r.URL.Path)
+		fmt.Fprint(w, `[{"name":"jules:in-progress"}]`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	err := c.AddLabelsToIssue(context.Background(), "o", "r", 7, []string{"jules:in-progress"})
+	assert.NoError(t, err)
+}
+
+func TestListReviews(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/o/r/pulls/7/reviews", r.URL.Path)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"id":2,"state":"CHANGES_REQUESTED","user":{"login":"bob"}}]`)
+			return
+		}
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/7/reviews?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"id":1,"state":"APPROVED","user":{"login":"alice"}}]`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	reviews, err := c.ListReviews(context.Background(), "o", "r", 7)
+	assert.NoError(t, err)
+	if assert.Len(t, reviews, 2) {
+		assert.Equal(t, "APPROVED", reviews[0].GetState())
+		assert.Equal(t, "bob", reviews[1].GetUser().GetLogin())
+	}
+}
+
+func TestUpdatePullRequestBody(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/repos/o/r/pulls/123", r.URL.Path)
+		fmt.Fprint(w, `{"number":123, "body":"Closes #7"}`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	pr, err := c.UpdatePullRequestBody(context.Background(), "o", "r", 123, "Closes #7")
+	assert.NoError(t, err)
+	assert.Equal(t, "Closes #7", pr.GetBody())
+}
+
+func TestGetRepository(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/repos/o/r", r.URL.Path)
+		fmt.Fprint(w, `{"name":"r", "default_branch":"develop"}`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	repo, err := c.GetRepository(context.Background(), "o", "r")
+	assert.NoError(t, err)
+	assert.Equal(t, "develop", repo.GetDefaultBranch())
+}
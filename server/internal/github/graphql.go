@@ -0,0 +1,314 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// openPRSnapshotsQuery fetches a page of 20 open PRs, each with up to 100 files, 100 checks,
+// 100 labels and its 50 most recent comments.
+const openPRSnapshotsQuery = `query($owner: String!, $name: String!, $cursor: String) {
+  repository(owner: $owner, name: $name) {
+    pullRequests(states: OPEN, first: 20, after: $cursor, orderBy: {field: UPDATED_AT, direction: DESC}) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        number url title body state isDraft merged mergeable mergeStateStatus
+        createdAt updatedAt changedFiles additions deletions authorAssociation
+        author { login }
+        headRefName headRefOid baseRefName
+        files(first: 100) { totalCount nodes { path changeType } }
+        labels(first: 100) { nodes { name } }
+        comments(last: 50) { nodes { databaseId body createdAt authorAssociation author { login } } }
+        commits(last: 1) { nodes { commit { statusCheckRollup { contexts(first: 100) {
+          totalCount
+          nodes {
+            __typename
+            ... on CheckRun { databaseId name status conclusion detailsUrl }
+            ... on StatusContext { context state targetUrl description }
+          }
+        } } } } }
+      }
+    }
+  }
+}`
+
+// PullRequestSnapshot is an open PR with everything the PR monitor reads about it, fetched in
+// one GraphQL query. Files and checks are nil when the PR has more than the query fetches.
+type PullRequestSnapshot struct {
+	PullRequest *github.PullRequest
+	Files       []*github.CommitFile
+	// CombinedStatus covers commit statuses only, as the REST combined status does
+	CombinedStatus *github.CombinedStatus
+	CheckRuns      []*github.CheckRun
+	// Comments are the most recent ones, oldest first
+	Comments []*github.IssueComment
+}
+
+type gqlActor struct {
+	Login string `json:"login"`
+}
+
+type gqlPullRequest struct {
+	Number            int       `json:"number"`
+	URL               string    `json:"url"`
+	Title             string    `json:"title"`
+	Body              string    `json:"body"`
+	State             string    `json:"state"`
+	IsDraft           bool      `json:"isDraft"`
+	Merged            bool      `json:"merged"`
+	Mergeable         string    `json:"mergeable"`
+	MergeStateStatus  string    `json:"mergeStateStatus"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	ChangedFiles      int       `json:"changedFiles"`
+	Additions         int       `json:"additions"`
+	Deletions         int       `json:"deletions"`
+	AuthorAssociation string    `json:"authorAssociation"`
+	Author            *gqlActor `json:"author"`
+	HeadRefName       string    `json:"headRefName"`
+	HeadRefOid        string    `json:"headRefOid"`
+	BaseRefName       string    `json:"baseRefName"`
+	Files             struct {
+		TotalCount int `json:"totalCount"`
+		Nodes      []struct {
+			Path       string `json:"path"`
+			ChangeType string `json:"changeType"`
+		} `json:"nodes"`
+	} `json:"files"`
+	Labels struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	Comments struct {
+		Nodes []struct {
+			DatabaseID        int64     `json:"databaseId"`
+			Body              string    `json:"body"`
+			CreatedAt         time.Time `json:"createdAt"`
+			AuthorAssociation string    `json:"authorAssociation"`
+			Author            *gqlActor `json:"author"`
+		} `json:"nodes"`
+	} `json:"comments"`
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					Contexts struct {
+						TotalCount int `json:"totalCount"`
+						Nodes      []struct {
+							Typename    string `json:"__typename"`
+							DatabaseID  int64  `json:"databaseId"`
+							Name        string `json:"name"`
+							Status      string `json:"status"`
+							Conclusion  string `json:"conclusion"`
+							DetailsURL  string `json:"detailsUrl"`
+							Context     string `json:"context"`
+							State       string `json:"state"`
+							TargetURL   string `json:"targetUrl"`
+							Description string `json:"description"`
+						} `json:"nodes"`
+					} `json:"contexts"`
+				} `json:"statusCheckRollup"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"commits"`
+}
+
+// ListOpenPullRequestSnapshots fetches the open PRs of a repo with their files, checks and
+// recent comments, one GraphQL query per page of PRs.
+func (c *Client) ListOpenPullRequestSnapshots(ctx context.Context, owner, repo string) ([]*PullRequestSnapshot, error) {
+	var snapshots []*PullRequestSnapshot
+	var cursor *string
+	for {
+		var data struct {
+			Repository *struct {
+				PullRequests struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []gqlPullRequest `json:"nodes"`
+				} `json:"pullRequests"`
+			} `json:"repository"`
+		}
+		vars := map[string]interface{}{"owner": owner, "name": repo, "cursor": cursor}
+		if err := c.graphQL(ctx, owner, repo, openPRSnapshotsQuery, vars, &data); err != nil {
+			return nil, err
+		}
+		if data.Repository == nil {
+			return nil, fmt.Errorf("repository %s/%s not found", owner, repo)
+		}
+		for _, pr := range data.Repository.PullRequests.Nodes {
+			snapshots = append(snapshots, pr.snapshot())
+		}
+		page := data.Repository.PullRequests.PageInfo
+		if !page.HasNextPage {
+			return snapshots, nil
+		}
+		cursor = github.String(page.EndCursor)
+	}
+}
+
+// graphQL runs a GraphQL query on the repository and decodes its data into out. The
+// repository lets App clients authenticate the query as the repository's installation.
+func (c *Client) graphQL(ctx context.Context, owner, repo, query string, vars map[string]interface{}, out interface{}) error {
+	ctx = withRequestRepo(ctx, owner, repo)
+	api := c.api(ctx)
+	req, err := api.NewRequest(http.MethodPost, graphQLURL(api.BaseURL), map[string]interface{}{"query": query, "variables": vars})
+	if err != nil {
+		return err
+	}
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if _, err := api.Do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return fmt.Errorf("GraphQL error: %s", resp.Errors[0].Message)
+	}
+	return json.Unmarshal(resp.Data, out)
+}
+
+// graphQLURL returns the GraphQL endpoint of a REST base URL. GHES serves REST under
+// /api/v3/ and GraphQL at /api/graphql.
+func graphQLURL(base *url.URL) string {
+	u := *base
+	if strings.HasSuffix(u.Path, "/api/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "v3/") + "graphql"
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/graphql"
+	}
+	return u.String()
+}
+
+// snapshot converts the GraphQL PR into the REST types the PR monitor works with.
+func (p *gqlPullRequest) snapshot() *PullRequestSnapshot {
+	pr := &github.PullRequest{
+		Number:            github.Int(p.Number),
+		HTMLURL:           github.String(p.URL),
+		Title:             github.String(p.Title),
+		Body:              github.String(p.Body),
+		State:             github.String(strings.ToLower(p.State)),
+		Draft:             github.Bool(p.IsDraft),
+		Merged:            github.Bool(p.Merged),
+		MergeableState:    github.String(strings.ToLower(p.MergeStateStatus)),
+		CreatedAt:         &github.Timestamp{Time: p.CreatedAt},
+		UpdatedAt:         &github.Timestamp{Time: p.UpdatedAt},
+		ChangedFiles:      github.Int(p.ChangedFiles),
+		Additions:         github.Int(p.Additions),
+		Deletions:         github.Int(p.Deletions),
+		AuthorAssociation: github.String(p.AuthorAssociation),
+		Head:              &github.PullRequestBranch{Ref: github.String(p.HeadRefName), SHA: github.String(p.HeadRefOid)},
+		Base:              &github.PullRequestBranch{Ref: github.String(p.BaseRefName)},
+	}
+	if p.Author != nil {
+		pr.User = &github.User{Login: github.String(p.Author.Login)}
+	}
+	for _, l := range p.Labels.Nodes {
+		pr.Labels = append(pr.Labels, &github.Label{Name: github.String(l.Name)})
+	}
+	switch p.Mergeable {
+	case "MERGEABLE":
+		pr.Mergeable = github.Bool(true)
+	case "CONFLICTING":
+		pr.Mergeable = github.Bool(false)
+	}
+	s := &PullRequestSnapshot{PullRequest: pr}
+
+	if p.Files.TotalCount <= len(p.Files.Nodes) {
+		s.Files = []*github.CommitFile{}
+		for _, f := range p.Files.Nodes {
+			s.Files = append(s.Files, &github.CommitFile{Filename: github.String(f.Path), Status: github.String(fileStatus(f.ChangeType))})
+		}
+	}
+
+	for _, c := range p.Comments.Nodes {
+		comment := &github.IssueComment{
+			ID:                github.Int64(c.DatabaseID),
+			Body:              github.String(c.Body),
+			CreatedAt:         &github.Timestamp{Time: c.CreatedAt},
+			AuthorAssociation: github.String(c.AuthorAssociation),
+		}
+		if c.Author != nil {
+			comment.User = &github.User{Login: github.String(c.Author.Login)}
+		}
+		s.Comments = append(s.Comments, comment)
+	}
+
+	statuses := []*github.RepoStatus{}
+	checkRuns := []*github.CheckRun{}
+	complete := true
+	if len(p.Commits.Nodes) > 0 {
+		if rollup := p.Commits.Nodes[0].Commit.StatusCheckRollup; rollup != nil {
+			complete = rollup.Contexts.TotalCount <= len(rollup.Contexts.Nodes)
+			for _, n := range rollup.Contexts.Nodes {
+				switch n.Typename {
+				case "CheckRun":
+					run := &github.CheckRun{
+						ID:         github.Int64(n.DatabaseID),
+						Name:       github.String(n.Name),
+						Status:     github.String(strings.ToLower(n.Status)),
+						DetailsURL: github.String(n.DetailsURL),
+					}
+					if n.Conclusion != "" {
+						run.Conclusion = github.String(strings.ToLower(n.Conclusion))
+					}
+					checkRuns = append(checkRuns, run)
+				case "StatusContext":
+					statuses = append(statuses, &github.RepoStatus{
+						Context:     github.String(n.Context),
+						State:       github.String(strings.ToLower(n.State)),
+						TargetURL:   github.String(n.TargetURL),
+						Description: github.String(n.Description),
+					})
+				}
+			}
+		}
+	}
+	if complete {
+		s.CheckRuns = checkRuns
+		s.CombinedStatus = &github.CombinedStatus{
+			State:      github.String(combinedState(statuses)),
+			SHA:        github.String(p.HeadRefOid),
+			TotalCount: github.Int(len(statuses)),
+			Statuses:   statuses,
+		}
+	}
+	return s
+}
+
+// combinedState computes the state of a combined status the way the REST API does.
+func combinedState(statuses []*github.RepoStatus) string {
+	if len(statuses) == 0 {
+		return "pending"
+	}
+	state := "success"
+	for _, s := range statuses {
+		switch s.GetState() {
+		case "error", "failure":
+			return "failure"
+		case "pending", "expected":
+			state = "pending"
+		}
+	}
+	return state
+}
+
+// fileStatus maps a GraphQL change type to the status of a REST PR file.
+func fileStatus(changeType string) string {
+	if changeType == "DELETED" {
+		return "removed"
+	}
+	return strings.ToLower(changeType)
+}
@@ -0,0 +1,120 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const snapshotPRNode = `{
+  "number": 7, "url": "https://github.com/o/r/pull/7", "title": "Fix", "body": "b", "state": "OPEN",
+  "isDraft": true, "merged": false, "mergeable": "CONFLICTING", "mergeStateStatus": "DIRTY",
+  "createdAt": "2026-01-01T00:00:00Z", "updatedAt": "2026-01-02T00:00:00Z", "changedFiles": 2,
+  "additions": 12, "deletions": 3, "authorAssociation": "NONE", "author": {"login": "google-labs-jules"},
+  "headRefName": "fix", "headRefOid": "abc", "baseRefName": "main",
+  "files": {"totalCount": 2, "nodes": [{"path": "a_test.go", "changeType": "DELETED"}, {"path": "a.go", "changeType": "MODIFIED"}]},
+  "labels": {"nodes": [{"name": "automerge"}]},
+  "comments": {"nodes": [{"databaseId": 11, "body": "/hub merge", "createdAt": "2026-01-02T00:00:00Z", "authorAssociation": "OWNER", "author": {"login": "alice"}}]},
+  "commits": {"nodes": [{"commit": {"statusCheckRollup": {"contexts": {"totalCount": 2, "nodes": [
+    {"__typename": "CheckRun", "databaseId": 5, "name": "test", "status": "COMPLETED", "conclusion": "FAILURE", "detailsUrl": "https://ci/5"},
+    {"__typename": "StatusContext", "context": "ci/legacy", "state": "SUCCESS", "targetUrl": "https://ci/legacy"}
+  ]}}}}]}
+}`
+
+func TestListOpenPullRequestSnapshots(t *testing.T) {
+	var cursors []interface{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/graphql", r.URL.Path)
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "o", body.Variables["owner"])
+		assert.Equal(t, "r", body.Variables["name"])
+		cursors = append(cursors, body.Variables["cursor"])
+
+		if body.Variables["cursor"] == nil {
+			fmt.Fprintf(w, `{"data":{"repository":{"pullRequests":{"pageInfo":{"hasNextPage":true,"endCursor":"c1"},"nodes":[%s]}}}}`, snapshotPRNode)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequests":{"pageInfo":{"hasNextPage":false},"nodes":[
+			{"number": 8, "state": "OPEN", "mergeable": "UNKNOWN", "files": {"totalCount": 300, "nodes": []}, "commits": {"nodes": [{"commit": {"statusCheckRollup": null}}]}}
+		]}}}}`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	snaps, err := c.ListOpenPullRequestSnapshots(context.Background(), "o", "r")
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{nil, "c1"}, cursors)
+	if !assert.Len(t, snaps, 2) {
+		return
+	}
+
+	s := snaps[0]
+	pr := s.PullRequest
+	assert.Equal(t, 7, pr.GetNumber())
+	assert.Equal(t, "open", pr.GetState())
+	assert.True(t, pr.GetDraft())
+	assert.False(t, pr.GetMergeable())
+	assert.Equal(t, "dirty", pr.GetMergeableState())
+	assert.Equal(t, "abc", pr.GetHead().GetSHA())
+	assert.Equal(t, "google-labs-jules", pr.GetUser().GetLogin())
+	assert.Equal(t, 12, pr.GetAdditions())
+	assert.Equal(t, 3, pr.GetDeletions())
+	if assert.Len(t, pr.Labels, 1) {
+		assert.Equal(t, "automerge", pr.Labels[0].GetName())
+	}
+	assert.Equal(t, "removed", s.Files[0].GetStatus())
+	assert.Equal(t, "modified", s.Files[1].GetStatus())
+	assert.Equal(t, int64(11), s.Comments[0].GetID())
+	assert.Equal(t, "OWNER", s.Comments[0].GetAuthorAssociation())
+	assert.Equal(t, "failure", s.CheckRuns[0].GetConclusion())
+	assert.Equal(t, "completed", s.CheckRuns[0].GetStatus())
+	assert.Equal(t, "success", s.CombinedStatus.GetState())
+	assert.Equal(t, 1, s.CombinedStatus.GetTotalCount())
+
+	// Mergeability not computed yet, too many files, no checks at all
+	s = snaps[1]
+	assert.Nil(t, s.PullRequest.Mergeable)
+	assert.Nil(t, s.Files)
+	assert.Empty(t, s.CheckRuns)
+	assert.Equal(t, "pending", s.CombinedStatus.GetState())
+}
+
+func TestListOpenPullRequestSnapshots_Errors(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errors":[{"message":"Something went wrong"}]}`)
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+	_, err := c.ListOpenPullRequestSnapshots(context.Background(), "o", "r")
+	assert.ErrorContains(t, err, "GraphQL error: Something went wrong")
+
+	handler404 := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	c, server404 := newTestClient(t, handler404)
+	defer server404.Close()
+	_, err = c.ListOpenPullRequestSnapshots(context.Background(), "o", "r")
+	assert.Error(t, err)
+}
+
+func TestGraphQLURL(t *testing.T) {
+	for base, want := range map[string]string{
+		"https://api.github.com/":         "https://api.github.com/graphql",
+		"https://ghe.example.com/api/v3/": "https://ghe.example.com/api/graphql",
+		"http://127.0.0.1:8080/":          "http://127.0.0.1:8080/graphql",
+	} {
+		u, err := url.Parse(base)
+		assert.NoError(t, err)
+		assert.Equal(t, want, graphQLURL(u))
+	}
+}
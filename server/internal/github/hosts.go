@@ -0,0 +1,304 @@
+package github
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultHost is the host of repos whose identifier has no host.
+	DefaultHost = "github.com"
+	// DefaultBotLogin is the login of the Jules bot on github.com.
+	DefaultBotLogin = "google-labs-jules"
+)
+
+// HostConfig configures access to a GitHub host, either github.com or a GitHub Enterprise Server.
+type HostConfig struct {
+	// Host is the web host of the instance, e.g. "github.example.com"
+	Host string `yaml:"host"`
+	// APIURL and UploadURL default to https://<host>/api/v3/ and https://<host>/api/uploads/ for GHES
+	APIURL    string `yaml:"api_url"`
+	UploadURL string `yaml:"upload_url"`
+	// CAFile is a PEM bundle trusted in addition to the system roots
+	CAFile string `yaml:"ca_file"`
+
+	Token             string `yaml:"token"`
+	AppID             int64  `yaml:"app_id"`
+	AppPrivateKey     string `yaml:"app_private_key"`
+	AppPrivateKeyPath string `yaml:"app_private_key_path"`
+
+	// BotLogin identifies the PRs and comments of the Jules bot on this host
+	BotLogin string `yaml:"bot_login"`
+	// Repos are monitored for PRs in addition to the repos of jobs, as "owner/repo"
+	Repos []string `yaml:"repos"`
+}
+
+type hostsFile struct {
+	Hosts []HostConfig `yaml:"hosts"`
+}
+
+// NewHostClient creates a client for the host. It uses GitHub App authentication when an
+// app ID is set, with the token as fallback, and the token alone otherwise.
+func NewHostClient(cfg HostConfig) (*Client, error) {
+	host := strings.ToLower(cfg.Host)
+	if host == "" {
+		host = DefaultHost
+	}
+
+	var network http.RoundTripper = http.DefaultTransport
+	if cfg.CAFile != "" {
+		t, err := transportWithCA(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		network = t
+	}
+	base := newCachingTransport(network)
+
+	var c *Client
+	if cfg.AppID != 0 {
+		key := []byte(cfg.AppPrivateKey)
+		if len(key) == 0 {
+			if cfg.AppPrivateKeyPath == "" {
+				return nil, fmt.Errorf("GitHub App %d of %s has no private key", cfg.AppID, host)
+			}
+			var err error
+			if key, err = os.ReadFile(cfg.AppPrivateKeyPath); err != nil {
+				return nil, fmt.Errorf("failed to read GitHub App private key: %w", err)
+			}
+		}
+		app, err := NewApp(cfg.AppID, key)
+		if err != nil {
+			return nil, err
+		}
+		c = newAppClient(app, cfg.Token, base)
+	} else {
+		c = newTokenClient(cfg.Token, base)
+	}
+	c.host = host
+	c.botLogin = cfg.BotLogin
+	c.download = &http.Client{Transport: network}
+	for _, r := range cfg.Repos {
+		if repo, err := ParseRepo(r); err != nil || repo.Host != "" {
+			return nil, fmt.Errorf("invalid repo %q of %s: must be 'owner/repo'", r, host)
+		}
+	}
+	c.repos = cfg.Repos
+
+	apiURL, uploadURL := cfg.APIURL, cfg.UploadURL
+	if apiURL == "" && host != DefaultHost {
+		apiURL = "https://" + host + "/"
+	}
+	if apiURL != "" {
+		if uploadURL == "" {
+			uploadURL = apiURL
+		}
+		// Adds the /api/v3/ and /api/uploads/ suffixes of GHES when missing
+		enterprise, err := c.client.WithEnterpriseURLs(apiURL, uploadURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid API URL of %s: %w", host, err)
+		}
+		c.client = enterprise
+		if c.app != nil {
+			c.app.SetBaseURL(enterprise.BaseURL)
+		}
+	}
+	return c, nil
+}
+
+// transportWithCA trusts the certificates of caFile in addition to the system roots.
+func transportWithCA(caFile string) (*http.Transport, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in CA file %s", caFile)
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	return t, nil
+}
+
+// AddHost makes the client reach another GitHub host, for repos whose identifier names it.
+func (c *Client) AddHost(host *Client) {
+	if c.hosts == nil {
+		c.hosts = make(map[string]*Client)
+	}
+	c.hosts[host.host] = host
+}
+
+// ForHost returns the client of the host. The empty host is the client's own.
+func (c *Client) ForHost(host string) *Client {
+	host = strings.ToLower(host)
+	if host == "" || host == c.host {
+		return c
+	}
+	if h, ok := c.hosts[host]; ok {
+		return h
+	}
+	err := fmt.Errorf("GitHub host %s is not configured", host)
+	return &Client{client: github.NewClient(&http.Client{Transport: errTransport{err}}), host: host}
+}
+
+// api returns the go-github client of the host in the context.
+func (c *Client) api(ctx context.Context) *github.Client {
+	return c.ForHost(HostFromContext(ctx)).client
+}
+
+// BotLogin returns the login of the Jules bot on the host.
+func (c *Client) BotLogin(host string) string {
+	if login := c.ForHost(host).botLogin; login != "" {
+		return login
+	}
+	return DefaultBotLogin
+}
+
+// Repos returns the repos configured for monitoring on every host. Repos of other hosts include the host.
+func (c *Client) Repos() []string {
+	repos := append([]string(nil), c.repos...)
+	for _, h := range c.hosts {
+		for _, r := range h.repos {
+			repos = append(repos, h.host+"/"+r)
+		}
+	}
+	return repos
+}
+
+type errTransport struct{ err error }
+
+func (t errTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+// NewClientFromEnv creates a client from the environment. The default host is configured by
+// GITHUB_HOST, GITHUB_API_URL, GITHUB_UPLOAD_URL, GITHUB_CA_FILE and GITHUB_BOT_LOGIN.
+// GITHUB_APP_ID together with GITHUB_APP_PRIVATE_KEY (or GITHUB_APP_PRIVATE_KEY_PATH) selects
+// GitHub App authentication, with GITHUB_TOKEN as fallback; otherwise GITHUB_TOKEN is used on
+// its own. GITHUB_HOSTS_FILE names a YAML file configuring further hosts.
+func NewClientFromEnv() (*Client, error) {
+	cfg := HostConfig{
+		Host:              os.Getenv("GITHUB_HOST"),
+		APIURL:            os.Getenv("GITHUB_API_URL"),
+		UploadURL:         os.Getenv("GITHUB_UPLOAD_URL"),
+		CAFile:            os.Getenv("GITHUB_CA_FILE"),
+		Token:             os.Getenv("GITHUB_TOKEN"),
+		AppPrivateKey:     os.Getenv("GITHUB_APP_PRIVATE_KEY"),
+		AppPrivateKeyPath: os.Getenv("GITHUB_APP_PRIVATE_KEY_PATH"),
+		BotLogin:          os.Getenv("GITHUB_BOT_LOGIN"),
+	}
+	if appID := os.Getenv("GITHUB_APP_ID"); appID != "" {
+		id, err := strconv.ParseInt(appID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GITHUB_APP_ID %q: %w", appID, err)
+		}
+		cfg.AppID = id
+	}
+	c, err := NewHostClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if path := os.Getenv("GITHUB_HOSTS_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read GitHub hosts file: %w", err)
+		}
+		var f hostsFile
+		if err := yaml.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("failed to parse GitHub hosts file: %w", err)
+		}
+		for _, hc := range f.Hosts {
+			if hc.Host == "" {
+				return nil, fmt.Errorf("GitHub hosts file: every host needs a host name")
+			}
+			h, err := NewHostClient(hc)
+			if err != nil {
+				return nil, err
+			}
+			c.AddHost(h)
+		}
+	}
+	return c, nil
+}
+
+type hostKey struct{}
+
+// WithHost routes the client calls made with the context to the host.
+func WithHost(ctx context.Context, host string) context.Context {
+	return context.WithValue(ctx, hostKey{}, host)
+}
+
+// HostFromContext returns the host set by WithHost, or "" for the default host.
+func HostFromContext(ctx context.Context) string {
+	host, _ := ctx.Value(hostKey{}).(string)
+	return host
+}
+
+// Repo identifies a repository. Repos on the default host are written "owner/name",
+// repos on other hosts "host/owner/name".
+type Repo struct {
+	Host  string
+	Owner string
+	Name  string
+}
+
+// ParseRepo parses a repo identifier.
+func ParseRepo(id string) (Repo, error) {
+	parts := strings.Split(id, "/")
+	for _, p := range parts {
+		if p == "" {
+			return Repo{}, fmt.Errorf("invalid repo %q", id)
+		}
+	}
+	switch len(parts) {
+	case 2:
+		return Repo{Owner: parts[0], Name: parts[1]}, nil
+	case 3:
+		return Repo{Host: strings.ToLower(parts[0]), Owner: parts[1], Name: parts[2]}, nil
+	}
+	return Repo{}, fmt.Errorf("invalid repo %q: must be 'owner/repo' or 'host/owner/repo'", id)
+}
+
+// RepoID returns the identifier of a repo from its web URL and full name, as found in webhook payloads.
+func RepoID(htmlURL, fullName string) string {
+	u, err := url.Parse(htmlURL)
+	if err != nil || u.Host == "" || strings.EqualFold(u.Host, DefaultHost) {
+		return fullName
+	}
+	return strings.ToLower(u.Host) + "/" + fullName
+}
+
+// FullName returns "owner/name", as used in search queries.
+func (r Repo) FullName() string {
+	return r.Owner + "/" + r.Name
+}
+
+func (r Repo) String() string {
+	if r.Host == "" {
+		return r.FullName()
+	}
+	return r.Host + "/" + r.FullName()
+}
+
+// Context returns ctx routed to the repo's host.
+func (r Repo) Context(ctx context.Context) context.Context {
+	if r.Host == "" {
+		return ctx
+	}
+	return WithHost(ctx, r.Host)
+}
@@ -0,0 +1,118 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRepo(t *testing.T) {
+	r, err := ParseRepo("o/r")
+	assert.NoError(t, err)
+	assert.Equal(t, Repo{Owner: "o", Name: "r"}, r)
+	assert.Equal(t, "o/r", r.String())
+
+	r, err = ParseRepo("GHE.example.com/o/r")
+	assert.NoError(t, err)
+	assert.Equal(t, Repo{Host: "ghe.example.com", Owner: "o", Name: "r"}, r)
+	assert.Equal(t, "ghe.example.com/o/r", r.String())
+	assert.Equal(t, "o/r", r.FullName())
+	assert.Equal(t, "ghe.example.com", HostFromContext(r.Context(context.Background())))
+
+	for _, id := range []string{"", "o", "o/", "a/b/c/d"} {
+		_, err := ParseRepo(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestRepoID(t *testing.T) {
+	assert.Equal(t, "o/r", RepoID("https://github.com/o/r", "o/r"))
+	assert.Equal(t, "o/r", RepoID("", "o/r"))
+	assert.Equal(t, "ghe.example.com/o/r", RepoID("https://ghe.example.com/o/r", "o/r"))
+}
+
+func TestClient_RoutesByHost(t *testing.T) {
+	dotcom := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/o/r/pulls/1", r.URL.Path)
+		assert.Equal(t, "Bearer dotcom-token", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"number":1,"title":"dotcom"}`)
+	}))
+	defer dotcom.Close()
+	ghes := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// GHES serves the API under /api/v3/
+		assert.Equal(t, "/api/v3/repos/o/r/pulls/1", r.URL.Path)
+		assert.Equal(t, "Bearer ghes-token", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"number":1,"title":"ghes"}`)
+	}))
+	defer ghes.Close()
+
+	c := NewClient("dotcom-token")
+	assert.NoError(t, c.SetBaseURL(dotcom.URL+"/"))
+	h, err := NewHostClient(HostConfig{Host: "ghe.example.com", APIURL: ghes.URL, Token: "ghes-token", BotLogin: "jules-bot", Repos: []string{"o/r"}})
+	assert.NoError(t, err)
+	c.AddHost(h)
+
+	ctx := context.Background()
+	pr, _, err := c.GetPullRequest(ctx, "o", "r", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "dotcom", pr.GetTitle())
+
+	pr, _, err = c.GetPullRequest(WithHost(ctx, "ghe.example.com"), "o", "r", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "ghes", pr.GetTitle())
+
+	_, _, err = c.GetPullRequest(WithHost(ctx, "other.example.com"), "o", "r", 1)
+	assert.ErrorContains(t, err, "GitHub host other.example.com is not configured")
+
+	assert.Equal(t, DefaultBotLogin, c.BotLogin(""))
+	assert.Equal(t, "jules-bot", c.BotLogin("ghe.example.com"))
+	assert.Equal(t, []string{"ghe.example.com/o/r"}, c.Repos())
+}
+
+func TestNewHostClient_Validation(t *testing.T) {
+	_, err := NewHostClient(HostConfig{Host: "ghe.example.com", Repos: []string{"other.example.com/o/r"}})
+	assert.Error(t, err)
+
+	_, err = NewHostClient(HostConfig{Host: "ghe.example.com", CAFile: "/nonexistent/ca.pem"})
+	assert.ErrorContains(t, err, "failed to read CA file")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0600))
+	_, err = NewHostClient(HostConfig{Host: "ghe.example.com", CAFile: caFile})
+	assert.ErrorContains(t, err, "no certificates found")
+
+	c, err := NewHostClient(HostConfig{Host: "ghe.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://ghe.example.com/api/v3/", c.client.BaseURL.String())
+	assert.Equal(t, "https://ghe.example.com/api/uploads/", c.client.UploadURL.String())
+}
+
+func TestNewClientFromEnv_HostsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+hosts:
+  - host: ghe.example.com
+    token: t
+    bot_login: jules-bot
+    repos: [team/service]
+`), 0600))
+	t.Setenv("GITHUB_APP_ID", "")
+	t.Setenv("GITHUB_HOST", "")
+	t.Setenv("GITHUB_API_URL", "")
+	t.Setenv("GITHUB_HOSTS_FILE", path)
+
+	c, err := NewClientFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, "jules-bot", c.BotLogin("ghe.example.com"))
+	assert.Equal(t, []string{"ghe.example.com/team/service"}, c.Repos())
+
+	assert.NoError(t, os.WriteFile(path, []byte("hosts:\n  - token: t\n"), 0600))
+	_, err = NewClientFromEnv()
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// RequiredChecks returns the names of the status checks that must pass before PRs can be
+// merged into the branch, from its branch protection and the rulesets that apply to it.
+// Unprotected branches have none. Reading branch protection needs admin access to the repo;
+// without it, only the rulesets are read.
+func (c *Client) RequiredChecks(ctx context.Context, owner, repo, branch string) ([]string, error) {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	checks, _, err := c.api(ctx).Repositories.GetRequiredStatusChecks(ctx, owner, repo, branch)
+	switch {
+	case errors.Is(err, github.ErrBranchNotProtected):
+	case err == nil:
+		if checks.Checks != nil {
+			for _, check := range *checks.Checks {
+				add(check.Context)
+			}
+		}
+		if checks.Contexts != nil {
+			for _, name := range *checks.Contexts {
+				add(name)
+			}
+		}
+	case !isNotFoundOrForbidden(err):
+		return nil, err
+	}
+
+	rules, _, err := c.api(ctx).Repositories.GetRulesForBranch(ctx, owner, repo, branch)
+	switch {
+	case err == nil:
+		for _, rule := range rules.RequiredStatusChecks {
+			for _, check := range rule.Parameters.RequiredStatusChecks {
+				add(check.Context)
+			}
+		}
+	case !isNotFoundOrForbidden(err):
+		return nil, err
+	}
+	return names, nil
+}
+
+// isNotFoundOrForbidden reports whether GitHub answered 404 or 403, which it does for
+// unprotected branches and for settings the token may not read.
+func isNotFoundOrForbidden(err error) bool {
+	status := errorStatus(err)
+	return status == http.StatusNotFound || status == http.StatusForbidden
+}
+
+// isNotFound reports whether GitHub answered 404.
+func isNotFound(err error) bool {
+	return errorStatus(err) == http.StatusNotFound
+}
+
+// errorStatus returns the HTTP status of a GitHub API error, or 0.
+func errorStatus(err error) int {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return 0
+	}
+	return errResp.Response.StatusCode
+}
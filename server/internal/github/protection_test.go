@@ -0,0 +1,45 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequiredChecks(t *testing.T) {
+	protected := true
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/o/r/branches/main/protection/required_status_checks":
+			if !protected {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"message":"Branch not protected"}`)
+				return
+			}
+			fmt.Fprint(w, `{"strict":true,"contexts":["ci/legacy","test"],"checks":[{"context":"ci/legacy"},{"context":"test"}]}`)
+		case "/repos/o/r/rules/branches/main":
+			fmt.Fprint(w, `[{"type":"required_status_checks","ruleset_id":1,"parameters":{"strict_required_status_checks_policy":false,
+				"required_status_checks":[{"context":"test"},{"context":"lint"}]}},{"type":"deletion","ruleset_id":1}]`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	c, server := newTestClient(t, handler)
+	defer server.Close()
+
+	names, err := c.RequiredChecks(context.Background(), "o", "r", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ci/legacy", "test", "lint"}, names)
+
+	// Without branch protection, only the rulesets apply
+	protected = false
+	names, err = c.RequiredChecks(context.Background(), "o", "r", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test", "lint"}, names)
+
+	_, err = c.RequiredChecks(context.Background(), "o", "r", "dev")
+	assert.Error(t, err)
+}
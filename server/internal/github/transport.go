@@ -0,0 +1,328 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mcpany/jules/internal/logger"
+)
+
+const (
+	// maxCachedResponses bounds the ETag cache; the oldest entries are evicted first.
+	maxCachedResponses = 2000
+	// maxCachedBodyBytes keeps large responses (e.g. logs) out of the caches.
+	maxCachedBodyBytes = 1 << 20
+	// maxRateLimitWait is the longest a request waits for its rate limit to reset.
+	maxRateLimitWait = 15 * time.Minute
+	// maxRetryAfter is the longest a request waits after hitting a secondary rate limit.
+	maxRetryAfter = 5 * time.Minute
+)
+
+type cachedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// response rebuilds the cached response for req.
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.status, http.StatusText(c.status)),
+		StatusCode:    c.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+type rateLimit struct {
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// cachingTransport makes conditional requests with the ETag and Last-Modified of earlier
+// responses, since GitHub doesn't count 304s against the rate limit. It also paces requests
+// as the rate limit runs out, waits out secondary rate limits, and serves repeated GETs from
+// a per-context cache (see WithRequestCache).
+type cachingTransport struct {
+	base  http.RoundTripper
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu     sync.Mutex
+	etags  map[string]*cachedResponse
+	order  []string
+	limits map[string]*rateLimit // by resource, e.g. "core" or "search"
+}
+
+func newCachingTransport(base http.RoundTripper) *cachingTransport {
+	return &cachingTransport{
+		base:   base,
+		now:    time.Now,
+		sleep:  sleepContext,
+		etags:  make(map[string]*cachedResponse),
+		limits: make(map[string]*rateLimit),
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	key := cacheKey(req)
+	resource := requestResource(req)
+	memo := requestCacheFromContext(ctx)
+	if memo != nil {
+		// Writes may change what later reads return. GraphQL requests are only used for queries.
+		if key == "" && resource != "graphql" {
+			memo.clear()
+		} else if c := memo.get(key); c != nil {
+			return c.response(req), nil
+		}
+	}
+
+	if err := t.throttle(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	var cached *cachedResponse
+	if key != "" {
+		t.mu.Lock()
+		cached = t.etags[key]
+		t.mu.Unlock()
+	}
+	out := req
+	if cached != nil {
+		out = req.Clone(ctx)
+		if etag := cached.header.Get("ETag"); etag != "" {
+			out.Header.Set("If-None-Match", etag)
+		}
+		if lm := cached.header.Get("Last-Modified"); lm != "" {
+			out.Header.Set("If-Modified-Since", lm)
+		}
+	}
+
+	resp, err := t.send(out, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		fresh := cached.response(req)
+		for k, v := range resp.Header {
+			if strings.HasPrefix(k, "X-Ratelimit-") {
+				fresh.Header[k] = v
+			}
+		}
+		resp = fresh
+	} else if key != "" && resp.StatusCode == http.StatusOK && resp.ContentLength <= maxCachedBodyBytes {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodyBytes+1))
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if len(body) <= maxCachedBodyBytes {
+			c := &cachedResponse{status: resp.StatusCode, header: resp.Header.Clone(), body: body}
+			if c.header.Get("ETag") != "" || c.header.Get("Last-Modified") != "" {
+				t.store(key, c)
+			}
+			if memo != nil {
+				memo.put(key, c)
+			}
+		}
+		return resp, nil
+	}
+
+	if memo != nil && key != "" && resp.StatusCode == http.StatusOK && cached != nil {
+		memo.put(key, cached)
+	}
+	return resp, nil
+}
+
+// send performs the request, waiting out a secondary rate limit once.
+func (t *cachingTransport) send(req *http.Request, resource string) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	t.record(resp, resource)
+
+	wait, ok := retryAfter(resp)
+	if !ok || wait > maxRetryAfter || (req.Body != nil && req.GetBody == nil) {
+		return resp, nil
+	}
+	logger.Warn("GitHub secondary rate limit hit on %s %s, retrying in %s", req.Method, req.URL.Path, wait)
+	resp.Body.Close()
+	if err := t.sleep(req.Context(), wait); err != nil {
+		return nil, err
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	resp, err = t.base.RoundTrip(retry)
+	if err != nil {
+		return nil, err
+	}
+	t.record(resp, resource)
+	return resp, nil
+}
+
+// retryAfter returns how long a rate limited response asks to wait. Exhausted primary
+// limits are left to throttle, which knows when they reset.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		return 0, false
+	}
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	return time.Duration(secs) * time.Second, true
+}
+
+// throttle waits before a request when the resource's rate limit is exhausted or nearly so.
+// Near the limit the remaining requests are spread over the time left until the reset.
+func (t *cachingTransport) throttle(ctx context.Context, resource string) error {
+	t.mu.Lock()
+	var wait time.Duration
+	var remaining int
+	var reset time.Time
+	now := t.now()
+	if rl, ok := t.limits[resource]; ok && rl.reset.After(now) {
+		remaining, reset = rl.remaining, rl.reset
+		untilReset := reset.Sub(now)
+		switch {
+		case remaining <= 0:
+			wait = untilReset + time.Second
+		case remaining < rl.limit/20:
+			wait = untilReset / time.Duration(remaining)
+		}
+		// Concurrent requests must not all spend the same remaining request
+		rl.remaining--
+	}
+	t.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	if wait > maxRateLimitWait {
+		return fmt.Errorf("GitHub %s rate limit exhausted until %s", resource, reset.Format(time.RFC3339))
+	}
+	logger.Warn("GitHub %s rate limit nearly exhausted (%d left), waiting %s", resource, remaining, wait.Round(time.Second))
+	return t.sleep(ctx, wait)
+}
+
+// record keeps the rate limit reported by a response.
+func (t *cachingTransport) record(resp *http.Response, resource string) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	reset, _ := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if r := resp.Header.Get("X-RateLimit-Resource"); r != "" {
+		resource = r
+	}
+
+	t.mu.Lock()
+	t.limits[resource] = &rateLimit{limit: limit, remaining: remaining, reset: time.Unix(reset, 0)}
+	t.mu.Unlock()
+}
+
+func (t *cachingTransport) store(key string, c *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.etags[key]; !ok {
+		t.order = append(t.order, key)
+	}
+	t.etags[key] = c
+	for len(t.order) > maxCachedResponses {
+		delete(t.etags, t.order[0])
+		t.order = t.order[1:]
+	}
+}
+
+// cacheKey identifies a cacheable request, or returns "" for requests that are not.
+func cacheKey(req *http.Request) string {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return ""
+	}
+	return req.Method + " " + req.URL.String() + " " + req.Header.Get("Accept")
+}
+
+// requestResource returns the rate limit resource the request counts against.
+func requestResource(req *http.Request) string {
+	switch {
+	case strings.Contains(req.URL.Path, "/search/"):
+		return "search"
+	case strings.HasSuffix(req.URL.Path, "/graphql"):
+		return "graphql"
+	}
+	return "core"
+}
+
+type requestCacheKey struct{}
+
+// requestCache holds the responses of the GETs made with a context.
+type requestCache struct {
+	mu        sync.Mutex
+	responses map[string]*cachedResponse
+}
+
+// WithRequestCache makes identical GET requests made with the returned context, e.g. during one
+// monitor pass, hit GitHub only once. Any other request clears the cache, since it may change
+// what later reads return.
+func WithRequestCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestCacheKey{}, &requestCache{responses: make(map[string]*cachedResponse)})
+}
+
+func requestCacheFromContext(ctx context.Context) *requestCache {
+	c, _ := ctx.Value(requestCacheKey{}).(*requestCache)
+	return c
+}
+
+func (c *requestCache) get(key string) *cachedResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.responses[key]
+}
+
+func (c *requestCache) put(key string, r *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[key] = r
+}
+
+func (c *requestCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses = make(map[string]*cachedResponse)
+}
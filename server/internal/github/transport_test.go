@@ -0,0 +1,138 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newCachingTestClient returns a client whose transport records sleeps instead of waiting.
+func newCachingTestClient(t *testing.T, handler http.Handler) (*Client, *cachingTransport, *[]time.Duration) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	var sleeps []time.Duration
+	tr := newCachingTransport(http.DefaultTransport)
+	tr.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	c := newTokenClient("dummy-token", tr)
+	assert.NoError(t, c.SetBaseURL(server.URL+"/"))
+	return c, tr, &sleeps
+}
+
+func TestCachingTransport_ETag(t *testing.T) {
+	var full, notModified int
+	c, _, _ := newCachingTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full++
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"number":1,"title":"cached"}`)
+	}))
+
+	for i := 0; i < 3; i++ {
+		pr, _, err := c.GetPullRequest(context.Background(), "o", "r", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "cached", pr.GetTitle())
+	}
+	assert.Equal(t, 1, full)
+	assert.Equal(t, 2, notModified)
+}
+
+func TestCachingTransport_RequestCache(t *testing.T) {
+	var gets, posts int
+	c, _, _ := newCachingTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts++
+			fmt.Fprint(w, `{"id":1}`)
+			return
+		}
+		gets++
+		fmt.Fprint(w, `{"number":1}`)
+	}))
+
+	ctx := WithRequestCache(context.Background())
+	for i := 0; i < 3; i++ {
+		_, _, err := c.GetPullRequest(ctx, "o", "r", 1)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, gets)
+
+	// Writes clear the cache
+	assert.NoError(t, c.CreateComment(ctx, "o", "r", 1, "hi"))
+	_, _, err := c.GetPullRequest(ctx, "o", "r", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, gets)
+	assert.Equal(t, 1, posts)
+
+	// Without the cache every call is made
+	_, _, err = c.GetPullRequest(context.Background(), "o", "r", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, gets)
+}
+
+func TestCachingTransport_RateLimit(t *testing.T) {
+	remaining, reset := 100, time.Now().Add(10*time.Minute)
+	c, tr, sleeps := newCachingTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set("X-RateLimit-Resource", "core")
+		fmt.Fprint(w, `{"number":1}`)
+	}))
+	ctx := context.Background()
+
+	// The first response reports the limit; nothing is known before
+	_, _, err := c.GetPullRequest(ctx, "o", "r", 1)
+	assert.NoError(t, err)
+	assert.Empty(t, *sleeps)
+
+	// Below 5% of the limit, the remaining requests are spread until the reset
+	_, _, err = c.GetPullRequest(ctx, "o", "r", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, *sleeps, 1) {
+		assert.InDelta(t, (10 * time.Minute / 100).Seconds(), (*sleeps)[0].Seconds(), 1)
+	}
+
+	// Exhausted: wait for the reset, unless it is too far away
+	tr.limits["core"] = &rateLimit{limit: 5000, remaining: 0, reset: reset}
+	assert.NoError(t, tr.throttle(ctx, "core"))
+	if assert.Len(t, *sleeps, 2) {
+		assert.InDelta(t, (10 * time.Minute).Seconds(), (*sleeps)[1].Seconds(), 2)
+	}
+	tr.now = func() time.Time { return reset.Add(-time.Hour) }
+	assert.ErrorContains(t, tr.throttle(ctx, "core"), "GitHub core rate limit exhausted")
+
+	// Other resources are tracked separately
+	_, _, err = c.SearchIssues(ctx, "repo:o/r", nil)
+	assert.NoError(t, err)
+}
+
+func TestCachingTransport_SecondaryRateLimit(t *testing.T) {
+	calls := 0
+	c, _, sleeps := newCachingTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	}))
+
+	assert.NoError(t, c.CreateComment(context.Background(), "o", "r", 1, "hi"))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []time.Duration{30 * time.Second}, *sleeps)
+}
@@ -1,11 +1,14 @@
 package ratelimit
 
 import (
-	"fmt"
+	"errors"
 	"sync"
 	"time"
 )
 
+// ErrRateLimited is returned by Check when the key was seen less than one interval ago.
+var ErrRateLimited = errors.New("rate limit exceeded: please slow down")
+
 type Limiter struct {
 	mu       sync.Mutex
 	lastSeen map[string]time.Time
@@ -58,7 +61,7 @@ func (l *Limiter) Check(key string) error {
 	last, exists := l.lastSeen[key]
 
 	if exists && now.Sub(last) < l.interval {
-		return fmt.Errorf("rate limit exceeded: please slow down")
+		return ErrRateLimited
 	}
 
 	l.lastSeen[key] = now
@@ -20,7 +20,8 @@ func (s *CronJobServer) ListCronJobs(ctx context.Context, _ *emptypb.Empty) (*pb
 	rows, err := s.DB.Query(`
 		SELECT id, name, schedule, prompt, repo, branch, enabled, auto_approval, 
 		       automation_mode, require_plan_approval, session_count, profile_id, 
-			   created_at, updated_at, last_run_at 
+			   created_at, updated_at, last_run_at, matrix, priority,
+			   time_zone, catch_up, jitter_seconds, skip_if_running
 		FROM cron_jobs 
 		ORDER BY created_at DESC
 	`)
@@ -32,8 +33,8 @@ func (s *CronJobServer) ListCronJobs(ctx context.Context, _ *emptypb.Empty) (*pb
 	var jobs []*pb.CronJob
 	for rows.Next() {
 		var j pb.CronJob
-		var updatedAt, lastRunAt sql.NullString
-		var automationMode sql.NullString
+		var updatedAt, lastRunAt, matrix sql.NullString
+		var automationMode, timeZone, catchUp sql.NullString
 
 		// Scan into local vars then convert to proto
 		// assuming automation_mode is string enum in DB
@@ -41,7 +42,8 @@ func (s *CronJobServer) ListCronJobs(ctx context.Context, _ *emptypb.Empty) (*pb
 		if err := rows.Scan(
 			&j.Id, &j.Name, &j.Schedule, &j.Prompt, &j.Repo, &j.Branch, &j.Enabled, &j.AutoApproval,
 			&automationMode, &j.RequirePlanApproval, &j.SessionCount, &j.ProfileId,
-			&j.CreatedAt, &updatedAt, &lastRunAt,
+			&j.CreatedAt, &updatedAt, &lastRunAt, &matrix, &j.Priority,
+			&timeZone, &catchUp, &j.JitterSeconds, &j.SkipIfRunning,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan cron job: %w", err)
 		}
@@ -52,6 +54,9 @@ func (s *CronJobServer) ListCronJobs(ctx context.Context, _ *emptypb.Empty) (*pb
 		if lastRunAt.Valid {
 			j.LastRunAt = lastRunAt.String
 		}
+		j.Matrix = unmarshalMatrix(matrix)
+		j.TimeZone = timeZone.String
+		j.CatchUp = ParseCronCatchUp(catchUp.String)
 
 		if automationMode.Valid {
 			if automationMode.String == "AUTO_CREATE_PR" {
@@ -66,14 +71,34 @@ func (s *CronJobServer) ListCronJobs(ctx context.Context, _ *emptypb.Empty) (*pb
 }
 
 func (s *CronJobServer) CreateCronJob(ctx context.Context, req *pb.CreateCronJobRequest) (*pb.CronJob, error) {
+	return createCronJob(ctx, s.DB, req)
+}
+
+func createCronJob(ctx context.Context, db execer, req *pb.CreateCronJobRequest) (*pb.CronJob, error) {
+	if err := ValidateCronSchedule(req.Schedule, req.TimeZone, req.JitterSeconds); err != nil {
+		return nil, err
+	}
+	if err := ValidateMatrix(req.Matrix); err != nil {
+		return nil, err
+	}
+	if hasMatrix(req.Matrix) && req.Repo == "" {
+		req.Repo, req.Branch = req.Matrix.Targets[0].Repo, req.Matrix.Targets[0].Branch
+	}
 	if err := ValidateRepo(req.Repo); err != nil {
 		return nil, err
 	}
 	if err := ValidateBranch(req.Branch); err != nil {
 		return nil, err
 	}
+	matrix, err := marshalMatrix(req.Matrix)
+	if err != nil {
+		return nil, err
+	}
 
-	id := uuid.New().String()
+	id := req.Id
+	if id == "" {
+		id = uuid.New().String()
+	}
 	createdAt := time.Now().Format(time.RFC3339)
 
 	// Default automation mode
@@ -82,15 +107,15 @@ func (s *CronJobServer) CreateCronJob(ctx context.Context, req *pb.CreateCronJob
 		automationModeStr = "AUTO_CREATE_PR"
 	}
 
-	_, err := s.DB.Exec(`
+	_, err = db.ExecContext(ctx, `
 		INSERT INTO cron_jobs (
 			id, name, schedule, prompt, repo, branch, auto_approval, 
 			automation_mode, require_plan_approval, session_count, profile_id, 
-			enabled, created_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			enabled, created_at, matrix, priority, time_zone, catch_up, jitter_seconds, skip_if_running
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`, id, req.Name, req.Schedule, req.Prompt, req.Repo, req.Branch, req.AutoApproval,
 		automationModeStr, req.RequirePlanApproval, req.SessionCount, req.ProfileId,
-		true, createdAt) // Enabled by default
+		true, createdAt, matrix, req.Priority, req.TimeZone, CronCatchUpString(req.CatchUp), req.JitterSeconds, req.SkipIfRunning) // Enabled by default
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cron job: %w", err)
@@ -110,10 +135,20 @@ func (s *CronJobServer) CreateCronJob(ctx context.Context, req *pb.CreateCronJob
 		ProfileId:           req.ProfileId,
 		Enabled:             true,
 		CreatedAt:           createdAt,
+		Matrix:              req.Matrix,
+		Priority:            req.Priority,
+		TimeZone:            req.TimeZone,
+		CatchUp:             req.CatchUp,
+		JitterSeconds:       req.JitterSeconds,
+		SkipIfRunning:       req.SkipIfRunning,
 	}, nil
 }
 
 func (s *CronJobServer) UpdateCronJob(ctx context.Context, req *pb.UpdateCronJobRequest) (*emptypb.Empty, error) {
+	return updateCronJob(ctx, s.DB, req)
+}
+
+func updateCronJob(ctx context.Context, db execer, req *pb.UpdateCronJobRequest) (*emptypb.Empty, error) {
 	// Dynamic updates based on what is set in the request.
 	// Since proto3 fields are always present (zero values), we used optional in proto definitions where possible or rely on client sending full object?
 	// In our proto definition we used `optional` fields.
@@ -123,6 +158,23 @@ func (s *CronJobServer) UpdateCronJob(ctx context.Context, req *pb.UpdateCronJob
 	// Or better: UPDATE ... SET ... where field is updated.
 	// Given the `optional` keyword in proto3, generated Go struct has pointers for optional fields.
 
+	if req.Schedule != nil || req.TimeZone != nil {
+		var schedule, timeZone sql.NullString
+		err := db.QueryRowContext(ctx, "SELECT schedule, time_zone FROM cron_jobs WHERE id = ?", req.Id).Scan(&schedule, &timeZone)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, fmt.Errorf("failed to fetch cron job: %w", err)
+		}
+		if req.Schedule != nil {
+			schedule.String = *req.Schedule
+		}
+		if req.TimeZone != nil {
+			timeZone.String = *req.TimeZone
+		}
+		if _, _, err := ParseCronSchedule(schedule.String, timeZone.String); err != nil {
+			return nil, err
+		}
+	}
+
 	query := "UPDATE cron_jobs SET updated_at = ?"
 	args := []interface{}{time.Now().Format(time.RFC3339)}
 
@@ -176,11 +228,45 @@ func (s *CronJobServer) UpdateCronJob(ctx context.Context, req *pb.UpdateCronJob
 		query += ", enabled = ?"
 		args = append(args, *req.Enabled)
 	}
+	if req.Priority != nil {
+		query += ", priority = ?"
+		args = append(args, *req.Priority)
+	}
+	if req.TimeZone != nil {
+		query += ", time_zone = ?"
+		args = append(args, *req.TimeZone)
+	}
+	if req.CatchUp != nil {
+		query += ", catch_up = ?"
+		args = append(args, CronCatchUpString(*req.CatchUp))
+	}
+	if req.JitterSeconds != nil {
+		if err := validateCronJitter(*req.JitterSeconds); err != nil {
+			return nil, err
+		}
+		query += ", jitter_seconds = ?"
+		args = append(args, *req.JitterSeconds)
+	}
+	if req.SkipIfRunning != nil {
+		query += ", skip_if_running = ?"
+		args = append(args, *req.SkipIfRunning)
+	}
+	if req.Matrix != nil {
+		if err := ValidateMatrix(req.Matrix); err != nil {
+			return nil, err
+		}
+		matrix, err := marshalMatrix(req.Matrix)
+		if err != nil {
+			return nil, err
+		}
+		query += ", matrix = ?"
+		args = append(args, matrix)
+	}
 
 	query += " WHERE id = ?"
 	args = append(args, req.Id)
 
-	_, err := s.DB.Exec(query, args...)
+	_, err := db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update cron job: %w", err)
 	}
@@ -189,15 +275,26 @@ func (s *CronJobServer) UpdateCronJob(ctx context.Context, req *pb.UpdateCronJob
 }
 
 func (s *CronJobServer) DeleteCronJob(ctx context.Context, req *pb.DeleteCronJobRequest) (*emptypb.Empty, error) {
-	_, err := s.DB.Exec("DELETE FROM cron_jobs WHERE id = ?", req.Id)
+	return deleteCronJob(ctx, s.DB, req)
+}
+
+func deleteCronJob(ctx context.Context, db execer, req *pb.DeleteCronJobRequest) (*emptypb.Empty, error) {
+	_, err := db.ExecContext(ctx, "DELETE FROM cron_jobs WHERE id = ?", req.Id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete cron job: %w", err)
 	}
+	if _, err := db.ExecContext(ctx, "DELETE FROM cron_runs WHERE cron_job_id = ?", req.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete cron runs: %w", err)
+	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *CronJobServer) ToggleCronJob(ctx context.Context, req *pb.ToggleCronJobRequest) (*emptypb.Empty, error) {
-	_, err := s.DB.Exec("UPDATE cron_jobs SET enabled = ?, updated_at = ? WHERE id = ?", req.Enabled, time.Now().Format(time.RFC3339), req.Id)
+	return toggleCronJob(ctx, s.DB, req)
+}
+
+func toggleCronJob(ctx context.Context, db execer, req *pb.ToggleCronJobRequest) (*emptypb.Empty, error) {
+	_, err := db.ExecContext(ctx, "UPDATE cron_jobs SET enabled = ?, updated_at = ? WHERE id = ?", req.Enabled, time.Now().Format(time.RFC3339), req.Id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to toggle cron job: %w", err)
 	}
@@ -207,9 +304,9 @@ func (s *CronJobServer) ToggleCronJob(ctx context.Context, req *pb.ToggleCronJob
 func (s *CronJobServer) ExecuteCronJob(ctx context.Context, req *pb.ExecuteCronJobRequest) (*emptypb.Empty, error) {
 	// 1. Fetch Cron Job
 	var j pb.CronJob
-	var automationMode sql.NullString
-	err := s.DB.QueryRow(`SELECT name, prompt, repo, branch, auto_approval, automation_mode, require_plan_approval, session_count, profile_id FROM cron_jobs WHERE id = ?`, req.Id).Scan(
-		&j.Name, &j.Prompt, &j.Repo, &j.Branch, &j.AutoApproval, &automationMode, &j.RequirePlanApproval, &j.SessionCount, &j.ProfileId,
+	var automationMode, matrix sql.NullString
+	err := s.DB.QueryRow(`SELECT name, prompt, repo, branch, auto_approval, automation_mode, require_plan_approval, session_count, profile_id, matrix, priority FROM cron_jobs WHERE id = ?`, req.Id).Scan(
+		&j.Name, &j.Prompt, &j.Repo, &j.Branch, &j.AutoApproval, &automationMode, &j.RequirePlanApproval, &j.SessionCount, &j.ProfileId, &matrix, &j.Priority,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch cron job: %w", err)
@@ -224,18 +321,24 @@ func (s *CronJobServer) ExecuteCronJob(ctx context.Context, req *pb.ExecuteCronJ
 		INSERT INTO jobs (
 			id, name, created_at, repo, branch, auto_approval, 
 			background, prompt, session_count, status, automation_mode, 
-			require_plan_approval, cron_job_id, profile_id, session_ids
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			require_plan_approval, cron_job_id, profile_id, session_ids, matrix, priority
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`, jobId, j.Name, createdAt, j.Repo, j.Branch, j.AutoApproval,
 		true, j.Prompt, j.SessionCount, status, automationMode,
-		j.RequirePlanApproval, req.Id, j.ProfileId, "[]")
+		j.RequirePlanApproval, req.Id, j.ProfileId, "[]", matrix, j.Priority)
 
+	run := &pb.CronRun{CronJobId: req.Id, Trigger: pb.CronTrigger_CRON_TRIGGER_MANUAL, TriggeredAt: createdAt, Status: CronRunStarted, JobId: jobId}
 	if err != nil {
+		run.Status, run.Message, run.JobId = CronRunFailed, err.Error(), ""
+		_ = s.RecordCronRun(ctx, run)
 		return nil, fmt.Errorf("failed to insert job: %w", err)
 	}
 
 	// 3. Update Last Run
 	_, _ = s.DB.Exec("UPDATE cron_jobs SET last_run_at = ? WHERE id = ?", createdAt, req.Id)
+	if err := s.RecordCronRun(ctx, run); err != nil {
+		return nil, err
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/mcpany/jules/proto"
+)
+
+// Cron run statuses, stored in the cron_runs table.
+const (
+	CronRunStarted = "STARTED"
+	CronRunSkipped = "SKIPPED"
+	CronRunFailed  = "FAILED"
+)
+
+const (
+	defaultCronRunsLimit = 50
+	maxCronRunsLimit     = 500
+	defaultPreviewCount  = 5
+	maxPreviewCount      = 100
+)
+
+// CronTriggerString converts a trigger into its stored form ('SCHEDULED', 'MANUAL').
+func CronTriggerString(t pb.CronTrigger) string {
+	if t == pb.CronTrigger_CRON_TRIGGER_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(t.String(), "CRON_TRIGGER_")
+}
+
+// RecordCronRun stores a trigger of a cron job. The id and triggered_at are filled in when empty.
+func (s *CronJobServer) RecordCronRun(ctx context.Context, run *pb.CronRun) error {
+	if run.Id == "" {
+		run.Id = uuid.New().String()
+	}
+	if run.TriggeredAt == "" {
+		run.TriggeredAt = time.Now().Format(time.RFC3339)
+	}
+	if run.ScheduledAt == "" {
+		run.ScheduledAt = run.TriggeredAt
+	}
+	var message, jobID sql.NullString
+	if run.Message != "" {
+		message = sql.NullString{String: run.Message, Valid: true}
+	}
+	if run.JobId != "" {
+		jobID = sql.NullString{String: run.JobId, Valid: true}
+	}
+	_, err := s.DB.ExecContext(ctx, `
+		INSERT INTO cron_runs (id, cron_job_id, trigger, scheduled_at, triggered_at, status, message, job_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		run.Id, run.CronJobId, CronTriggerString(run.Trigger), run.ScheduledAt, run.TriggeredAt, run.Status, message, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to record cron run: %w", err)
+	}
+	return nil
+}
+
+// CronRunRecorded reports whether the run of a cron job scheduled at the given time was already
+// recorded with a status.
+func (s *CronJobServer) CronRunRecorded(ctx context.Context, cronID, scheduledAt, status string) (bool, error) {
+	var recorded bool
+	err := s.DB.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM cron_runs WHERE cron_job_id = ? AND scheduled_at = ? AND status = ?)`,
+		cronID, scheduledAt, status).Scan(&recorded)
+	if err != nil {
+		return false, fmt.Errorf("failed to check cron run history: %w", err)
+	}
+	return recorded, nil
+}
+
+// ListCronRuns returns the latest runs of a cron job (or of all cron jobs) with the current status
+// of the jobs they created and the PRs those jobs produced.
+func (s *CronJobServer) ListCronRuns(ctx context.Context, req *pb.ListCronRunsRequest) (*pb.ListCronRunsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultCronRunsLimit
+	}
+	if limit > maxCronRunsLimit {
+		limit = maxCronRunsLimit
+	}
+
+	query := `
+		SELECT r.id, r.cron_job_id, r.trigger, r.scheduled_at, r.triggered_at, r.status, r.message, r.job_id, j.status
+		FROM cron_runs r LEFT JOIN jobs j ON j.id = r.job_id`
+	var args []interface{}
+	if req.CronJobId != "" {
+		query += " WHERE r.cron_job_id = ?"
+		args = append(args, req.CronJobId)
+	}
+	query += " ORDER BY r.triggered_at DESC, r.scheduled_at DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cron runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*pb.CronRun
+	for rows.Next() {
+		var r pb.CronRun
+		var trigger string
+		var message, jobID, jobStatus sql.NullString
+		if err := rows.Scan(&r.Id, &r.CronJobId, &trigger, &r.ScheduledAt, &r.TriggeredAt, &r.Status, &message, &jobID, &jobStatus); err != nil {
+			return nil, fmt.Errorf("failed to scan cron run: %w", err)
+		}
+		r.Trigger = pb.CronTrigger(pb.CronTrigger_value["CRON_TRIGGER_"+trigger])
+		r.Message = message.String
+		r.JobId = jobID.String
+		r.JobStatus, _ = ParseJobStatus(jobStatus.String)
+		runs = append(runs, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list cron runs: %w", err)
+	}
+	rows.Close()
+
+	for _, r := range runs {
+		if r.JobId == "" {
+			continue
+		}
+		if err := s.loadRunPRs(ctx, r); err != nil {
+			return nil, err
+		}
+	}
+	return &pb.ListCronRunsResponse{Runs: runs}, nil
+}
+
+// loadRunPRs fills in the PRs opened by the sessions of a run's job.
+func (s *CronJobServer) loadRunPRs(ctx context.Context, r *pb.CronRun) error {
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT s.pr_url, COALESCE(s.is_pr_merged, 0) FROM job_sessions js
+		JOIN sessions s ON s.id = js.session_id
+		WHERE js.job_id = ? AND COALESCE(s.pr_url, '') != ''
+		ORDER BY js.slot_index`, r.JobId)
+	if err != nil {
+		return fmt.Errorf("failed to list PRs of cron run: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var url string
+		var merged bool
+		if err := rows.Scan(&url, &merged); err != nil {
+			return fmt.Errorf("failed to scan PR of cron run: %w", err)
+		}
+		r.PrUrls = append(r.PrUrls, url)
+		if merged {
+			r.PrsMerged++
+		}
+	}
+	return rows.Err()
+}
+
+// PreviewCronSchedule returns the next fire times of a schedule, without jitter.
+func (s *CronJobServer) PreviewCronSchedule(ctx context.Context, req *pb.PreviewCronScheduleRequest) (*pb.PreviewCronScheduleResponse, error) {
+	sched, loc, err := ParseCronSchedule(req.Schedule, req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	count := req.Count
+	if count <= 0 {
+		count = defaultPreviewCount
+	}
+	if count > maxPreviewCount {
+		return nil, fmt.Errorf("count is too large (max %d)", maxPreviewCount)
+	}
+	after := time.Now()
+	if req.After != "" {
+		if after, err = time.Parse(time.RFC3339, req.After); err != nil {
+			return nil, fmt.Errorf("invalid after time: %w", err)
+		}
+	}
+
+	resp := &pb.PreviewCronScheduleResponse{}
+	for t := sched.Next(after.In(loc)); !t.IsZero() && int32(len(resp.Times)) < count; t = sched.Next(t) {
+		resp.Times = append(resp.Times, t.Format(time.RFC3339))
+	}
+	return resp, nil
+}
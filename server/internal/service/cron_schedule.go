@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	pb "github.com/mcpany/jules/proto"
+	"github.com/robfig/cron/v3"
+)
+
+// MaxCronJitterSeconds caps the random delay of cron runs.
+const MaxCronJitterSeconds = 3600
+
+// maxDueCronRuns bounds how many missed runs are computed for a cron job (a month of minutes).
+const maxDueCronRuns = 31 * 24 * 60
+
+// maxDueCronWindow bounds how far back missed runs of a cron job are looked for.
+const maxDueCronWindow = 31 * 24 * time.Hour
+
+// CronParser parses the 5-field schedules of cron jobs, and descriptors such as @daily.
+var CronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseCronSchedule parses a schedule and the time zone it is evaluated in.
+// An empty time zone is the server's local time.
+func ParseCronSchedule(schedule, timeZone string) (cron.Schedule, *time.Location, error) {
+	loc := time.Local
+	if timeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timeZone); err != nil {
+			return nil, nil, fmt.Errorf("invalid time zone %q", timeZone)
+		}
+	}
+	if strings.HasPrefix(schedule, "TZ=") || strings.HasPrefix(schedule, "CRON_TZ=") {
+		return nil, nil, fmt.Errorf("invalid schedule %q: use time_zone instead of a TZ prefix", schedule)
+	}
+	sched, err := CronParser.Parse(schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %q: %w", schedule, err)
+	}
+	return sched, loc, nil
+}
+
+// ValidateCronSchedule checks the scheduling options of a cron job.
+func ValidateCronSchedule(schedule, timeZone string, jitterSeconds int32) error {
+	if _, _, err := ParseCronSchedule(schedule, timeZone); err != nil {
+		return err
+	}
+	return validateCronJitter(jitterSeconds)
+}
+
+func validateCronJitter(jitterSeconds int32) error {
+	if jitterSeconds < 0 || jitterSeconds > MaxCronJitterSeconds {
+		return fmt.Errorf("jitter_seconds must be between 0 and %d", MaxCronJitterSeconds)
+	}
+	return nil
+}
+
+// CronCatchUpString converts a catch-up policy into its stored form ('SKIP', 'ONCE', 'ALL').
+func CronCatchUpString(c pb.CronCatchUp) string {
+	if c == pb.CronCatchUp_CRON_CATCH_UP_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(c.String(), "CRON_CATCH_UP_")
+}
+
+// ParseCronCatchUp converts a stored catch-up policy into the enum. Unknown values are unspecified.
+func ParseCronCatchUp(s string) pb.CronCatchUp {
+	return pb.CronCatchUp(pb.CronCatchUp_value["CRON_CATCH_UP_"+strings.ToUpper(s)])
+}
+
+// CronJitter returns the delay of the run scheduled at t. It is derived from the cron job and the
+// scheduled time, so every check agrees on when the run is due.
+func CronJitter(cronID string, t time.Time, jitterSeconds int32) time.Duration {
+	if jitterSeconds <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%d", cronID, t.Unix())
+	return time.Duration(h.Sum64()%uint64(jitterSeconds)) * time.Second
+}
+
+// DueCronRuns returns the times scheduled after last whose run, including jitter, is due at now.
+// Times are oldest first and evaluated in loc. After a long outage only the newest runs, within
+// maxDueCronWindow and at most maxDueCronRuns, are returned.
+func DueCronRuns(c *pb.CronJob, sched cron.Schedule, loc *time.Location, last, now time.Time) []time.Time {
+	if earliest := now.Add(-maxDueCronWindow); last.Before(earliest) {
+		last = earliest
+	}
+	var due []time.Time
+	for t := sched.Next(last.In(loc)); !t.IsZero(); t = sched.Next(t) {
+		if t.Add(CronJitter(c.Id, t, c.JitterSeconds)).After(now) {
+			break
+		}
+		due = append(due, t)
+		if len(due) > maxDueCronRuns {
+			due = due[1:]
+		}
+	}
+	return due
+}
+
+// CronRunActive reports whether the latest job triggered by a cron job is still queued or
+// processing, or any of its sessions has not finished yet.
+func (s *CronJobServer) CronRunActive(ctx context.Context, cronID string) (bool, error) {
+	var active bool
+	err := s.DB.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM (SELECT id, status FROM jobs WHERE cron_job_id = ? ORDER BY created_at DESC, id DESC LIMIT 1) j
+			WHERE j.status IN (?, ?, ?) OR EXISTS (
+				SELECT 1 FROM job_sessions js JOIN sessions s ON s.id = js.session_id
+				WHERE js.job_id = j.id AND COALESCE(s.state, '') NOT IN ('COMPLETED', 'FAILED')
+			)
+		)`, cronID, JobStatusQueued, JobStatusPending, JobStatusProcessing).Scan(&active)
+	if err != nil {
+		return false, fmt.Errorf("failed to check previous run of cron job: %w", err)
+	}
+	return active, nil
+}
@@ -58,3 +58,228 @@ func TestCronJobService_CRUD(t *testing.T) {
 	list, _ = svc.ListCronJobs(ctx, &emptypb.Empty{})
 	assert.Len(t, list.CronJobs, 0)
 }
+
+func TestCronJobService_Matrix(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &CronJobServer{DB: db}
+	ctx := context.Background()
+
+	matrix := &pb.JobMatrix{Targets: []*pb.JobTarget{
+		{Repo: "org/a", Branch: "main"},
+		{Repo: "org/b", Branch: "main", Vars: map[string]string{"file": "SECURITY.md"}},
+	}}
+	created, err := svc.CreateCronJob(ctx, &pb.CreateCronJobRequest{Name: "Fan out", Schedule: "0 * * * *", Prompt: "Add {{file}}", SessionCount: 1, Matrix: matrix})
+	assert.NoError(t, err)
+	assert.Equal(t, "org/a", created.Repo)
+
+	list, err := svc.ListCronJobs(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, list.CronJobs[0].Matrix.GetTargets(), 2)
+	assert.Equal(t, "SECURITY.md", list.CronJobs[0].Matrix.Targets[1].Vars["file"])
+
+	_, err = svc.ExecuteCronJob(ctx, &pb.ExecuteCronJobRequest{Id: created.Id})
+	assert.NoError(t, err)
+	var stored string
+	assert.NoError(t, db.QueryRow("SELECT matrix FROM jobs WHERE cron_job_id = ?", created.Id).Scan(&stored))
+	assert.Contains(t, stored, "org/b")
+
+	// Invalid targets are rejected, an empty matrix removes it
+	_, err = svc.UpdateCronJob(ctx, &pb.UpdateCronJobRequest{Id: created.Id, Matrix: &pb.JobMatrix{Targets: []*pb.JobTarget{{Repo: "bad repo", Branch: "main"}}}})
+	assert.Error(t, err)
+	_, err = svc.UpdateCronJob(ctx, &pb.UpdateCronJobRequest{Id: created.Id, Matrix: &pb.JobMatrix{}})
+	assert.NoError(t, err)
+	list, err = svc.ListCronJobs(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Nil(t, list.CronJobs[0].Matrix)
+}
+
+func TestCronJobService_Priority(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &CronJobServer{DB: db}
+	jobs := &JobServer{DB: db}
+	ctx := context.Background()
+
+	created, err := svc.CreateCronJob(ctx, &pb.CreateCronJobRequest{Name: "Nightly", Schedule: "0 3 * * *", Prompt: "p", Repo: "user/repo", Branch: "main", Priority: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), created.Priority)
+
+	priority := int32(7)
+	_, err = svc.UpdateCronJob(ctx, &pb.UpdateCronJobRequest{Id: created.Id, Priority: &priority})
+	assert.NoError(t, err)
+	list, err := svc.ListCronJobs(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), list.CronJobs[0].Priority)
+
+	// Triggered jobs inherit the priority
+	_, err = svc.ExecuteCronJob(ctx, &pb.ExecuteCronJobRequest{Id: created.Id})
+	assert.NoError(t, err)
+	triggered, err := jobs.ListJobs(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, triggered.Jobs, 1)
+	assert.Equal(t, int32(7), triggered.Jobs[0].Priority)
+}
+
+func TestCronJobService_ScheduleValidation(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &CronJobServer{DB: db}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *pb.CreateCronJobRequest
+		err  string
+	}{
+		{"bad schedule", &pb.CreateCronJobRequest{Schedule: "every day"}, "invalid schedule"},
+		{"six fields", &pb.CreateCronJobRequest{Schedule: "0 0 9 * * *"}, "invalid schedule"},
+		{"tz prefix", &pb.CreateCronJobRequest{Schedule: "CRON_TZ=UTC 0 9 * * *"}, "time_zone"},
+		{"bad time zone", &pb.CreateCronJobRequest{Schedule: "0 9 * * *", TimeZone: "Mars/Base"}, "invalid time zone"},
+		{"negative jitter", &pb.CreateCronJobRequest{Schedule: "0 9 * * *", JitterSeconds: -1}, "jitter_seconds"},
+		{"jitter too large", &pb.CreateCronJobRequest{Schedule: "0 9 * * *", JitterSeconds: MaxCronJitterSeconds + 1}, "jitter_seconds"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateCronJob(ctx, tt.req)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	created, err := svc.CreateCronJob(ctx, &pb.CreateCronJobRequest{
+		Name: "Standup", Schedule: "0 9 * * 1-5", Prompt: "p", Repo: "user/repo", Branch: "main",
+		TimeZone: "Europe/Berlin", CatchUp: pb.CronCatchUp_CRON_CATCH_UP_SKIP, JitterSeconds: 300, SkipIfRunning: true,
+	})
+	assert.NoError(t, err)
+
+	list, err := svc.ListCronJobs(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", list.CronJobs[0].TimeZone)
+	assert.Equal(t, pb.CronCatchUp_CRON_CATCH_UP_SKIP, list.CronJobs[0].CatchUp)
+	assert.Equal(t, int32(300), list.CronJobs[0].JitterSeconds)
+	assert.True(t, list.CronJobs[0].SkipIfRunning)
+
+	// The stored schedule is validated against a new time zone and vice versa
+	badZone := "Nowhere"
+	_, err = svc.UpdateCronJob(ctx, &pb.UpdateCronJobRequest{Id: created.Id, TimeZone: &badZone})
+	assert.Error(t, err)
+	badSchedule := "61 * * * *"
+	_, err = svc.UpdateCronJob(ctx, &pb.UpdateCronJobRequest{Id: created.Id, Schedule: &badSchedule})
+	assert.Error(t, err)
+
+	zone, catchUp := "UTC", pb.CronCatchUp_CRON_CATCH_UP_ALL
+	_, err = svc.UpdateCronJob(ctx, &pb.UpdateCronJobRequest{Id: created.Id, TimeZone: &zone, CatchUp: &catchUp})
+	assert.NoError(t, err)
+	list, err = svc.ListCronJobs(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, "UTC", list.CronJobs[0].TimeZone)
+	assert.Equal(t, pb.CronCatchUp_CRON_CATCH_UP_ALL, list.CronJobs[0].CatchUp)
+}
+
+func TestDueCronRuns(t *testing.T) {
+	sched, loc, err := ParseCronSchedule("0 9 * * *", "America/New_York")
+	assert.NoError(t, err)
+
+	c := &pb.CronJob{Id: "cron-1"}
+	last := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 1, 3, 15, 0, 0, 0, time.UTC)
+	due := DueCronRuns(c, sched, loc, last, now)
+	assert.Len(t, due, 3)
+	for _, d := range due {
+		// 9:00 in New York is 14:00 UTC in winter
+		assert.Equal(t, 14, d.UTC().Hour())
+	}
+
+	// Jitter delays each run by a stable amount below the maximum
+	c.JitterSeconds = 600
+	latest := due[len(due)-1]
+	jitter := CronJitter(c.Id, latest, c.JitterSeconds)
+	assert.Equal(t, jitter, CronJitter(c.Id, latest, c.JitterSeconds))
+	assert.Less(t, jitter, 600*time.Second)
+	assert.Len(t, DueCronRuns(c, sched, loc, last, latest.Add(jitter).Add(-time.Second)), 2)
+	assert.Len(t, DueCronRuns(c, sched, loc, last, latest.Add(jitter)), 3)
+
+	// After a long outage, the newest runs are due
+	sched, loc, err = ParseCronSchedule("* * * * *", "UTC")
+	assert.NoError(t, err)
+	c.JitterSeconds = 0
+	now = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	due = DueCronRuns(c, sched, loc, last, now)
+	assert.Len(t, due, maxDueCronRuns)
+	assert.Equal(t, now, due[len(due)-1])
+}
+
+func TestCronJobService_Runs(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &CronJobServer{DB: db}
+	jobs := &JobServer{DB: db}
+	ctx := context.Background()
+
+	created, err := svc.CreateCronJob(ctx, &pb.CreateCronJobRequest{Name: "Nightly", Schedule: "0 3 * * *", Prompt: "p", Repo: "user/repo", Branch: "main", SessionCount: 2})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.RecordCronRun(ctx, &pb.CronRun{CronJobId: created.Id, Trigger: pb.CronTrigger_CRON_TRIGGER_SCHEDULED, ScheduledAt: "2026-01-01T03:00:00Z", TriggeredAt: "2026-01-01T03:00:00Z", Status: CronRunSkipped, Message: "previous run is still active"}))
+
+	_, err = svc.ExecuteCronJob(ctx, &pb.ExecuteCronJobRequest{Id: created.Id})
+	assert.NoError(t, err)
+
+	resp, err := svc.ListCronRuns(ctx, &pb.ListCronRunsRequest{CronJobId: created.Id})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Runs, 2)
+	manual := resp.Runs[0]
+	assert.Equal(t, pb.CronTrigger_CRON_TRIGGER_MANUAL, manual.Trigger)
+	assert.Equal(t, CronRunStarted, manual.Status)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_PENDING, manual.JobStatus)
+	assert.NotEmpty(t, manual.JobId)
+	assert.Equal(t, CronRunSkipped, resp.Runs[1].Status)
+	assert.Equal(t, "previous run is still active", resp.Runs[1].Message)
+	assert.Empty(t, resp.Runs[1].JobId)
+
+	// The job's sessions open two PRs, one of them merged
+	job, err := jobs.GetJob(ctx, &pb.GetJobRequest{Id: manual.JobId})
+	assert.NoError(t, err)
+	assert.NoError(t, jobs.TransitionJob(ctx, job.Id, pb.JobStatus_JOB_STATUS_PROCESSING))
+	assert.NoError(t, jobs.InitJobSessions(ctx, job))
+	for i, id := range []string{"s1", "s2"} {
+		assert.NoError(t, jobs.UpdateJobSession(ctx, job.Id, &pb.JobSessionSlot{SlotIndex: int32(i), SessionId: id, Status: SlotStatusCreated, Attempts: 1}))
+		_, err = db.Exec("INSERT INTO sessions (id, name, state, pr_url, is_pr_merged) VALUES (?, ?, 'COMPLETED', ?, ?)", id, "sessions/"+id, "https://github.com/user/repo/pull/"+id, i == 0)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, jobs.TransitionJob(ctx, job.Id, pb.JobStatus_JOB_STATUS_COMPLETED))
+
+	resp, err = svc.ListCronRuns(ctx, &pb.ListCronRunsRequest{CronJobId: created.Id, Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Runs, 1)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_COMPLETED, resp.Runs[0].JobStatus)
+	assert.Equal(t, []string{"https://github.com/user/repo/pull/s1", "https://github.com/user/repo/pull/s2"}, resp.Runs[0].PrUrls)
+	assert.Equal(t, int32(1), resp.Runs[0].PrsMerged)
+
+	// Deleting the cron job removes its history
+	_, err = svc.DeleteCronJob(ctx, &pb.DeleteCronJobRequest{Id: created.Id})
+	assert.NoError(t, err)
+	resp, err = svc.ListCronRuns(ctx, &pb.ListCronRunsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Runs)
+}
+
+func TestCronJobService_PreviewSchedule(t *testing.T) {
+	svc := &CronJobServer{}
+	ctx := context.Background()
+
+	resp, err := svc.PreviewCronSchedule(ctx, &pb.PreviewCronScheduleRequest{Schedule: "30 9 * * 1-5", TimeZone: "Asia/Tokyo", Count: 3, After: "2026-01-02T00:00:00Z"})
+	assert.NoError(t, err)
+	// Midnight UTC is 9:00 in Tokyo: Friday's run is still ahead, then the weekend is skipped
+	assert.Equal(t, []string{"2026-01-02T09:30:00+09:00", "2026-01-05T09:30:00+09:00", "2026-01-06T09:30:00+09:00"}, resp.Times)
+
+	resp, err = svc.PreviewCronSchedule(ctx, &pb.PreviewCronScheduleRequest{Schedule: "@hourly"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Times, defaultPreviewCount)
+
+	_, err = svc.PreviewCronSchedule(ctx, &pb.PreviewCronScheduleRequest{Schedule: "nope"})
+	assert.Error(t, err)
+	_, err = svc.PreviewCronSchedule(ctx, &pb.PreviewCronScheduleRequest{Schedule: "@hourly", Count: maxPreviewCount + 1})
+	assert.Error(t, err)
+	_, err = svc.PreviewCronSchedule(ctx, &pb.PreviewCronScheduleRequest{Schedule: "@hourly", After: "yesterday"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	pb "github.com/mcpany/jules/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultTriggerBranch = "main"
+	defaultTriggerLabel  = "jules"
+	// maxEventVarLength truncates long event variables (issue bodies, release notes) in prompts.
+	maxEventVarLength = 10000
+)
+
+const eventTriggerColumns = `id, name, event, repo, branch, label, prompt, auto_approval, automation_mode,
+	require_plan_approval, session_count, profile_id, enabled, priority, created_at, updated_at, last_event_at`
+
+type EventTriggerServer struct {
+	pb.UnimplementedEventTriggerServiceServer
+	DB   *sql.DB
+	Jobs *JobServer
+}
+
+// Event is a GitHub event that may start the job of a trigger.
+type Event struct {
+	Type    pb.TriggerEvent
+	Key     string // Identifies the event per trigger, e.g. "issue/12"
+	Summary string // Short description, used in the job name
+	URL     string
+	Branch  string            // Branch the job starts from, the trigger's branch when empty
+	Vars    map[string]string // Substituted into the trigger's prompt
+}
+
+// TriggerEventString converts an event type into its stored form ('ISSUE_LABELED', ...).
+func TriggerEventString(e pb.TriggerEvent) string {
+	if e == pb.TriggerEvent_TRIGGER_EVENT_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(e.String(), "TRIGGER_EVENT_")
+}
+
+// ParseTriggerEvent converts a stored event type into the enum. Unknown values are unspecified.
+func ParseTriggerEvent(s string) pb.TriggerEvent {
+	return pb.TriggerEvent(pb.TriggerEvent_value["TRIGGER_EVENT_"+strings.ToUpper(s)])
+}
+
+func (s *EventTriggerServer) ListEventTriggers(ctx context.Context, _ *emptypb.Empty) (*pb.ListEventTriggersResponse, error) {
+	triggers, err := s.queryEventTriggers(ctx, "SELECT "+eventTriggerColumns+" FROM event_triggers ORDER BY created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListEventTriggersResponse{Triggers: triggers}, nil
+}
+
+// EnabledEventTriggers returns the triggers whose events should be watched.
+func (s *EventTriggerServer) EnabledEventTriggers(ctx context.Context) ([]*pb.EventTrigger, error) {
+	return s.queryEventTriggers(ctx, "SELECT "+eventTriggerColumns+" FROM event_triggers WHERE enabled = 1 ORDER BY created_at")
+}
+
+func (s *EventTriggerServer) CreateEventTrigger(ctx context.Context, req *pb.CreateEventTriggerRequest) (*pb.EventTrigger, error) {
+	t := &pb.EventTrigger{
+		Id:                  req.Id,
+		Name:                req.Name,
+		Event:               req.Event,
+		Repo:                req.Repo,
+		Branch:              req.Branch,
+		Label:               req.Label,
+		Prompt:              req.Prompt,
+		AutoApproval:        req.AutoApproval,
+		AutomationMode:      req.AutomationMode,
+		RequirePlanApproval: req.RequirePlanApproval,
+		SessionCount:        req.SessionCount,
+		ProfileId:           req.ProfileId,
+		Enabled:             true,
+		Priority:            req.Priority,
+		CreatedAt:           time.Now().Format(time.RFC3339),
+	}
+	if t.Id == "" {
+		t.Id = uuid.New().String()
+	}
+	if t.ProfileId == "" {
+		t.ProfileId = "default"
+	}
+	applyTriggerDefaults(t)
+	if err := validateEventTrigger(t); err != nil {
+		return nil, err
+	}
+
+	_, err := s.DB.ExecContext(ctx, `
+		INSERT INTO event_triggers (`+eventTriggerColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NULL, NULL)`,
+		t.Id, t.Name, TriggerEventString(t.Event), t.Repo, t.Branch, t.Label, t.Prompt, t.AutoApproval, automationModeString(t.AutomationMode),
+		t.RequirePlanApproval, t.SessionCount, t.ProfileId, t.Enabled, t.Priority, t.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event trigger: %w", err)
+	}
+	return t, nil
+}
+
+func (s *EventTriggerServer) UpdateEventTrigger(ctx context.Context, req *pb.UpdateEventTriggerRequest) (*pb.EventTrigger, error) {
+	t, err := s.getEventTrigger(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil {
+		t.Name = *req.Name
+	}
+	if req.Repo != nil {
+		t.Repo = *req.Repo
+	}
+	if req.Branch != nil {
+		t.Branch = *req.Branch
+	}
+	if req.Label != nil {
+		t.Label = *req.Label
+	}
+	if req.Prompt != nil {
+		t.Prompt = *req.Prompt
+	}
+	if req.AutoApproval != nil {
+		t.AutoApproval = *req.AutoApproval
+	}
+	if req.AutomationMode != nil {
+		t.AutomationMode = *req.AutomationMode
+	}
+	if req.RequirePlanApproval != nil {
+		t.RequirePlanApproval = *req.RequirePlanApproval
+	}
+	if req.SessionCount != nil {
+		t.SessionCount = *req.SessionCount
+	}
+	if req.Enabled != nil {
+		t.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		t.Priority = *req.Priority
+	}
+	applyTriggerDefaults(t)
+	if err := validateEventTrigger(t); err != nil {
+		return nil, err
+	}
+	t.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	_, err = s.DB.ExecContext(ctx, `
+		UPDATE event_triggers SET name = ?, repo = ?, branch = ?, label = ?, prompt = ?, auto_approval = ?, automation_mode = ?,
+			require_plan_approval = ?, session_count = ?, enabled = ?, priority = ?, updated_at = ?
+		WHERE id = ?`,
+		t.Name, t.Repo, t.Branch, t.Label, t.Prompt, t.AutoApproval, automationModeString(t.AutomationMode),
+		t.RequirePlanApproval, t.SessionCount, t.Enabled, t.Priority, t.UpdatedAt, t.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update event trigger: %w", err)
+	}
+	return t, nil
+}
+
+func (s *EventTriggerServer) DeleteEventTrigger(ctx context.Context, req *pb.DeleteEventTriggerRequest) (*emptypb.Empty, error) {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM triggered_events WHERE trigger_id = ?", req.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete triggered events: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM event_triggers WHERE id = ?", req.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete event trigger: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *EventTriggerServer) ListTriggeredEvents(ctx context.Context, req *pb.ListTriggeredEventsRequest) (*pb.ListTriggeredEventsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT trigger_id, event_key, summary, url, job_id, created_at FROM triggered_events
+		WHERE trigger_id = ? ORDER BY created_at DESC, event_key DESC LIMIT ?`, req.TriggerId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list triggered events: %w", err)
+	}
+	defer rows.Close()
+
+	resp := &pb.ListTriggeredEventsResponse{}
+	for rows.Next() {
+		var e pb.TriggeredEvent
+		var url, jobID sql.NullString
+		if err := rows.Scan(&e.TriggerId, &e.EventKey, &e.Summary, &url, &jobID, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan triggered event: %w", err)
+		}
+		e.Url, e.JobId = url.String, jobID.String
+		resp.Events = append(resp.Events, &e)
+	}
+	return resp, rows.Err()
+}
+
+// Fire starts the trigger's job for an event. Each event starts at most one job per trigger:
+// nil is returned for events that were already handled. If the job cannot be created the event
+// is forgotten, so it is retried the next time it is seen.
+func (s *EventTriggerServer) Fire(ctx context.Context, t *pb.EventTrigger, ev Event) (*pb.Job, error) {
+	if ev.Type != t.Event {
+		return nil, fmt.Errorf("event %s does not match trigger %s", TriggerEventString(ev.Type), TriggerEventString(t.Event))
+	}
+	now := time.Now().Format(time.RFC3339)
+	res, err := s.DB.ExecContext(ctx, `
+		INSERT OR IGNORE INTO triggered_events (trigger_id, event_key, summary, url, created_at) VALUES (?, ?, ?, ?, ?)`,
+		t.Id, ev.Key, ev.Summary, ev.URL, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record triggered event: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, nil
+	}
+
+	branch := ev.Branch
+	if branch == "" {
+		branch = t.Branch
+	}
+	vars := map[string]string{"repo": t.Repo, "branch": branch, "url": ev.URL}
+	for k, v := range ev.Vars {
+		vars[k] = TruncateString(v, maxEventVarLength)
+	}
+
+	job, err := s.Jobs.CreateJob(ctx, &pb.CreateJobRequest{
+		Name:                TruncateString(t.Name+" / "+ev.Summary, 255),
+		Repo:                t.Repo,
+		Branch:              branch,
+		Prompt:              RenderPrompt(t.Prompt, vars),
+		AutoApproval:        t.AutoApproval,
+		AutomationMode:      t.AutomationMode,
+		RequirePlanApproval: t.RequirePlanApproval,
+		SessionCount:        t.SessionCount,
+		ProfileId:           t.ProfileId,
+		Priority:            t.Priority,
+		Background:          true,
+		Status:              JobStatusPending,
+	})
+	if err != nil {
+		_, _ = s.DB.ExecContext(ctx, "DELETE FROM triggered_events WHERE trigger_id = ? AND event_key = ?", t.Id, ev.Key)
+		return nil, err
+	}
+
+	if _, err := s.DB.ExecContext(ctx, "UPDATE triggered_events SET job_id = ? WHERE trigger_id = ? AND event_key = ?", job.Id, t.Id, ev.Key); err != nil {
+		return nil, fmt.Errorf("failed to record triggered job: %w", err)
+	}
+	if _, err := s.DB.ExecContext(ctx, "UPDATE event_triggers SET last_event_at = ? WHERE id = ?", now, t.Id); err != nil {
+		return nil, fmt.Errorf("failed to update event trigger: %w", err)
+	}
+	return job, nil
+}
+
+func (s *EventTriggerServer) getEventTrigger(ctx context.Context, id string) (*pb.EventTrigger, error) {
+	triggers, err := s.queryEventTriggers(ctx, "SELECT "+eventTriggerColumns+" FROM event_triggers WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(triggers) == 0 {
+		return nil, fmt.Errorf("event trigger not found: %s", id)
+	}
+	return triggers[0], nil
+}
+
+func (s *EventTriggerServer) queryEventTriggers(ctx context.Context, query string, args ...interface{}) ([]*pb.EventTrigger, error) {
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event triggers: %w", err)
+	}
+	defer rows.Close()
+
+	var triggers []*pb.EventTrigger
+	for rows.Next() {
+		var t pb.EventTrigger
+		var event string
+		var label, automationMode, updatedAt, lastEventAt sql.NullString
+		var requirePlanApproval sql.NullBool
+		var sessionCount sql.NullInt32
+		if err := rows.Scan(&t.Id, &t.Name, &event, &t.Repo, &t.Branch, &label, &t.Prompt, &t.AutoApproval, &automationMode,
+			&requirePlanApproval, &sessionCount, &t.ProfileId, &t.Enabled, &t.Priority, &t.CreatedAt, &updatedAt, &lastEventAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event trigger: %w", err)
+		}
+		t.Event = ParseTriggerEvent(event)
+		t.Label = label.String
+		if automationMode.String == "AUTO_CREATE_PR" {
+			t.AutomationMode = pb.AutomationMode_AUTO_CREATE_PR
+		}
+		t.RequirePlanApproval = requirePlanApproval.Bool
+		t.SessionCount = sessionCount.Int32
+		t.UpdatedAt = updatedAt.String
+		t.LastEventAt = lastEventAt.String
+		triggers = append(triggers, &t)
+	}
+	return triggers, rows.Err()
+}
+
+func applyTriggerDefaults(t *pb.EventTrigger) {
+	if t.Branch == "" {
+		t.Branch = defaultTriggerBranch
+	}
+	if t.Event == pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED && t.Label == "" {
+		t.Label = defaultTriggerLabel
+	}
+}
+
+func validateEventTrigger(t *pb.EventTrigger) error {
+	if t.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(t.Name) > 255 {
+		return fmt.Errorf("name is too long (max 255 characters)")
+	}
+	if _, ok := pb.TriggerEvent_name[int32(t.Event)]; !ok || t.Event == pb.TriggerEvent_TRIGGER_EVENT_UNSPECIFIED {
+		return fmt.Errorf("event is required")
+	}
+	if t.Repo == "" {
+		return fmt.Errorf("repo is required")
+	}
+	if err := ValidateRepo(t.Repo); err != nil {
+		return err
+	}
+	if err := ValidateBranch(t.Branch); err != nil {
+		return err
+	}
+	if len(t.Label) > 100 {
+		return fmt.Errorf("label is too long (max 100 characters)")
+	}
+	if t.Prompt == "" {
+		return fmt.Errorf("prompt is required")
+	}
+	if len(t.Prompt) > 50000 {
+		return fmt.Errorf("prompt is too long (max 50000 characters)")
+	}
+	return nil
+}
+
+func automationModeString(m pb.AutomationMode) string {
+	if m == pb.AutomationMode_AUTO_CREATE_PR {
+		return "AUTO_CREATE_PR"
+	}
+	return "AUTOMATION_MODE_UNSPECIFIED"
+}
+
+// TruncateString shortens s to at most n bytes without splitting a character.
+func TruncateString(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
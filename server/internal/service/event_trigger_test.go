@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestEventTriggerService_CRUD(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &EventTriggerServer{DB: db, Jobs: &JobServer{DB: db}}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *pb.CreateEventTriggerRequest
+		err  string
+	}{
+		{"no name", &pb.CreateEventTriggerRequest{Event: pb.TriggerEvent_TRIGGER_EVENT_RELEASE, Repo: "o/r", Prompt: "p"}, "name is required"},
+		{"no event", &pb.CreateEventTriggerRequest{Name: "t", Repo: "o/r", Prompt: "p"}, "event is required"},
+		{"unknown event", &pb.CreateEventTriggerRequest{Name: "t", Event: 42, Repo: "o/r", Prompt: "p"}, "event is required"},
+		{"no repo", &pb.CreateEventTriggerRequest{Name: "t", Event: pb.TriggerEvent_TRIGGER_EVENT_RELEASE, Prompt: "p"}, "repo is required"},
+		{"bad repo", &pb.CreateEventTriggerRequest{Name: "t", Event: pb.TriggerEvent_TRIGGER_EVENT_RELEASE, Repo: "bad repo", Prompt: "p"}, "invalid repo"},
+		{"no prompt", &pb.CreateEventTriggerRequest{Name: "t", Event: pb.TriggerEvent_TRIGGER_EVENT_RELEASE, Repo: "o/r"}, "prompt is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateEventTrigger(ctx, tt.req)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	created, err := svc.CreateEventTrigger(ctx, &pb.CreateEventTriggerRequest{Name: "Triage", Event: pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED, Repo: "o/r", Prompt: "Fix {{title}}"})
+	assert.NoError(t, err)
+	assert.Equal(t, "main", created.Branch)
+	assert.Equal(t, "jules", created.Label)
+	assert.True(t, created.Enabled)
+
+	label, enabled := "bot", false
+	updated, err := svc.UpdateEventTrigger(ctx, &pb.UpdateEventTriggerRequest{Id: created.Id, Label: &label, Enabled: &enabled})
+	assert.NoError(t, err)
+	assert.Equal(t, "bot", updated.Label)
+	assert.NotEmpty(t, updated.UpdatedAt)
+
+	list, err := svc.ListEventTriggers(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Triggers, 1)
+	assert.Equal(t, pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED, list.Triggers[0].Event)
+	assert.False(t, list.Triggers[0].Enabled)
+
+	enabledTriggers, err := svc.EnabledEventTriggers(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, enabledTriggers)
+
+	_, err = svc.UpdateEventTrigger(ctx, &pb.UpdateEventTriggerRequest{Id: "missing"})
+	assert.Error(t, err)
+
+	_, err = svc.DeleteEventTrigger(ctx, &pb.DeleteEventTriggerRequest{Id: created.Id})
+	assert.NoError(t, err)
+	list, err = svc.ListEventTriggers(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Triggers)
+}
+
+func TestEventTriggerService_Fire(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	jobs := &JobServer{DB: db}
+	svc := &EventTriggerServer{DB: db, Jobs: jobs}
+	ctx := context.Background()
+
+	trigger, err := svc.CreateEventTrigger(ctx, &pb.CreateEventTriggerRequest{
+		Name: "Triage", Event: pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED, Repo: "o/r", Priority: 3, SessionCount: 2,
+		Prompt: "Fix issue #{{number}} in {{repo}}@{{branch}}: {{title}}\n\n{{body}}\n{{unknown}}",
+	})
+	assert.NoError(t, err)
+
+	ev := Event{
+		Type:    pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED,
+		Key:     "issue/12",
+		Summary: "#12 Login fails",
+		URL:     "https://github.com/o/r/issues/12",
+		Vars:    map[string]string{"number": "12", "title": "Login fails", "body": strings.Repeat("x", maxEventVarLength+10)},
+	}
+	job, err := svc.Fire(ctx, trigger, ev)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.Equal(t, "Triage / #12 Login fails", job.Name)
+	assert.Equal(t, "main", job.Branch)
+	assert.Equal(t, int32(3), job.Priority)
+	assert.Equal(t, int32(2), job.SessionCount)
+	assert.True(t, job.Background)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_PENDING, job.State)
+	assert.True(t, strings.HasPrefix(job.Prompt, "Fix issue #12 in o/r@main: Login fails\n\n"))
+	// Long bodies are truncated, unknown placeholders are kept
+	assert.True(t, strings.HasSuffix(job.Prompt, "\n\n"+strings.Repeat("x", maxEventVarLength)+"\n{{unknown}}"))
+
+	// The same event does not start a second job
+	again, err := svc.Fire(ctx, trigger, ev)
+	assert.NoError(t, err)
+	assert.Nil(t, again)
+
+	// Events of another type are rejected
+	_, err = svc.Fire(ctx, trigger, Event{Type: pb.TriggerEvent_TRIGGER_EVENT_RELEASE, Key: "release/v1"})
+	assert.Error(t, err)
+
+	// A job that cannot be created is retried the next time the event is seen
+	bad := Event{Type: pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED, Key: "issue/13", Summary: "#13", Branch: "bad..branch"}
+	_, err = svc.Fire(ctx, trigger, bad)
+	assert.Error(t, err)
+
+	events, err := svc.ListTriggeredEvents(ctx, &pb.ListTriggeredEventsRequest{TriggerId: trigger.Id})
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 1)
+	assert.Equal(t, "issue/12", events.Events[0].EventKey)
+	assert.Equal(t, job.Id, events.Events[0].JobId)
+	assert.Equal(t, ev.URL, events.Events[0].Url)
+
+	list, err := svc.ListEventTriggers(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, list.Triggers[0].LastEventAt)
+}
@@ -16,6 +16,8 @@ import (
 type JobServer struct {
 	pb.UnimplementedJobServiceServer
 	DB *sql.DB
+	// Sessions is used to tear down remote sessions on cancel/delete. Optional.
+	Sessions *SessionServer
 }
 
 func (s *JobServer) ListJobs(ctx context.Context, _ *emptypb.Empty) (*pb.ListJobsResponse, error) {
@@ -23,7 +25,7 @@ func (s *JobServer) ListJobs(ctx context.Context, _ *emptypb.Empty) (*pb.ListJob
 	rows, err := s.DB.Query(`
         SELECT id, name, session_ids, created_at, repo, branch, auto_approval, 
                background, prompt, session_count, status, automation_mode, 
-               require_plan_approval, cron_job_id, profile_id, chat_enabled
+               require_plan_approval, cron_job_id, profile_id, chat_enabled, parent_job_id, matrix, priority
         FROM jobs 
         ORDER BY created_at DESC
     `)
@@ -45,12 +47,14 @@ func (s *JobServer) ListJobs(ctx context.Context, _ *emptypb.Empty) (*pb.ListJob
 			sessionCount        sql.NullInt64
 			status              sql.NullString
 			chatEnabled         sql.NullBool
+			parentJobId         sql.NullString
+			matrix              sql.NullString
 		)
 
 		if err := rows.Scan(
 			&j.Id, &j.Name, &sessionIdsJSON, &j.CreatedAt, &j.Repo, &j.Branch, &j.AutoApproval,
 			&j.Background, &prompt, &sessionCount, &status, &automationMode,
-			&requirePlanApproval, &cronJobId, &profileId, &chatEnabled,
+			&requirePlanApproval, &cronJobId, &profileId, &chatEnabled, &parentJobId, &matrix, &j.Priority,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan job: %w", err)
 		}
@@ -63,6 +67,10 @@ func (s *JobServer) ListJobs(ctx context.Context, _ *emptypb.Empty) (*pb.ListJob
 		}
 		if status.Valid {
 			j.Status = status.String
+			if state, err := ParseJobStatus(status.String); err == nil {
+				j.State = state
+				j.Status = JobStatusString(state)
+			}
 		}
 		if requirePlanApproval.Valid {
 			j.RequirePlanApproval = requirePlanApproval.Bool
@@ -76,6 +84,10 @@ func (s *JobServer) ListJobs(ctx context.Context, _ *emptypb.Empty) (*pb.ListJob
 		if chatEnabled.Valid {
 			j.ChatEnabled = chatEnabled.Bool
 		}
+		if parentJobId.Valid {
+			j.ParentJobId = parentJobId.String
+		}
+		j.Matrix = unmarshalMatrix(matrix)
 
 		if sessionIdsJSON.Valid && sessionIdsJSON.String != "" {
 			if err := json.Unmarshal([]byte(sessionIdsJSON.String), &j.SessionIds); err != nil {
@@ -114,18 +126,20 @@ func (s *JobServer) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.Job,
 		sessionCount        sql.NullInt64
 		status              sql.NullString
 		chatEnabled         sql.NullBool
+		parentJobId         sql.NullString
+		matrix              sql.NullString
 	)
 
 	err := s.DB.QueryRow(`
         SELECT id, name, session_ids, created_at, repo, branch, auto_approval, 
         background, prompt, session_count, status, automation_mode, 
-        require_plan_approval, cron_job_id, profile_id, chat_enabled
+        require_plan_approval, cron_job_id, profile_id, chat_enabled, parent_job_id, matrix, priority
         FROM jobs 
         WHERE id = ?
     `, req.Id).Scan(
 		&j.Id, &j.Name, &sessionIdsJSON, &j.CreatedAt, &j.Repo, &j.Branch, &j.AutoApproval,
 		&j.Background, &prompt, &sessionCount, &status, &automationMode,
-		&requirePlanApproval, &cronJobId, &profileId, &chatEnabled,
+		&requirePlanApproval, &cronJobId, &profileId, &chatEnabled, &parentJobId, &matrix, &j.Priority,
 	)
 
 	if err == sql.ErrNoRows {
@@ -142,6 +156,10 @@ func (s *JobServer) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.Job,
 	}
 	if status.Valid {
 		j.Status = status.String
+		if state, err := ParseJobStatus(status.String); err == nil {
+			j.State = state
+			j.Status = JobStatusString(state)
+		}
 	}
 	if requirePlanApproval.Valid {
 		j.RequirePlanApproval = requirePlanApproval.Bool
@@ -155,6 +173,10 @@ func (s *JobServer) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.Job,
 	if chatEnabled.Valid {
 		j.ChatEnabled = chatEnabled.Bool
 	}
+	if parentJobId.Valid {
+		j.ParentJobId = parentJobId.String
+	}
+	j.Matrix = unmarshalMatrix(matrix)
 
 	if sessionIdsJSON.Valid && sessionIdsJSON.String != "" {
 		if err := json.Unmarshal([]byte(sessionIdsJSON.String), &j.SessionIds); err != nil {
@@ -168,6 +190,13 @@ func (s *JobServer) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.Job,
 		j.AutomationMode = pb.AutomationMode_AUTO_CREATE_PR
 	}
 
+	slots, err := s.ListJobSessions(ctx, j.Id)
+	if err != nil {
+		return nil, err
+	}
+	j.SessionSlots = slots
+	j.TargetProgress = targetProgress(&j, slots)
+
 	return &j, nil
 }
 
@@ -181,12 +210,30 @@ func (s *JobServer) CreateJob(ctx context.Context, req *pb.CreateJobRequest) (*p
 	if len(req.Prompt) > 50000 {
 		return nil, fmt.Errorf("prompt is too long (max 50000 characters)")
 	}
+	if err := ValidateMatrix(req.Matrix); err != nil {
+		return nil, err
+	}
+	// Matrix jobs are listed under their first target unless a repo is given
+	if hasMatrix(req.Matrix) && req.Repo == "" {
+		req.Repo, req.Branch = req.Matrix.Targets[0].Repo, req.Matrix.Targets[0].Branch
+	}
 	if err := ValidateRepo(req.Repo); err != nil {
 		return nil, err
 	}
 	if err := ValidateBranch(req.Branch); err != nil {
 		return nil, err
 	}
+	state, err := ParseJobStatus(req.Status)
+	if err != nil {
+		return nil, err
+	}
+	matrix, err := marshalMatrix(req.Matrix)
+	if err != nil {
+		return nil, err
+	}
+	if state == pb.JobStatus_JOB_STATUS_QUEUED && hasMatrix(req.Matrix) {
+		return nil, fmt.Errorf("matrix jobs cannot be queued")
+	}
 
 	id := req.Id
 	if id == "" {
@@ -208,18 +255,23 @@ func (s *JobServer) CreateJob(ctx context.Context, req *pb.CreateJobRequest) (*p
 		automationModeStr = "AUTO_CREATE_PR"
 	}
 
-	_, err := s.DB.Exec(`INSERT INTO jobs (
+	_, err = s.DB.Exec(`INSERT INTO jobs (
         id, name, session_ids, created_at, repo, branch, 
         auto_approval, background, prompt, session_count, 
-        status, automation_mode, require_plan_approval, cron_job_id, profile_id, chat_enabled
-    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+        status, automation_mode, require_plan_approval, cron_job_id, profile_id, chat_enabled, parent_job_id, matrix, priority
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		id, req.GetName(), string(sessionIdsJSON), createdAt, req.GetRepo(), req.GetBranch(),
 		req.GetAutoApproval(), req.GetBackground(), req.GetPrompt(), req.GetSessionCount(),
-		req.GetStatus(), automationModeStr, req.GetRequirePlanApproval(), req.GetCronJobId(), req.GetProfileId(), req.GetChatEnabled())
+		JobStatusString(state), automationModeStr, req.GetRequirePlanApproval(), req.GetCronJobId(), req.GetProfileId(), req.GetChatEnabled(), req.GetParentJobId(), matrix, req.GetPriority())
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create job: %w", err)
 	}
+	if state == pb.JobStatus_JOB_STATUS_QUEUED {
+		if err := s.appendToQueue(ctx, id); err != nil {
+			return nil, err
+		}
+	}
 
 	// Return constructed job (ideally fetch back but lets construct)
 	return &pb.Job{
@@ -233,12 +285,16 @@ func (s *JobServer) CreateJob(ctx context.Context, req *pb.CreateJobRequest) (*p
 		Background:          req.Background,
 		Prompt:              req.Prompt,
 		SessionCount:        req.SessionCount,
-		Status:              req.Status,
+		Status:              JobStatusString(state),
+		State:               state,
 		AutomationMode:      req.AutomationMode,
 		RequirePlanApproval: req.RequirePlanApproval,
 		CronJobId:           req.CronJobId,
 		ProfileId:           req.ProfileId,
 		ChatEnabled:         req.ChatEnabled,
+		ParentJobId:         req.ParentJobId,
+		Matrix:              req.Matrix,
+		Priority:            req.Priority,
 	}, nil
 }
 
@@ -253,8 +309,8 @@ func (s *JobServer) CreateManyJobs(ctx context.Context, req *pb.CreateManyJobsRe
 		INSERT INTO jobs (
 			id, name, session_ids, created_at, repo, branch, auto_approval, 
 			background, prompt, session_count, status, automation_mode, 
-			require_plan_approval, cron_job_id, profile_id, chat_enabled
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			require_plan_approval, cron_job_id, profile_id, chat_enabled, parent_job_id, matrix, priority
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
     `)
 	if err != nil {
 		return nil, err
@@ -268,12 +324,26 @@ func (s *JobServer) CreateManyJobs(ctx context.Context, req *pb.CreateManyJobsRe
 		if len(j.Prompt) > 50000 {
 			return nil, fmt.Errorf("prompt is too long (max 50000 characters)")
 		}
+		if err := ValidateMatrix(j.Matrix); err != nil {
+			return nil, err
+		}
+		if hasMatrix(j.Matrix) && j.Repo == "" {
+			j.Repo, j.Branch = j.Matrix.Targets[0].Repo, j.Matrix.Targets[0].Branch
+		}
 		if err := ValidateRepo(j.Repo); err != nil {
 			return nil, err
 		}
 		if err := ValidateBranch(j.Branch); err != nil {
 			return nil, err
 		}
+		state, err := ParseJobStatus(j.Status)
+		if err != nil {
+			return nil, err
+		}
+		matrix, err := marshalMatrix(j.Matrix)
+		if err != nil {
+			return nil, err
+		}
 
 		sessionIdsJSON, _ := json.Marshal(j.SessionIds)
 		if j.SessionIds == nil {
@@ -295,8 +365,8 @@ func (s *JobServer) CreateManyJobs(ctx context.Context, req *pb.CreateManyJobsRe
 		}
 
 		if _, err := stmt.Exec(id, j.Name, string(sessionIdsJSON), createdAt, j.Repo, j.Branch, j.AutoApproval,
-			j.Background, j.Prompt, j.SessionCount, j.Status, automationModeStr,
-			j.RequirePlanApproval, j.CronJobId, j.ProfileId, j.ChatEnabled); err != nil {
+			j.Background, j.Prompt, j.SessionCount, JobStatusString(state), automationModeStr,
+			j.RequirePlanApproval, j.CronJobId, j.ProfileId, j.ChatEnabled, j.ParentJobId, matrix, j.Priority); err != nil {
 			return nil, err
 		}
 	}
@@ -321,9 +391,14 @@ func (s *JobServer) UpdateJob(ctx context.Context, req *pb.UpdateJobRequest) (*e
 		updates = true
 	}
 	if req.Status != nil {
-		query += "status = ?, "
-		args = append(args, *req.Status)
-		updates = true
+		// Status changes go through the state machine
+		state, err := ParseJobStatus(*req.Status)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.TransitionJob(ctx, req.Id, state); err != nil {
+			return nil, err
+		}
 	}
 	// ... handle other fields
 
@@ -344,9 +419,73 @@ func (s *JobServer) UpdateJob(ctx context.Context, req *pb.UpdateJobRequest) (*e
 }
 
 func (s *JobServer) DeleteJob(ctx context.Context, req *pb.DeleteJobRequest) (*emptypb.Empty, error) {
-	_, err := s.DB.Exec("DELETE FROM jobs WHERE id = ?", req.Id)
+	if req.DeleteRemoteSessions {
+		job, err := s.GetJob(ctx, &pb.GetJobRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		// Keep the job if any remote delete failed so the call can be retried
+		if _, failed := s.teardownSessions(job.SessionIds); len(failed) > 0 {
+			return nil, fmt.Errorf("failed to delete %d remote sessions", len(failed))
+		}
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
 	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM job_sessions WHERE job_id = ?", req.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete job sessions: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM jobs WHERE id = ?", req.Id); err != nil {
 		return nil, fmt.Errorf("failed to delete job: %w", err)
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return &emptypb.Empty{}, nil
 }
+
+// CancelJob marks the job as cancelled and deletes the remote sessions it has spawned so far.
+// BackgroundJobWorker stops creating sessions for it and the automation workers leave its sessions alone.
+// Cancelling an already cancelled job retries the remote teardown; finished jobs cannot be cancelled.
+func (s *JobServer) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.CancelJobResponse, error) {
+	job, err := s.GetJob(ctx, &pb.GetJobRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.TransitionJob(ctx, job.Id, pb.JobStatus_JOB_STATUS_CANCELLED); err != nil {
+		return nil, fmt.Errorf("failed to cancel job: %w", err)
+	}
+
+	cancelled, failed := s.teardownSessions(job.SessionIds)
+	logger.Info("CancelJob: job %s cancelled, %d sessions deleted, %d failed", job.Id, len(cancelled), len(failed))
+
+	return &pb.CancelJobResponse{CancelledSessionIds: cancelled, FailedSessionIds: failed}, nil
+}
+
+// teardownSessions deletes the given sessions from the Jules API. Sessions that could not be
+// deleted, including those that were not attempted, are reported as failed.
+func (s *JobServer) teardownSessions(sessionIDs []string) (cancelled []string, failed []string) {
+	for _, id := range sessionIDs {
+		var err error
+		switch {
+		case s.Sessions == nil:
+			err = fmt.Errorf("no session service")
+		case !isValidSessionID(id):
+			err = fmt.Errorf("invalid session id")
+		default:
+			err = s.Sessions.deleteRemoteSession(id)
+		}
+		if err != nil {
+			logger.Error("Failed to delete remote session %s: %v", id, err)
+			failed = append(failed, id)
+			continue
+		}
+		cancelled = append(cancelled, id)
+	}
+	return cancelled, failed
+}
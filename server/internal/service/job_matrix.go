@@ -0,0 +1,157 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+
+	pb "github.com/mcpany/jules/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxMatrixTargets bounds the fan-out of a single job.
+const maxMatrixTargets = 100
+
+var promptVarPattern = regexp.MustCompile(`\{\{\s*\.?([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// RenderPrompt substitutes {{name}} (or {{ .name }}) placeholders with the given variables.
+// Unknown placeholders are left as they are, so prompts quoting e.g. GitHub Actions expressions are not broken.
+func RenderPrompt(prompt string, vars map[string]string) string {
+	if len(vars) == 0 {
+		return prompt
+	}
+	return promptVarPattern.ReplaceAllStringFunc(prompt, func(m string) string {
+		name := promptVarPattern.FindStringSubmatch(m)[1]
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+// ValidateMatrix checks the targets of a matrix job.
+func ValidateMatrix(m *pb.JobMatrix) error {
+	if m == nil {
+		return nil
+	}
+	if len(m.Targets) > maxMatrixTargets {
+		return fmt.Errorf("matrix has too many targets (max %d)", maxMatrixTargets)
+	}
+	for i, t := range m.Targets {
+		if err := ValidateRepo(t.Repo); err != nil {
+			return fmt.Errorf("matrix target %d: %w", i, err)
+		}
+		if err := ValidateBranch(t.Branch); err != nil {
+			return fmt.Errorf("matrix target %d: %w", i, err)
+		}
+		if t.SessionCount < 0 {
+			return fmt.Errorf("matrix target %d: session count must not be negative", i)
+		}
+		for k := range t.Vars {
+			if !promptVarPattern.MatchString("{{" + k + "}}") {
+				return fmt.Errorf("matrix target %d: invalid variable name: %s", i, k)
+			}
+		}
+	}
+	return nil
+}
+
+// hasMatrix reports whether the matrix fans out over at least one target.
+func hasMatrix(m *pb.JobMatrix) bool {
+	return m != nil && len(m.Targets) > 0
+}
+
+// marshalMatrix returns the stored form of a matrix, NULL when it has no targets.
+func marshalMatrix(m *pb.JobMatrix) (sql.NullString, error) {
+	if !hasMatrix(m) {
+		return sql.NullString{}, nil
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode matrix: %w", err)
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+// unmarshalMatrix decodes a stored matrix. Invalid values are treated as no matrix.
+func unmarshalMatrix(s sql.NullString) *pb.JobMatrix {
+	if !s.Valid || s.String == "" {
+		return nil
+	}
+	var m pb.JobMatrix
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(s.String), &m); err != nil {
+		return nil
+	}
+	return &m
+}
+
+// JobTargetFor returns the repo, branch and rendered prompt of a matrix target.
+// Jobs without a matrix have a single target made of their own repo and branch.
+func JobTargetFor(job *pb.Job, targetIndex int32) (repo, branch, prompt string) {
+	if !hasMatrix(job.Matrix) || targetIndex < 0 || int(targetIndex) >= len(job.Matrix.Targets) {
+		return job.Repo, job.Branch, job.Prompt
+	}
+	t := job.Matrix.Targets[targetIndex]
+	vars := map[string]string{"repo": t.Repo, "branch": t.Branch}
+	for k, v := range t.Vars {
+		vars[k] = v
+	}
+	return t.Repo, t.Branch, RenderPrompt(job.Prompt, vars)
+}
+
+// slotTargets returns the matrix target of each session slot of a job, in slot order.
+func slotTargets(job *pb.Job) []int32 {
+	defaultCount := job.SessionCount
+	if defaultCount < 1 {
+		defaultCount = 1
+	}
+
+	if !hasMatrix(job.Matrix) {
+		// Sessions already recorded in session_ids (jobs from before slots existed) get a slot each
+		count := int(job.SessionCount)
+		if len(job.SessionIds) > count {
+			count = len(job.SessionIds)
+		}
+		return make([]int32, count)
+	}
+
+	var targets []int32
+	for i, t := range job.Matrix.Targets {
+		n := t.SessionCount
+		if n < 1 {
+			n = defaultCount
+		}
+		for j := int32(0); j < n; j++ {
+			targets = append(targets, int32(i))
+		}
+	}
+	return targets
+}
+
+// targetProgress aggregates the session slots of a matrix job per target.
+func targetProgress(job *pb.Job, slots []*pb.JobSessionSlot) []*pb.JobTargetProgress {
+	if !hasMatrix(job.Matrix) {
+		return nil
+	}
+	progress := make([]*pb.JobTargetProgress, len(job.Matrix.Targets))
+	for i, t := range job.Matrix.Targets {
+		progress[i] = &pb.JobTargetProgress{TargetIndex: int32(i), Repo: t.Repo, Branch: t.Branch}
+	}
+	for _, slot := range slots {
+		if slot.TargetIndex < 0 || int(slot.TargetIndex) >= len(progress) {
+			continue
+		}
+		p := progress[slot.TargetIndex]
+		p.Total++
+		switch slot.Status {
+		case SlotStatusCreated:
+			p.Created++
+			p.SessionIds = append(p.SessionIds, slot.SessionId)
+		case SlotStatusFailed:
+			p.Failed++
+		default:
+			p.Pending++
+		}
+	}
+	return progress
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/mcpany/jules/proto"
+)
+
+// RerunJob creates a new job cloned from an existing one, applying any overrides from the request.
+// The new job is queued for BackgroundJobWorker and links back to the original through parent_job_id.
+func (s *JobServer) RerunJob(ctx context.Context, req *pb.RerunJobRequest) (*pb.Job, error) {
+	parent, err := s.GetJob(ctx, &pb.GetJobRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	clone := rerunRequest(parent)
+	if req.Name != nil {
+		clone.Name = req.GetName()
+	}
+	if req.Prompt != nil {
+		clone.Prompt = req.GetPrompt()
+	}
+	if req.Repo != nil {
+		clone.Repo = req.GetRepo()
+	}
+	if req.Branch != nil {
+		clone.Branch = req.GetBranch()
+	}
+	if req.SessionCount != nil {
+		if req.GetSessionCount() < 1 {
+			return nil, fmt.Errorf("session count must be at least 1")
+		}
+		clone.SessionCount = req.GetSessionCount()
+	}
+	if req.AutoApproval != nil {
+		clone.AutoApproval = req.GetAutoApproval()
+	}
+	if req.AutomationMode != nil {
+		clone.AutomationMode = req.GetAutomationMode()
+	}
+	if req.RequirePlanApproval != nil {
+		clone.RequirePlanApproval = req.GetRequirePlanApproval()
+	}
+	if req.ChatEnabled != nil {
+		clone.ChatEnabled = req.GetChatEnabled()
+	}
+	if req.Matrix != nil {
+		// An empty matrix turns the rerun into a single-target job
+		clone.Matrix = req.Matrix
+		if req.Repo == nil && hasMatrix(req.Matrix) {
+			clone.Repo, clone.Branch = "", ""
+		}
+	}
+
+	return s.CreateJob(ctx, clone)
+}
+
+// RerunFailedSessions creates a new job with one session for every session of the original job
+// that could not be created, ended FAILED, or completed without producing a PR.
+func (s *JobServer) RerunFailedSessions(ctx context.Context, req *pb.RerunFailedSessionsRequest) (*pb.Job, error) {
+	parent, err := s.GetJob(ctx, &pb.GetJobRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	// Jobs from before slots existed only have session_ids
+	slots := parent.SessionSlots
+	if len(slots) == 0 {
+		for i, id := range parent.SessionIds {
+			slots = append(slots, &pb.JobSessionSlot{SlotIndex: int32(i), SessionId: id, Status: SlotStatusCreated})
+		}
+	}
+
+	// Failed sessions per matrix target (a single target for plain jobs)
+	failedPerTarget := map[int32]int32{}
+	total := 0
+	for _, slot := range slots {
+		switch slot.Status {
+		case SlotStatusFailed:
+		case SlotStatusCreated:
+			if !s.sessionFailed(ctx, slot.SessionId) {
+				continue
+			}
+		default:
+			continue
+		}
+		failedPerTarget[slot.TargetIndex]++
+		total++
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("job has no failed sessions")
+	}
+
+	clone := rerunRequest(parent)
+	clone.Name = parent.Name + " (rerun failed)"
+	if len(clone.Name) > 255 {
+		clone.Name = clone.Name[:255]
+	}
+	if hasMatrix(parent.Matrix) {
+		clone.Matrix = &pb.JobMatrix{}
+		for i, t := range parent.Matrix.Targets {
+			if n := failedPerTarget[int32(i)]; n > 0 {
+				clone.Matrix.Targets = append(clone.Matrix.Targets, &pb.JobTarget{Repo: t.Repo, Branch: t.Branch, Vars: t.Vars, SessionCount: n})
+			}
+		}
+		clone.Repo, clone.Branch = "", ""
+		clone.SessionCount = 1
+	} else {
+		clone.SessionCount = int32(total)
+	}
+	return s.CreateJob(ctx, clone)
+}
+
+// sessionFailed reports whether a session ended FAILED or completed without producing a PR.
+// Sessions not cached locally yet are still running as far as we know.
+func (s *JobServer) sessionFailed(ctx context.Context, id string) bool {
+	var state, prURL string
+	err := s.DB.QueryRowContext(ctx, "SELECT COALESCE(state, ''), COALESCE(pr_url, '') FROM sessions WHERE id = ?", id).Scan(&state, &prURL)
+	if err != nil {
+		return false
+	}
+	return state == "FAILED" || (state == "COMPLETED" && prURL == "")
+}
+
+// rerunRequest copies the settings of a job into a request for a new pending job.
+// Reruns are always created by the server, so they run in the background.
+func rerunRequest(parent *pb.Job) *pb.CreateJobRequest {
+	sessionCount := parent.SessionCount
+	if sessionCount < 1 {
+		sessionCount = 1
+	}
+	return &pb.CreateJobRequest{
+		Name:                parent.Name,
+		Repo:                parent.Repo,
+		Branch:              parent.Branch,
+		AutoApproval:        parent.AutoApproval,
+		Background:          true,
+		Prompt:              parent.Prompt,
+		SessionCount:        sessionCount,
+		Status:              JobStatusPending,
+		AutomationMode:      parent.AutomationMode,
+		RequirePlanApproval: parent.RequirePlanApproval,
+		ProfileId:           parent.ProfileId,
+		ChatEnabled:         parent.ChatEnabled,
+		ParentJobId:         parent.Id,
+		Matrix:              parent.Matrix,
+		Priority:            parent.Priority,
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// pendingOrder is the order pending jobs are considered in, before sharing across profiles.
+const pendingOrder = "priority DESC, created_at, id"
+
+// NextPendingJobs picks up to limit pending jobs to process. Jobs are taken by priority then age,
+// but round-robin across profiles so a large batch from one profile cannot starve the others.
+// Each round visits the profiles in the order of their best remaining job.
+func (s *JobServer) NextPendingJobs(ctx context.Context, limit int) ([]string, error) {
+	rows, err := s.DB.QueryContext(ctx, "SELECT id, COALESCE(profile_id, 'default') FROM jobs WHERE status = ? ORDER BY "+pendingOrder, JobStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending jobs: %w", err)
+	}
+	defer rows.Close()
+
+	type pending struct {
+		id   string
+		rank int
+	}
+	byProfile := map[string][]pending{}
+	var profiles []string
+	rank := 0
+	for rows.Next() {
+		var id, profileID string
+		if err := rows.Scan(&id, &profileID); err != nil {
+			return nil, fmt.Errorf("failed to scan pending job: %w", err)
+		}
+		if _, ok := byProfile[profileID]; !ok {
+			profiles = append(profiles, profileID)
+		}
+		byProfile[profileID] = append(byProfile[profileID], pending{id: id, rank: rank})
+		rank++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list pending jobs: %w", err)
+	}
+
+	var ids []string
+	for len(ids) < limit && len(profiles) > 0 {
+		sort.SliceStable(profiles, func(i, j int) bool {
+			return byProfile[profiles[i]][0].rank < byProfile[profiles[j]][0].rank
+		})
+		var remaining []string
+		for _, p := range profiles {
+			if len(ids) == limit {
+				break
+			}
+			ids = append(ids, byProfile[p][0].id)
+			byProfile[p] = byProfile[p][1:]
+			if len(byProfile[p]) > 0 {
+				remaining = append(remaining, p)
+			}
+		}
+		profiles = remaining
+	}
+	return ids, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobService_NextPendingJobs(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &JobServer{DB: db}
+	ctx := context.Background()
+
+	created := time.Now().Add(-time.Hour)
+	insert := func(id, profileID string, priority int32) {
+		_, err := svc.CreateJob(ctx, &pb.CreateJobRequest{Id: id, Name: id, Repo: "org/repo", Branch: "main", Background: true, Status: JobStatusPending, ProfileId: profileID, Priority: priority})
+		assert.NoError(t, err)
+		created = created.Add(time.Minute)
+		_, err = db.Exec("UPDATE jobs SET created_at = ? WHERE id = ?", created.Format(time.RFC3339), id)
+		assert.NoError(t, err)
+	}
+
+	// A large import from one profile, then a couple of jobs from others
+	for i := 0; i < 10; i++ {
+		insert(fmt.Sprintf("bulk-%d", i), "import", 0)
+	}
+	insert("alice-1", "alice", 0)
+	insert("alice-2", "alice", 0)
+	insert("bob-urgent", "bob", 5)
+
+	ids, err := svc.NextPendingJobs(ctx, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bob-urgent", "bulk-0", "alice-1", "bulk-1", "alice-2"}, ids)
+
+	// Only PENDING jobs are considered
+	assert.NoError(t, svc.TransitionJob(ctx, "bob-urgent", pb.JobStatus_JOB_STATUS_CANCELLED))
+	ids, err = svc.NextPendingJobs(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bulk-0", "alice-1"}, ids)
+
+	ids, err = svc.NextPendingJobs(ctx, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+}
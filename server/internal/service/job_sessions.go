@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/mcpany/jules/proto"
+)
+
+// Session slot status values as stored in the job_sessions table.
+const (
+	SlotStatusPending  = "PENDING"
+	SlotStatusCreating = "CREATING"
+	SlotStatusCreated  = "CREATED"
+	SlotStatusFailed   = "FAILED"
+)
+
+// InitJobSessions creates one slot per requested session if the job has none yet.
+// Matrix jobs get the requested sessions for each target.
+// Sessions already recorded in session_ids (jobs from before slots existed) become created slots.
+func (s *JobServer) InitJobSessions(ctx context.Context, job *pb.Job) error {
+	now := time.Now().Format(time.RFC3339)
+
+	for i, target := range slotTargets(job) {
+		var err error
+		if i < len(job.SessionIds) && !hasMatrix(job.Matrix) {
+			_, err = s.DB.ExecContext(ctx, "INSERT OR IGNORE INTO job_sessions (job_id, slot_index, session_id, status, attempts, updated_at) VALUES (?, ?, ?, ?, 1, ?)",
+				job.Id, i, job.SessionIds[i], SlotStatusCreated, now)
+		} else {
+			_, err = s.DB.ExecContext(ctx, "INSERT OR IGNORE INTO job_sessions (job_id, slot_index, target_index, status, updated_at) VALUES (?, ?, ?, ?, ?)",
+				job.Id, i, target, SlotStatusPending, now)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create job session slot: %w", err)
+		}
+	}
+	return nil
+}
+
+// ListJobSessions returns the session slots of a job ordered by index.
+func (s *JobServer) ListJobSessions(ctx context.Context, jobID string) ([]*pb.JobSessionSlot, error) {
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT slot_index, COALESCE(session_id, ''), status, attempts, COALESCE(last_error, ''), updated_at, target_index
+		FROM job_sessions WHERE job_id = ? ORDER BY slot_index`, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var slots []*pb.JobSessionSlot
+	for rows.Next() {
+		var slot pb.JobSessionSlot
+		if err := rows.Scan(&slot.SlotIndex, &slot.SessionId, &slot.Status, &slot.Attempts, &slot.LastError, &slot.UpdatedAt, &slot.TargetIndex); err != nil {
+			return nil, fmt.Errorf("failed to scan job session: %w", err)
+		}
+		slots = append(slots, &slot)
+	}
+	return slots, nil
+}
+
+// UpdateJobSession records the creation status of a slot.
+// Created sessions are mirrored into the job's session_ids in slot order.
+func (s *JobServer) UpdateJobSession(ctx context.Context, jobID string, slot *pb.JobSessionSlot) error {
+	var sessionID, lastError sql.NullString
+	if slot.SessionId != "" {
+		sessionID = sql.NullString{String: slot.SessionId, Valid: true}
+	}
+	if slot.LastError != "" {
+		lastError = sql.NullString{String: slot.LastError, Valid: true}
+	}
+
+	_, err := s.DB.ExecContext(ctx, "UPDATE job_sessions SET session_id = ?, status = ?, attempts = ?, last_error = ?, updated_at = ? WHERE job_id = ? AND slot_index = ?",
+		sessionID, slot.Status, slot.Attempts, lastError, time.Now().Format(time.RFC3339), jobID, slot.SlotIndex)
+	if err != nil {
+		return fmt.Errorf("failed to update job session: %w", err)
+	}
+
+	if slot.Status == SlotStatusCreated {
+		// Recomputed in a single statement so concurrent slots cannot overwrite each other
+		_, err = s.DB.ExecContext(ctx, `
+			UPDATE jobs SET session_ids = (
+				SELECT json_group_array(session_id) FROM (
+					SELECT session_id FROM job_sessions WHERE job_id = ? AND status = ? ORDER BY slot_index
+				)
+			) WHERE id = ?`, jobID, SlotStatusCreated, jobID)
+		if err != nil {
+			return fmt.Errorf("failed to record session ids: %w", err)
+		}
+	}
+	return nil
+}
+
+// RetryFailedSessions re-queues the failed session slots of a job.
+// The job goes back to PENDING and BackgroundJobWorker creates only the failed sessions.
+func (s *JobServer) RetryFailedSessions(ctx context.Context, req *pb.RetryFailedSessionsRequest) (*pb.Job, error) {
+	job, err := s.GetJob(ctx, &pb.GetJobRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateJobTransition(job.State, pb.JobStatus_JOB_STATUS_PENDING); err != nil {
+		return nil, err
+	}
+
+	// Jobs that failed before slots existed get them now; their missing sessions start out pending
+	if err := s.InitJobSessions(ctx, job); err != nil {
+		return nil, err
+	}
+	_, err = s.DB.ExecContext(ctx, "UPDATE job_sessions SET status = ?, attempts = 0, last_error = NULL, updated_at = ? WHERE job_id = ? AND status = ?",
+		SlotStatusPending, time.Now().Format(time.RFC3339), job.Id, SlotStatusFailed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset failed sessions: %w", err)
+	}
+
+	var pending int
+	if err := s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM job_sessions WHERE job_id = ? AND status != ?", job.Id, SlotStatusCreated).Scan(&pending); err != nil {
+		return nil, fmt.Errorf("failed to count job sessions: %w", err)
+	}
+	if pending == 0 {
+		return nil, fmt.Errorf("job has no failed sessions")
+	}
+
+	if err := s.TransitionJob(ctx, job.Id, pb.JobStatus_JOB_STATUS_PENDING); err != nil {
+		return nil, err
+	}
+	return s.GetJob(ctx, &pb.GetJobRequest{Id: job.Id})
+}
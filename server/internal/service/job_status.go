@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	pb "github.com/mcpany/jules/proto"
+)
+
+// Job status values as stored in the jobs table.
+const (
+	JobStatusQueued     = "QUEUED"
+	JobStatusPending    = "PENDING"
+	JobStatusProcessing = "PROCESSING"
+	JobStatusCompleted  = "COMPLETED"
+	JobStatusFailed     = "FAILED"
+	JobStatusCancelled  = "CANCELLED"
+	// JobStatusPartiallySucceeded is set when some, but not all, sessions could be created.
+	JobStatusPartiallySucceeded = "PARTIALLY_SUCCEEDED"
+)
+
+// jobTransitions lists the statuses a job may move to from each status. Finished jobs cannot be
+// cancelled: cancelling deletes the job's sessions, whose PRs may be open or merged by then.
+var jobTransitions = map[pb.JobStatus][]pb.JobStatus{
+	pb.JobStatus_JOB_STATUS_QUEUED:              {pb.JobStatus_JOB_STATUS_PENDING, pb.JobStatus_JOB_STATUS_CANCELLED},
+	pb.JobStatus_JOB_STATUS_PENDING:             {pb.JobStatus_JOB_STATUS_PROCESSING, pb.JobStatus_JOB_STATUS_FAILED, pb.JobStatus_JOB_STATUS_CANCELLED},
+	pb.JobStatus_JOB_STATUS_PROCESSING:          {pb.JobStatus_JOB_STATUS_COMPLETED, pb.JobStatus_JOB_STATUS_PARTIALLY_SUCCEEDED, pb.JobStatus_JOB_STATUS_FAILED, pb.JobStatus_JOB_STATUS_CANCELLED},
+	pb.JobStatus_JOB_STATUS_COMPLETED:           {},
+	pb.JobStatus_JOB_STATUS_FAILED:              {pb.JobStatus_JOB_STATUS_PENDING},
+	pb.JobStatus_JOB_STATUS_CANCELLED:           {},
+	pb.JobStatus_JOB_STATUS_PARTIALLY_SUCCEEDED: {pb.JobStatus_JOB_STATUS_PENDING},
+}
+
+// ParseJobStatus converts a stored status string into the enum.
+// Legacy values written by older workers ('Running', 'Succeeded', 'Failed') are accepted.
+func ParseJobStatus(status string) (pb.JobStatus, error) {
+	switch strings.ToUpper(status) {
+	case "":
+		return pb.JobStatus_JOB_STATUS_UNSPECIFIED, nil
+	case JobStatusQueued:
+		return pb.JobStatus_JOB_STATUS_QUEUED, nil
+	case JobStatusPending:
+		return pb.JobStatus_JOB_STATUS_PENDING, nil
+	case JobStatusProcessing, "RUNNING":
+		return pb.JobStatus_JOB_STATUS_PROCESSING, nil
+	case JobStatusCompleted, "SUCCEEDED":
+		return pb.JobStatus_JOB_STATUS_COMPLETED, nil
+	case JobStatusFailed:
+		return pb.JobStatus_JOB_STATUS_FAILED, nil
+	case JobStatusCancelled:
+		return pb.JobStatus_JOB_STATUS_CANCELLED, nil
+	case JobStatusPartiallySucceeded, "PARTIALLYSUCCEEDED":
+		return pb.JobStatus_JOB_STATUS_PARTIALLY_SUCCEEDED, nil
+	}
+	if v, ok := pb.JobStatus_value[status]; ok {
+		return pb.JobStatus(v), nil
+	}
+	return pb.JobStatus_JOB_STATUS_UNSPECIFIED, fmt.Errorf("invalid job status: %s", status)
+}
+
+// JobStatusString returns the stored form of the status, e.g. "PROCESSING".
+func JobStatusString(status pb.JobStatus) string {
+	if status == pb.JobStatus_JOB_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(status.String(), "JOB_STATUS_")
+}
+
+// ValidateJobTransition checks that a job may move from one status to another.
+// Jobs without a status (created before statuses were enforced) may move anywhere.
+func ValidateJobTransition(from, to pb.JobStatus) error {
+	if from == pb.JobStatus_JOB_STATUS_UNSPECIFIED || from == to {
+		return nil
+	}
+	for _, allowed := range jobTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid job status transition: %s -> %s", JobStatusString(from), JobStatusString(to))
+}
+
+// TransitionJob moves the job to the given status if the transition is valid.
+// The update is a compare-and-set on the current status, retried a few times on concurrent changes.
+func (s *JobServer) TransitionJob(ctx context.Context, id string, to pb.JobStatus) error {
+	for attempt := 0; attempt < 3; attempt++ {
+		var current sql.NullString
+		err := s.DB.QueryRowContext(ctx, "SELECT status FROM jobs WHERE id = ?", id).Scan(&current)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("job not found")
+		} else if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+
+		from, err := ParseJobStatus(current.String)
+		if err != nil {
+			return err
+		}
+		if err := ValidateJobTransition(from, to); err != nil {
+			return err
+		}
+
+		res, err := s.DB.ExecContext(ctx, "UPDATE jobs SET status = ? WHERE id = ? AND COALESCE(status, '') = ?", JobStatusString(to), id, current.String)
+		if err != nil {
+			return fmt.Errorf("failed to update job status: %w", err)
+		}
+		if affected, _ := res.RowsAffected(); affected > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("job status changed concurrently")
+}
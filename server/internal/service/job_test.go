@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"io"
+	"net/http"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -120,3 +123,221 @@ func TestJobService_Validation(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "name is too long")
 }
+
+func TestJobService_Cancel(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var deleted []string
+	sessions := &SessionServer{
+		DB:      db,
+		BaseURL: "https://mock.api",
+		HTTPClient: &http.Client{Transport: &MockRoundTripper{
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				mu.Lock()
+				defer mu.Unlock()
+				status := http.StatusOK
+				if req.Method != "DELETE" || strings.HasSuffix(req.URL.Path, "/s2") {
+					status = http.StatusInternalServerError
+				} else {
+					deleted = append(deleted, req.URL.Path)
+				}
+				return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("{}")), Header: make(http.Header)}
+			},
+		}},
+	}
+	svc := &JobServer{DB: db, Sessions: sessions}
+	t.Setenv("JULES_API_KEY", "dummy-key")
+
+	_, err := svc.CreateJob(ctx, &pb.CreateJobRequest{Id: "c1", Name: "Cancel me", Repo: "test/repo", Branch: "main", Status: "PROCESSING", SessionIds: []string{"s1", "s2"}})
+	assert.NoError(t, err)
+
+	resp, err := svc.CancelJob(ctx, &pb.CancelJobRequest{Id: "c1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"s1"}, resp.CancelledSessionIds)
+	assert.Equal(t, []string{"s2"}, resp.FailedSessionIds)
+	assert.Equal(t, []string{"/sessions/s1"}, deleted)
+
+	job, err := svc.GetJob(ctx, &pb.GetJobRequest{Id: "c1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "CANCELLED", job.Status)
+
+	// Deleting with remote propagation keeps the job when teardown fails
+	_, err = svc.DeleteJob(ctx, &pb.DeleteJobRequest{Id: "c1", DeleteRemoteSessions: true})
+	assert.Error(t, err)
+	_, err = svc.GetJob(ctx, &pb.GetJobRequest{Id: "c1"})
+	assert.NoError(t, err)
+
+	// Finished jobs keep their sessions, whose PRs may be open or merged
+	_, err = svc.CreateJob(ctx, &pb.CreateJobRequest{Id: "c2", Name: "Done", Repo: "test/repo", Branch: "main", Status: "PROCESSING", SessionIds: []string{"s3"}})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.TransitionJob(ctx, "c2", pb.JobStatus_JOB_STATUS_COMPLETED))
+	deletes := len(deleted)
+	_, err = svc.CancelJob(ctx, &pb.CancelJobRequest{Id: "c2"})
+	assert.Error(t, err)
+	assert.Len(t, deleted, deletes)
+
+	// Sessions that can't be deleted remotely are not reported as torn down
+	t.Setenv("JULES_API_KEY", "")
+	_, err = svc.CreateJob(ctx, &pb.CreateJobRequest{Id: "c3", Name: "No key", Repo: "test/repo", Branch: "main", Status: "PROCESSING", SessionIds: []string{"s4", "bad id"}})
+	assert.NoError(t, err)
+	resp, err = svc.CancelJob(ctx, &pb.CancelJobRequest{Id: "c3"})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.CancelledSessionIds)
+	assert.Equal(t, []string{"s4", "bad id"}, resp.FailedSessionIds)
+
+	_, err = svc.CancelJob(ctx, &pb.CancelJobRequest{Id: "missing"})
+	assert.Error(t, err)
+}
+
+func TestJobService_StatusTransitions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &JobServer{DB: db}
+	ctx := context.Background()
+
+	_, err := svc.CreateJob(ctx, &pb.CreateJobRequest{Id: "t1", Name: "Job", Repo: "test/repo", Branch: "main", Status: "PENDING"})
+	assert.NoError(t, err)
+
+	_, err = svc.CreateJob(ctx, &pb.CreateJobRequest{Id: "t2", Name: "Job", Repo: "test/repo", Branch: "main", Status: "BOGUS"})
+	assert.Error(t, err)
+
+	// PENDING -> COMPLETED skips PROCESSING
+	completed := "COMPLETED"
+	_, err = svc.UpdateJob(ctx, &pb.UpdateJobRequest{Id: "t1", Status: &completed})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid job status transition")
+
+	assert.NoError(t, svc.TransitionJob(ctx, "t1", pb.JobStatus_JOB_STATUS_PROCESSING))
+	_, err = svc.UpdateJob(ctx, &pb.UpdateJobRequest{Id: "t1", Status: &completed})
+	assert.NoError(t, err)
+
+	job, err := svc.GetJob(ctx, &pb.GetJobRequest{Id: "t1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "COMPLETED", job.Status)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_COMPLETED, job.State)
+
+	// Finished jobs cannot be cancelled, and cancelled is terminal
+	assert.Error(t, svc.TransitionJob(ctx, "t1", pb.JobStatus_JOB_STATUS_CANCELLED))
+	_, err = svc.CreateJob(ctx, &pb.CreateJobRequest{Id: "t3", Name: "Job", Repo: "test/repo", Branch: "main", Status: "PENDING"})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.TransitionJob(ctx, "t3", pb.JobStatus_JOB_STATUS_CANCELLED))
+	assert.Error(t, svc.TransitionJob(ctx, "t3", pb.JobStatus_JOB_STATUS_PENDING))
+
+	// Legacy values are normalized on read
+	_, err = db.Exec("UPDATE jobs SET status = 'Succeeded' WHERE id = 't1'")
+	assert.NoError(t, err)
+	job, err = svc.GetJob(ctx, &pb.GetJobRequest{Id: "t1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "COMPLETED", job.Status)
+}
+
+func TestJobService_Rerun(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &JobServer{DB: db}
+	ctx := context.Background()
+
+	_, err := svc.CreateJob(ctx, &pb.CreateJobRequest{
+		Id: "orig", Name: "Batch", Prompt: "Fix it", Repo: "test/repo", Branch: "main",
+		Status: "COMPLETED", SessionCount: 4, AutoApproval: true, SessionIds: []string{"s1", "s2", "s3"}, Priority: 3,
+	})
+	assert.NoError(t, err)
+
+	prompt := "Fix it properly"
+	rerun, err := svc.RerunJob(ctx, &pb.RerunJobRequest{Id: "orig", Prompt: &prompt})
+	assert.NoError(t, err)
+	assert.NotEqual(t, "orig", rerun.Id)
+
+	job, err := svc.GetJob(ctx, &pb.GetJobRequest{Id: rerun.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "orig", job.ParentJobId)
+	assert.Equal(t, "Fix it properly", job.Prompt)
+	assert.Equal(t, "test/repo", job.Repo)
+	assert.Equal(t, int32(4), job.SessionCount)
+	assert.True(t, job.AutoApproval)
+	assert.Equal(t, int32(3), job.Priority)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_PENDING, job.State)
+
+	badBranch := "bad branch"
+	_, err = svc.RerunJob(ctx, &pb.RerunJobRequest{Id: "orig", Branch: &badBranch})
+	assert.Error(t, err)
+
+	// Nothing failed yet
+	_, err = svc.RerunFailedSessions(ctx, &pb.RerunFailedSessionsRequest{Id: "orig"})
+	assert.Error(t, err)
+
+	// s1 failed, s2 completed without a PR, s3 produced a PR, and one slot was never created
+	_, err = db.Exec(`INSERT INTO sessions (id, name, state, pr_url) VALUES
+		('s1', 'sessions/s1', 'FAILED', NULL),
+		('s2', 'sessions/s2', 'COMPLETED', ''),
+		('s3', 'sessions/s3', 'COMPLETED', 'https://github.com/test/repo/pull/1')`)
+	assert.NoError(t, err)
+	orig, err := svc.GetJob(ctx, &pb.GetJobRequest{Id: "orig"})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.InitJobSessions(ctx, orig))
+	assert.NoError(t, svc.UpdateJobSession(ctx, "orig", &pb.JobSessionSlot{SlotIndex: 3, Status: SlotStatusFailed, Attempts: 3, LastError: "boom"}))
+
+	failed, err := svc.RerunFailedSessions(ctx, &pb.RerunFailedSessionsRequest{Id: "orig"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), failed.SessionCount)
+	assert.Equal(t, "orig", failed.ParentJobId)
+	assert.Equal(t, "Fix it", failed.Prompt)
+	assert.Equal(t, int32(3), failed.Priority)
+
+	_, err = svc.RerunJob(ctx, &pb.RerunJobRequest{Id: "missing"})
+	assert.Error(t, err)
+}
+
+func TestJobService_Matrix(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &JobServer{DB: db}
+	ctx := context.Background()
+
+	assert.Equal(t, "Bump to 1.23 in org/a ${{ secrets.TOKEN }}", RenderPrompt("Bump to {{ .version }} in {{repo}} ${{ secrets.TOKEN }}", map[string]string{"version": "1.23", "repo": "org/a"}))
+
+	_, err := svc.CreateJob(ctx, &pb.CreateJobRequest{Name: "Bad", Matrix: &pb.JobMatrix{Targets: []*pb.JobTarget{{Repo: "org/a", Branch: "bad branch"}}}})
+	assert.Error(t, err)
+
+	_, err = svc.CreateJob(ctx, &pb.CreateJobRequest{
+		Id: "m1", Name: "Fan out", Prompt: "Add SECURITY.md", Status: "PENDING", SessionCount: 2,
+		Matrix: &pb.JobMatrix{Targets: []*pb.JobTarget{
+			{Repo: "org/a", Branch: "main"},
+			{Repo: "org/b", Branch: "main", SessionCount: 1},
+		}},
+	})
+	assert.NoError(t, err)
+
+	job, err := svc.GetJob(ctx, &pb.GetJobRequest{Id: "m1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "org/a", job.Repo)
+	assert.Len(t, job.Matrix.Targets, 2)
+	assert.NoError(t, svc.InitJobSessions(ctx, job))
+
+	// Target a: one created session without a PR and one failed slot, target b: a session with a PR
+	assert.NoError(t, svc.UpdateJobSession(ctx, "m1", &pb.JobSessionSlot{SlotIndex: 0, TargetIndex: 0, SessionId: "a1", Status: SlotStatusCreated, Attempts: 1}))
+	assert.NoError(t, svc.UpdateJobSession(ctx, "m1", &pb.JobSessionSlot{SlotIndex: 1, TargetIndex: 0, Status: SlotStatusFailed, Attempts: 3}))
+	assert.NoError(t, svc.UpdateJobSession(ctx, "m1", &pb.JobSessionSlot{SlotIndex: 2, TargetIndex: 1, SessionId: "b1", Status: SlotStatusCreated, Attempts: 1}))
+	_, err = db.Exec(`INSERT INTO sessions (id, name, state, pr_url) VALUES
+		('a1', 'sessions/a1', 'COMPLETED', NULL),
+		('b1', 'sessions/b1', 'COMPLETED', 'https://github.com/org/b/pull/1')`)
+	assert.NoError(t, err)
+
+	job, err = svc.GetJob(ctx, &pb.GetJobRequest{Id: "m1"})
+	assert.NoError(t, err)
+	assert.Len(t, job.TargetProgress, 2)
+	assert.Equal(t, int32(2), job.TargetProgress[0].Total)
+	assert.Equal(t, int32(1), job.TargetProgress[0].Created)
+	assert.Equal(t, int32(1), job.TargetProgress[0].Failed)
+	assert.Equal(t, []string{"b1"}, job.TargetProgress[1].SessionIds)
+
+	// Only target a is rerun, with both of its sessions
+	rerun, err := svc.RerunFailedSessions(ctx, &pb.RerunFailedSessionsRequest{Id: "m1"})
+	assert.NoError(t, err)
+	assert.Len(t, rerun.Matrix.Targets, 1)
+	assert.Equal(t, "org/a", rerun.Matrix.Targets[0].Repo)
+	assert.Equal(t, int32(2), rerun.Matrix.Targets[0].SessionCount)
+}
@@ -0,0 +1,830 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcpany/jules/internal/logger"
+	pb "github.com/mcpany/jules/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Pipeline run status values as stored in the pipeline_runs table.
+const (
+	PipelineRunRunning   = "RUNNING"
+	PipelineRunSucceeded = "SUCCEEDED"
+	PipelineRunFailed    = "FAILED"
+	PipelineRunCancelled = "CANCELLED"
+)
+
+// Pipeline step status values as stored in the pipeline_run_steps table.
+const (
+	PipelineStepWaiting   = "WAITING"
+	PipelineStepRunning   = "RUNNING"
+	PipelineStepSucceeded = "SUCCEEDED"
+	PipelineStepFailed    = "FAILED"
+	PipelineStepSkipped   = "SKIPPED"
+	PipelineStepCancelled = "CANCELLED"
+)
+
+// maxPipelineSteps bounds the size of a pipeline.
+const maxPipelineSteps = 50
+
+type PipelineServer struct {
+	pb.UnimplementedPipelineServiceServer
+	DB   *sql.DB
+	Jobs *JobServer
+}
+
+func (s *PipelineServer) ListPipelines(ctx context.Context, _ *emptypb.Empty) (*pb.ListPipelinesResponse, error) {
+	rows, err := s.DB.QueryContext(ctx, "SELECT id, name, steps, sequential, profile_id, created_at, updated_at FROM pipelines ORDER BY created_at DESC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipelines: %w", err)
+	}
+	defer rows.Close()
+
+	var pipelines []*pb.Pipeline
+	for rows.Next() {
+		p, err := scanPipeline(rows)
+		if err != nil {
+			return nil, err
+		}
+		pipelines = append(pipelines, p)
+	}
+	return &pb.ListPipelinesResponse{Pipelines: pipelines}, nil
+}
+
+func (s *PipelineServer) GetPipeline(ctx context.Context, req *pb.GetPipelineRequest) (*pb.Pipeline, error) {
+	if req.Id == "" {
+		return nil, fmt.Errorf("id required")
+	}
+	row := s.DB.QueryRowContext(ctx, "SELECT id, name, steps, sequential, profile_id, created_at, updated_at FROM pipelines WHERE id = ?", req.Id)
+	p, err := scanPipeline(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("pipeline not found")
+	}
+	return p, err
+}
+
+func (s *PipelineServer) CreatePipeline(ctx context.Context, req *pb.CreatePipelineRequest) (*pb.Pipeline, error) {
+	if err := validatePipeline(req.Name, req.Steps, req.Sequential); err != nil {
+		return nil, err
+	}
+	steps, err := marshalSteps(req.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	id := req.Id
+	if id == "" {
+		id = uuid.New().String()
+	}
+	profileID := req.ProfileId
+	if profileID == "" {
+		profileID = "default"
+	}
+	createdAt := time.Now().Format(time.RFC3339)
+
+	_, err = s.DB.ExecContext(ctx, "INSERT INTO pipelines (id, name, steps, sequential, profile_id, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		id, req.Name, steps, req.Sequential, profileID, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pipeline: %w", err)
+	}
+
+	return &pb.Pipeline{
+		Id:         id,
+		Name:       req.Name,
+		Steps:      req.Steps,
+		Sequential: req.Sequential,
+		ProfileId:  profileID,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+// UpdatePipeline replaces the name and steps of a pipeline. Runs already started keep their own copy of the steps.
+func (s *PipelineServer) UpdatePipeline(ctx context.Context, req *pb.UpdatePipelineRequest) (*pb.Pipeline, error) {
+	if err := validatePipeline(req.Name, req.Steps, req.Sequential); err != nil {
+		return nil, err
+	}
+	steps, err := marshalSteps(req.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.DB.ExecContext(ctx, "UPDATE pipelines SET name = ?, steps = ?, sequential = ?, updated_at = ? WHERE id = ?",
+		req.Name, steps, req.Sequential, time.Now().Format(time.RFC3339), req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pipeline: %w", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, fmt.Errorf("pipeline not found")
+	}
+	return s.GetPipeline(ctx, &pb.GetPipelineRequest{Id: req.Id})
+}
+
+// DeletePipeline deletes a pipeline and its run history. Pipelines with running runs cannot be deleted.
+func (s *PipelineServer) DeletePipeline(ctx context.Context, req *pb.DeletePipelineRequest) (*emptypb.Empty, error) {
+	var running int
+	if err := s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM pipeline_runs WHERE pipeline_id = ? AND status = ?", req.Id, PipelineRunRunning).Scan(&running); err != nil {
+		return nil, fmt.Errorf("failed to count pipeline runs: %w", err)
+	}
+	if running > 0 {
+		return nil, fmt.Errorf("pipeline has running runs, cancel them first")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM pipeline_run_steps WHERE run_id IN (SELECT id FROM pipeline_runs WHERE pipeline_id = ?)", req.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete pipeline run steps: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM pipeline_runs WHERE pipeline_id = ?", req.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete pipeline runs: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM pipelines WHERE id = ?", req.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete pipeline: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// StartPipeline starts a run of the pipeline. The steps are copied into the run, and steps without
+// dependencies get their jobs right away; PipelineWorker advances the rest.
+func (s *PipelineServer) StartPipeline(ctx context.Context, req *pb.StartPipelineRequest) (*pb.PipelineRun, error) {
+	p, err := s.GetPipeline(ctx, &pb.GetPipelineRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	steps, err := marshalSteps(p.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	runID := uuid.New().String()
+	now := time.Now().Format(time.RFC3339)
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO pipeline_runs (id, pipeline_id, pipeline_name, steps, sequential, profile_id, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, runID, p.Id, p.Name, steps, p.Sequential, p.ProfileId, PipelineRunRunning, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pipeline run: %w", err)
+	}
+	for _, step := range p.Steps {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO pipeline_run_steps (run_id, step_id, status) VALUES (?, ?, ?)", runID, step.Id, PipelineStepWaiting); err != nil {
+			return nil, fmt.Errorf("failed to create pipeline run step: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if err := s.AdvanceRun(ctx, runID); err != nil {
+		return nil, err
+	}
+	return s.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: runID})
+}
+
+// CancelPipelineRun stops a running run and cancels the jobs of its running steps.
+func (s *PipelineServer) CancelPipelineRun(ctx context.Context, req *pb.CancelPipelineRunRequest) (*pb.PipelineRun, error) {
+	run, err := s.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	if run.Status != PipelineRunRunning {
+		return nil, fmt.Errorf("pipeline run is not running")
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	res, err := s.DB.ExecContext(ctx, "UPDATE pipeline_runs SET status = ?, updated_at = ?, finished_at = ? WHERE id = ? AND status = ?",
+		PipelineRunCancelled, now, now, run.Id, PipelineRunRunning)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel pipeline run: %w", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, fmt.Errorf("pipeline run is not running")
+	}
+
+	for _, step := range run.Steps {
+		if step.Status != PipelineStepWaiting && step.Status != PipelineStepRunning {
+			continue
+		}
+		if step.JobId != "" && s.Jobs != nil {
+			if _, err := s.Jobs.CancelJob(ctx, &pb.CancelJobRequest{Id: step.JobId}); err != nil {
+				logger.Warn("Failed to cancel job %s of pipeline run %s: %s", step.JobId, run.Id, err.Error())
+			}
+		}
+		s.finishStep(ctx, run.Id, step.StepId, PipelineStepCancelled, "pipeline run cancelled")
+	}
+	return s.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+}
+
+func (s *PipelineServer) GetPipelineRun(ctx context.Context, req *pb.GetPipelineRunRequest) (*pb.PipelineRun, error) {
+	if req.Id == "" {
+		return nil, fmt.Errorf("id required")
+	}
+	var run pb.PipelineRun
+	var finishedAt sql.NullString
+	err := s.DB.QueryRowContext(ctx, "SELECT id, pipeline_id, pipeline_name, status, created_at, updated_at, finished_at FROM pipeline_runs WHERE id = ?", req.Id).Scan(
+		&run.Id, &run.PipelineId, &run.PipelineName, &run.Status, &run.CreatedAt, &run.UpdatedAt, &finishedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("pipeline run not found")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline run: %w", err)
+	}
+	run.FinishedAt = finishedAt.String
+
+	steps, err := s.listRunSteps(ctx, run.Id)
+	if err != nil {
+		return nil, err
+	}
+	run.Steps = steps
+	return &run, nil
+}
+
+// ListPipelineRuns returns the most recent runs of a pipeline.
+func (s *PipelineServer) ListPipelineRuns(ctx context.Context, req *pb.ListPipelineRunsRequest) (*pb.ListPipelineRunsResponse, error) {
+	if req.PipelineId == "" {
+		return nil, fmt.Errorf("pipeline_id required")
+	}
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	rows, err := s.DB.QueryContext(ctx, "SELECT id FROM pipeline_runs WHERE pipeline_id = ? ORDER BY created_at DESC, rowid DESC LIMIT ?", req.PipelineId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipeline runs: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan pipeline run: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	var runs []*pb.PipelineRun
+	for _, id := range ids {
+		run, err := s.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return &pb.ListPipelineRunsResponse{Runs: runs}, nil
+}
+
+// RunningPipelineRuns returns the ids of the runs PipelineWorker has to advance.
+func (s *PipelineServer) RunningPipelineRuns(ctx context.Context) ([]string, error) {
+	rows, err := s.DB.QueryContext(ctx, "SELECT id FROM pipeline_runs WHERE status = ? ORDER BY created_at", PipelineRunRunning)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list running pipeline runs: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan pipeline run: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// AdvanceRun settles running steps whose jobs finished, starts or skips waiting steps whose
+// dependencies allow it, and finishes the run once every step is done.
+func (s *PipelineServer) AdvanceRun(ctx context.Context, runID string) error {
+	var stepsJSON, status, name, profileID string
+	var sequential bool
+	err := s.DB.QueryRowContext(ctx, "SELECT steps, sequential, status, pipeline_name, profile_id FROM pipeline_runs WHERE id = ?", runID).Scan(
+		&stepsJSON, &sequential, &status, &name, &profileID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("pipeline run not found")
+	} else if err != nil {
+		return fmt.Errorf("failed to get pipeline run: %w", err)
+	}
+	if status != PipelineRunRunning {
+		return nil
+	}
+
+	steps, err := unmarshalSteps(stepsJSON)
+	if err != nil {
+		return err
+	}
+	deps := stepDependencies(steps, sequential)
+
+	runSteps, err := s.listRunSteps(ctx, runID)
+	if err != nil {
+		return err
+	}
+	state := map[string]*pb.PipelineRunStep{}
+	for _, rs := range runSteps {
+		state[rs.StepId] = rs
+	}
+
+	facts := map[string]*jobFacts{}
+	factsOf := func(stepID string) *jobFacts {
+		if f, ok := facts[stepID]; ok {
+			return f
+		}
+		f, err := s.jobFacts(ctx, state[stepID].JobId)
+		if err != nil {
+			logger.Warn("Pipeline run %s: failed to inspect job of step %s: %s", runID, stepID, err.Error())
+		}
+		facts[stepID] = f
+		return f
+	}
+
+	// Settle running steps from their jobs
+	for _, step := range steps {
+		rs := state[step.Id]
+		if rs == nil || rs.Status != PipelineStepRunning {
+			continue
+		}
+		f := factsOf(step.Id)
+		if f == nil {
+			continue
+		}
+		switch {
+		case f.succeeded():
+			rs.Status = PipelineStepSucceeded
+			s.finishStep(ctx, runID, step.Id, rs.Status, "")
+		case f.settled():
+			rs.Status = PipelineStepFailed
+			rs.Message = f.failureReason()
+			s.finishStep(ctx, runID, step.Id, rs.Status, rs.Message)
+		}
+	}
+
+	// Start or skip waiting steps. Skips propagate to dependents, so repeat until nothing changes.
+	for changed := true; changed; {
+		changed = false
+		for _, step := range steps {
+			rs := state[step.Id]
+			if rs == nil || rs.Status != PipelineStepWaiting {
+				continue
+			}
+
+			verdict, reason := conditionMet, ""
+			for _, dep := range deps[step.Id] {
+				v := s.evaluateDependency(state[dep], factsOf, step.Condition)
+				if v == conditionNever {
+					verdict, reason = conditionNever, fmt.Sprintf("dependency %s did not meet condition %s", dep, conditionName(step.Condition))
+					break
+				}
+				if v == conditionPending {
+					verdict = conditionPending
+				}
+			}
+
+			switch verdict {
+			case conditionNever:
+				rs.Status, rs.Message = PipelineStepSkipped, reason
+				s.finishStep(ctx, runID, step.Id, rs.Status, reason)
+				changed = true
+			case conditionMet:
+				if err := s.startStep(ctx, runID, name, profileID, step, rs); err != nil {
+					logger.Error("Pipeline run %s: failed to start step %s: %s", runID, step.Id, err.Error())
+				}
+				changed = true
+			}
+		}
+	}
+
+	// Finish the run once every step is done
+	outcome := PipelineRunSucceeded
+	for _, rs := range state {
+		switch rs.Status {
+		case PipelineStepWaiting, PipelineStepRunning:
+			return nil
+		case PipelineStepSucceeded:
+		default:
+			outcome = PipelineRunFailed
+		}
+	}
+	now := time.Now().Format(time.RFC3339)
+	_, err = s.DB.ExecContext(ctx, "UPDATE pipeline_runs SET status = ?, updated_at = ?, finished_at = ? WHERE id = ? AND status = ?",
+		outcome, now, now, runID, PipelineRunRunning)
+	if err != nil {
+		return fmt.Errorf("failed to finish pipeline run: %w", err)
+	}
+	return nil
+}
+
+// startStep creates the job of a step. The step is claimed first, so concurrent advances create a single job.
+func (s *PipelineServer) startStep(ctx context.Context, runID, pipelineName, profileID string, step *pb.PipelineStep, rs *pb.PipelineRunStep) error {
+	req := proto.Clone(step.Job).(*pb.CreateJobRequest)
+	req.Id = uuid.New().String()
+	req.Status = JobStatusPending
+	req.Background = true
+	req.CronJobId = ""
+	if req.Name == "" {
+		req.Name = pipelineName + " / " + step.Id
+		if len(req.Name) > 255 {
+			req.Name = req.Name[:255]
+		}
+	}
+	if req.ProfileId == "" {
+		req.ProfileId = profileID
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	res, err := s.DB.ExecContext(ctx, "UPDATE pipeline_run_steps SET status = ?, job_id = ?, started_at = ? WHERE run_id = ? AND step_id = ? AND status = ?",
+		PipelineStepRunning, req.Id, now, runID, step.Id, PipelineStepWaiting)
+	if err != nil {
+		return fmt.Errorf("failed to update pipeline step: %w", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		rs.Status = PipelineStepRunning
+		return nil
+	}
+	rs.Status, rs.JobId = PipelineStepRunning, req.Id
+
+	if _, err := s.Jobs.CreateJob(ctx, req); err != nil {
+		// The job doesn't exist, so dependents must not wait for it
+		if _, err := s.DB.ExecContext(ctx, "UPDATE pipeline_run_steps SET job_id = NULL WHERE run_id = ? AND step_id = ?", runID, step.Id); err != nil {
+			logger.Error("Pipeline run %s: failed to clear job of step %s: %s", runID, step.Id, err.Error())
+		}
+		rs.Status, rs.Message, rs.JobId = PipelineStepFailed, err.Error(), ""
+		s.finishStep(ctx, runID, step.Id, rs.Status, rs.Message)
+		return err
+	}
+	return nil
+}
+
+func (s *PipelineServer) finishStep(ctx context.Context, runID, stepID, status, message string) {
+	now := time.Now().Format(time.RFC3339)
+	var msg sql.NullString
+	if message != "" {
+		msg = sql.NullString{String: message, Valid: true}
+	}
+	if _, err := s.DB.ExecContext(ctx, "UPDATE pipeline_run_steps SET status = ?, message = ?, finished_at = ? WHERE run_id = ? AND step_id = ?",
+		status, msg, now, runID, stepID); err != nil {
+		logger.Error("Pipeline run %s: failed to update step %s: %s", runID, stepID, err.Error())
+	}
+	_, _ = s.DB.ExecContext(ctx, "UPDATE pipeline_runs SET updated_at = ? WHERE id = ?", now, runID)
+}
+
+func (s *PipelineServer) listRunSteps(ctx context.Context, runID string) ([]*pb.PipelineRunStep, error) {
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT step_id, status, COALESCE(job_id, ''), COALESCE(message, ''), COALESCE(started_at, ''), COALESCE(finished_at, '')
+		FROM pipeline_run_steps WHERE run_id = ? ORDER BY rowid`, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipeline run steps: %w", err)
+	}
+	defer rows.Close()
+
+	var steps []*pb.PipelineRunStep
+	for rows.Next() {
+		var rs pb.PipelineRunStep
+		if err := rows.Scan(&rs.StepId, &rs.Status, &rs.JobId, &rs.Message, &rs.StartedAt, &rs.FinishedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan pipeline run step: %w", err)
+		}
+		steps = append(steps, &rs)
+	}
+	return steps, nil
+}
+
+// Outcomes of a step condition for one dependency.
+const (
+	conditionPending = iota
+	conditionMet
+	conditionNever
+)
+
+func (s *PipelineServer) evaluateDependency(dep *pb.PipelineRunStep, factsOf func(string) *jobFacts, condition pb.PipelineCondition) int {
+	if dep == nil {
+		return conditionNever
+	}
+	if dep.JobId == "" {
+		if dep.Status == PipelineStepWaiting {
+			return conditionPending
+		}
+		return conditionNever
+	}
+	f := factsOf(dep.StepId)
+	if f == nil {
+		return conditionPending
+	}
+
+	switch condition {
+	case pb.PipelineCondition_PIPELINE_CONDITION_PR_MERGED:
+		if f.merged > 0 {
+			return conditionMet
+		}
+		if f.settled() && f.unmergedPRs == 0 {
+			return conditionNever
+		}
+		// Every PR was closed without merging
+		if f.unmergedPRs > 0 && f.closedPRs == f.unmergedPRs {
+			return conditionNever
+		}
+	case pb.PipelineCondition_PIPELINE_CONDITION_ANY_SUCCESS:
+		if f.completed > 0 || f.merged > 0 {
+			return conditionMet
+		}
+		if f.settled() {
+			return conditionNever
+		}
+	default:
+		if f.settled() {
+			if f.sessions > 0 && f.completed == f.sessions {
+				return conditionMet
+			}
+			return conditionNever
+		}
+	}
+	return conditionPending
+}
+
+func conditionName(c pb.PipelineCondition) string {
+	if c == pb.PipelineCondition_PIPELINE_CONDITION_UNSPECIFIED {
+		c = pb.PipelineCondition_PIPELINE_CONDITION_SESSIONS_COMPLETED
+	}
+	return strings.TrimPrefix(c.String(), "PIPELINE_CONDITION_")
+}
+
+// jobFacts summarizes the sessions of a job for step conditions.
+type jobFacts struct {
+	status      pb.JobStatus
+	sessions    int // Requested sessions, including those that could not be created
+	completed   int
+	failed      int
+	merged      int
+	unmergedPRs int  // PRs that were not merged, open or closed
+	closedPRs   int  // PRs that were closed without merging
+	missing     bool // The job was deleted
+}
+
+// settled reports whether the job is done and every session ended.
+func (f *jobFacts) settled() bool {
+	if f.missing {
+		return true
+	}
+	switch f.status {
+	case pb.JobStatus_JOB_STATUS_FAILED, pb.JobStatus_JOB_STATUS_CANCELLED:
+		return true
+	case pb.JobStatus_JOB_STATUS_COMPLETED, pb.JobStatus_JOB_STATUS_PARTIALLY_SUCCEEDED:
+		return f.completed+f.failed >= f.sessions
+	}
+	return false
+}
+
+func (f *jobFacts) succeeded() bool {
+	return f.settled() && f.status != pb.JobStatus_JOB_STATUS_CANCELLED && (f.completed > 0 || f.merged > 0)
+}
+
+func (f *jobFacts) failureReason() string {
+	if f.missing {
+		return "job deleted"
+	}
+	switch f.status {
+	case pb.JobStatus_JOB_STATUS_CANCELLED:
+		return "job cancelled"
+	case pb.JobStatus_JOB_STATUS_FAILED:
+		return "job failed"
+	}
+	return "no session completed"
+}
+
+func (s *PipelineServer) jobFacts(ctx context.Context, jobID string) (*jobFacts, error) {
+	var exists int
+	err := s.DB.QueryRowContext(ctx, "SELECT 1 FROM jobs WHERE id = ?", jobID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return &jobFacts{missing: true}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	job, err := s.Jobs.GetJob(ctx, &pb.GetJobRequest{Id: jobID})
+	if err != nil {
+		return nil, err
+	}
+
+	f := &jobFacts{status: job.State}
+	for _, slot := range job.SessionSlots {
+		if slot.Status == SlotStatusFailed {
+			f.sessions++
+			f.failed++
+		}
+	}
+	for _, id := range job.SessionIds {
+		f.sessions++
+		var state, prURL, prState string
+		var merged bool
+		err := s.DB.QueryRowContext(ctx, `
+			SELECT COALESCE(s.state, ''), COALESCE(s.pr_url, ''), COALESCE(s.is_pr_merged, 0),
+				COALESCE((SELECT p.state FROM pull_requests p WHERE p.url = s.pr_url LIMIT 1), '')
+			FROM sessions s WHERE s.id = ?`, id).Scan(&state, &prURL, &merged, &prState)
+		if err == sql.ErrNoRows {
+			// Not cached locally yet
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get session: %w", err)
+		}
+		switch state {
+		case "COMPLETED":
+			f.completed++
+		case "FAILED":
+			f.failed++
+		}
+		switch {
+		case merged || prState == PRStateMerged:
+			f.merged++
+		case prURL != "":
+			f.unmergedPRs++
+			if prState == PRStateClosed {
+				f.closedPRs++
+			}
+		}
+	}
+	return f, nil
+}
+
+// validatePipeline checks the steps form a DAG of valid job templates.
+func validatePipeline(name string, steps []*pb.PipelineStep, sequential bool) error {
+	if name == "" {
+		return fmt.Errorf("name required")
+	}
+	if len(name) > 255 {
+		return fmt.Errorf("name is too long (max 255 characters)")
+	}
+	if len(steps) == 0 {
+		return fmt.Errorf("pipeline needs at least one step")
+	}
+	if len(steps) > maxPipelineSteps {
+		return fmt.Errorf("pipeline has too many steps (max %d)", maxPipelineSteps)
+	}
+
+	ids := map[string]bool{}
+	for _, step := range steps {
+		if step.Id == "" {
+			return fmt.Errorf("step id required")
+		}
+		if len(step.Id) > 100 {
+			return fmt.Errorf("step id is too long (max 100 characters)")
+		}
+		if ids[step.Id] {
+			return fmt.Errorf("duplicate step id: %s", step.Id)
+		}
+		ids[step.Id] = true
+
+		if err := validateJobTemplate(step.Job); err != nil {
+			return fmt.Errorf("step %s: %w", step.Id, err)
+		}
+	}
+
+	deps := stepDependencies(steps, sequential)
+	for id, ds := range deps {
+		for _, dep := range ds {
+			if !ids[dep] {
+				return fmt.Errorf("step %s depends on unknown step %s", id, dep)
+			}
+		}
+	}
+
+	// Depth-first search for cycles
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	marks := map[string]int{}
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch marks[id] {
+		case visiting:
+			return fmt.Errorf("pipeline steps form a cycle through %s", id)
+		case done:
+			return nil
+		}
+		marks[id] = visiting
+		for _, dep := range deps[id] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		marks[id] = done
+		return nil
+	}
+	for _, step := range steps {
+		if err := visit(step.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateJobTemplate(job *pb.CreateJobRequest) error {
+	if job == nil {
+		return fmt.Errorf("job required")
+	}
+	if len(job.Name) > 255 {
+		return fmt.Errorf("name is too long (max 255 characters)")
+	}
+	if len(job.Prompt) > 50000 {
+		return fmt.Errorf("prompt is too long (max 50000 characters)")
+	}
+	if err := ValidateMatrix(job.Matrix); err != nil {
+		return err
+	}
+	if hasMatrix(job.Matrix) && job.Repo == "" {
+		return nil
+	}
+	if err := ValidateRepo(job.Repo); err != nil {
+		return err
+	}
+	return ValidateBranch(job.Branch)
+}
+
+// stepDependencies returns the steps each step waits for.
+func stepDependencies(steps []*pb.PipelineStep, sequential bool) map[string][]string {
+	deps := map[string][]string{}
+	for i, step := range steps {
+		if sequential {
+			if i > 0 {
+				deps[step.Id] = []string{steps[i-1].Id}
+			}
+			continue
+		}
+		deps[step.Id] = step.DependsOn
+	}
+	return deps
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPipeline(row scanner) (*pb.Pipeline, error) {
+	var p pb.Pipeline
+	var steps string
+	var updatedAt sql.NullString
+	if err := row.Scan(&p.Id, &p.Name, &steps, &p.Sequential, &p.ProfileId, &p.CreatedAt, &updatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan pipeline: %w", err)
+	}
+	p.UpdatedAt = updatedAt.String
+
+	var err error
+	if p.Steps, err = unmarshalSteps(steps); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func marshalSteps(steps []*pb.PipelineStep) (string, error) {
+	raw := make([]json.RawMessage, 0, len(steps))
+	for _, step := range steps {
+		b, err := protojson.Marshal(step)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode pipeline step: %w", err)
+		}
+		raw = append(raw, b)
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode pipeline steps: %w", err)
+	}
+	return string(b), nil
+}
+
+func unmarshalSteps(s string) ([]*pb.PipelineStep, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode pipeline steps: %w", err)
+	}
+	steps := make([]*pb.PipelineStep, 0, len(raw))
+	for _, r := range raw {
+		var step pb.PipelineStep
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(r, &step); err != nil {
+			return nil, fmt.Errorf("failed to decode pipeline step: %w", err)
+		}
+		steps = append(steps, &step)
+	}
+	return steps, nil
+}
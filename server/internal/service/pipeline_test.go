@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func pipelineJob(prompt string) *pb.CreateJobRequest {
+	return &pb.CreateJobRequest{Prompt: prompt, Repo: "test/repo", Branch: "main", SessionCount: 1}
+}
+
+// finishPipelineJob marks a job processed with one session in the given state.
+func finishPipelineJob(t *testing.T, svc *JobServer, jobID, sessionID, state, prURL string, merged bool) {
+	ctx := context.Background()
+	job, err := svc.GetJob(ctx, &pb.GetJobRequest{Id: jobID})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.TransitionJob(ctx, jobID, pb.JobStatus_JOB_STATUS_PROCESSING))
+	assert.NoError(t, svc.InitJobSessions(ctx, job))
+	assert.NoError(t, svc.UpdateJobSession(ctx, jobID, &pb.JobSessionSlot{SlotIndex: 0, SessionId: sessionID, Status: SlotStatusCreated, Attempts: 1}))
+	assert.NoError(t, svc.TransitionJob(ctx, jobID, pb.JobStatus_JOB_STATUS_COMPLETED))
+	_, err = svc.DB.Exec("INSERT INTO sessions (id, name, state, pr_url, is_pr_merged) VALUES (?, ?, ?, ?, ?)", sessionID, "sessions/"+sessionID, state, prURL, merged)
+	assert.NoError(t, err)
+}
+
+func runSteps(run *pb.PipelineRun) map[string]*pb.PipelineRunStep {
+	steps := map[string]*pb.PipelineRunStep{}
+	for _, s := range run.Steps {
+		steps[s.StepId] = s
+	}
+	return steps
+}
+
+func TestPipelineService_Validation(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &PipelineServer{DB: db, Jobs: &JobServer{DB: db}}
+	ctx := context.Background()
+
+	cycle := []*pb.PipelineStep{
+		{Id: "a", Job: pipelineJob("x"), DependsOn: []string{"b"}},
+		{Id: "b", Job: pipelineJob("y"), DependsOn: []string{"a"}},
+	}
+	tests := []struct {
+		name  string
+		steps []*pb.PipelineStep
+		err   string
+	}{
+		{"no steps", nil, "at least one step"},
+		{"duplicate", []*pb.PipelineStep{{Id: "a", Job: pipelineJob("x")}, {Id: "a", Job: pipelineJob("y")}}, "duplicate step id"},
+		{"unknown dependency", []*pb.PipelineStep{{Id: "a", Job: pipelineJob("x"), DependsOn: []string{"z"}}}, "unknown step"},
+		{"cycle", cycle, "cycle"},
+		{"missing job", []*pb.PipelineStep{{Id: "a"}}, "job required"},
+		{"invalid repo", []*pb.PipelineStep{{Id: "a", Job: &pb.CreateJobRequest{Repo: "bad repo", Branch: "main"}}}, "step a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreatePipeline(ctx, &pb.CreatePipelineRequest{Name: "p", Steps: tt.steps})
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	// depends_on is ignored for sequential pipelines
+	_, err := svc.CreatePipeline(ctx, &pb.CreatePipelineRequest{Name: "p", Sequential: true, Steps: cycle})
+	assert.NoError(t, err)
+}
+
+func TestPipelineService_RunDAG(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	jobs := &JobServer{DB: db}
+	svc := &PipelineServer{DB: db, Jobs: jobs}
+	ctx := context.Background()
+
+	// api -> client (once the PR merges) -> docs, and api -> review (sessions completed) -> report (any success)
+	p, err := svc.CreatePipeline(ctx, &pb.CreatePipelineRequest{Name: "Migration", Steps: []*pb.PipelineStep{
+		{Id: "api", Job: pipelineJob("Refactor the API")},
+		{Id: "client", Job: pipelineJob("Migrate the client"), DependsOn: []string{"api"}, Condition: pb.PipelineCondition_PIPELINE_CONDITION_PR_MERGED},
+		{Id: "docs", Job: pipelineJob("Update docs"), DependsOn: []string{"client"}},
+		{Id: "review", Job: pipelineJob("Review"), DependsOn: []string{"api"}, Condition: pb.PipelineCondition_PIPELINE_CONDITION_SESSIONS_COMPLETED},
+		{Id: "report", Job: pipelineJob("Report"), DependsOn: []string{"review"}, Condition: pb.PipelineCondition_PIPELINE_CONDITION_ANY_SUCCESS},
+	}})
+	assert.NoError(t, err)
+
+	list, err := svc.ListPipelines(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Pipelines, 1)
+	assert.Len(t, list.Pipelines[0].Steps, 5)
+
+	run, err := svc.StartPipeline(ctx, &pb.StartPipelineRequest{Id: p.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, PipelineRunRunning, run.Status)
+	steps := runSteps(run)
+	assert.Equal(t, PipelineStepRunning, steps["api"].Status)
+	assert.Equal(t, PipelineStepWaiting, steps["client"].Status)
+
+	apiJob, err := jobs.GetJob(ctx, &pb.GetJobRequest{Id: steps["api"].JobId})
+	assert.NoError(t, err)
+	assert.Equal(t, "Migration / api", apiJob.Name)
+	assert.Equal(t, "Refactor the API", apiJob.Prompt)
+	assert.True(t, apiJob.Background)
+
+	// Nothing changes while the job runs
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+	run, _ = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.Equal(t, PipelineStepRunning, runSteps(run)["api"].Status)
+
+	// The API session completes with an open PR: review starts, client waits for the merge
+	finishPipelineJob(t, jobs, steps["api"].JobId, "api-1", "COMPLETED", "https://github.com/test/repo/pull/1", false)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+	run, _ = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	steps = runSteps(run)
+	assert.Equal(t, PipelineStepSucceeded, steps["api"].Status)
+	assert.Equal(t, PipelineStepRunning, steps["review"].Status)
+	assert.Equal(t, PipelineStepWaiting, steps["client"].Status)
+
+	// The review fails, so the report is skipped
+	finishPipelineJob(t, jobs, steps["review"].JobId, "review-1", "FAILED", "", false)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+	run, _ = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	steps = runSteps(run)
+	assert.Equal(t, PipelineStepFailed, steps["review"].Status)
+	assert.Equal(t, PipelineStepSkipped, steps["report"].Status)
+	assert.Contains(t, steps["report"].Message, "review")
+
+	// The PR merges: client starts, then docs once client completes
+	_, err = db.Exec("UPDATE sessions SET is_pr_merged = 1 WHERE id = 'api-1'")
+	assert.NoError(t, err)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+	run, _ = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	steps = runSteps(run)
+	assert.Equal(t, PipelineStepRunning, steps["client"].Status)
+
+	finishPipelineJob(t, jobs, steps["client"].JobId, "client-1", "COMPLETED", "", false)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+	run, _ = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	steps = runSteps(run)
+	assert.Equal(t, PipelineStepRunning, steps["docs"].Status)
+
+	finishPipelineJob(t, jobs, steps["docs"].JobId, "docs-1", "COMPLETED", "", false)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+	run, _ = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.Equal(t, PipelineRunFailed, run.Status)
+	assert.NotEmpty(t, run.FinishedAt)
+
+	history, err := svc.ListPipelineRuns(ctx, &pb.ListPipelineRunsRequest{PipelineId: p.Id})
+	assert.NoError(t, err)
+	assert.Len(t, history.Runs, 1)
+	assert.Equal(t, PipelineRunFailed, history.Runs[0].Status)
+}
+
+func TestPipelineService_PRClosedWithoutMerging(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	jobs := &JobServer{DB: db}
+	svc := &PipelineServer{DB: db, Jobs: jobs}
+	ctx := context.Background()
+
+	p, err := svc.CreatePipeline(ctx, &pb.CreatePipelineRequest{Name: "Merge", Steps: []*pb.PipelineStep{
+		{Id: "api", Job: pipelineJob("Refactor the API")},
+		{Id: "client", Job: pipelineJob("Migrate the client"), DependsOn: []string{"api"}, Condition: pb.PipelineCondition_PIPELINE_CONDITION_PR_MERGED},
+	}})
+	assert.NoError(t, err)
+	run, err := svc.StartPipeline(ctx, &pb.StartPipelineRequest{Id: p.Id})
+	assert.NoError(t, err)
+
+	url := "https://github.com/test/repo/pull/1"
+	finishPipelineJob(t, jobs, runSteps(run)["api"].JobId, "api-1", "COMPLETED", url, false)
+	_, err = db.Exec("INSERT INTO pull_requests (repo, number, url, state, created_at, updated_at) VALUES ('test/repo', 1, ?, ?, '', '')", url, PRStateOpen)
+	assert.NoError(t, err)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+	run, _ = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.Equal(t, PipelineStepWaiting, runSteps(run)["client"].Status)
+
+	// The only PR is closed without merging, so the step is skipped rather than waiting forever
+	_, err = db.Exec("UPDATE pull_requests SET state = ?", PRStateClosed)
+	assert.NoError(t, err)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+	run, _ = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.Equal(t, PipelineStepSkipped, runSteps(run)["client"].Status)
+	assert.Contains(t, runSteps(run)["client"].Message, "PR_MERGED")
+}
+
+func TestPipelineService_StepWithoutJob(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	jobs := &JobServer{DB: db}
+	svc := &PipelineServer{DB: db, Jobs: jobs}
+	ctx := context.Background()
+
+	p, err := svc.CreatePipeline(ctx, &pb.CreatePipelineRequest{Name: "Sequence", Sequential: true, Steps: []*pb.PipelineStep{
+		{Id: "one", Job: pipelineJob("first")},
+		{Id: "two", Job: &pb.CreateJobRequest{Prompt: "second", Repo: "test/other", Branch: "main", SessionCount: 1}},
+		{Id: "three", Job: pipelineJob("third")},
+	}})
+	assert.NoError(t, err)
+	run, err := svc.StartPipeline(ctx, &pb.StartPipelineRequest{Id: p.Id})
+	assert.NoError(t, err)
+
+	// The run's copy of the second step no longer creates a valid job
+	_, err = db.Exec("UPDATE pipeline_runs SET steps = replace(steps, 'test/other', 'not a repo') WHERE id = ?", run.Id)
+	assert.NoError(t, err)
+	finishPipelineJob(t, jobs, runSteps(run)["one"].JobId, "one-1", "COMPLETED", "", false)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+
+	run, err = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, PipelineStepFailed, runSteps(run)["two"].Status)
+	assert.Empty(t, runSteps(run)["two"].JobId)
+	assert.Equal(t, PipelineStepSkipped, runSteps(run)["three"].Status)
+	assert.Equal(t, PipelineRunFailed, run.Status)
+
+	// A step whose job is deleted fails instead of running forever
+	run, err = svc.StartPipeline(ctx, &pb.StartPipelineRequest{Id: p.Id})
+	assert.NoError(t, err)
+	_, err = jobs.DeleteJob(ctx, &pb.DeleteJobRequest{Id: runSteps(run)["one"].JobId})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.AdvanceRun(ctx, run.Id))
+
+	run, err = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, PipelineStepFailed, runSteps(run)["one"].Status)
+	assert.Equal(t, "job deleted", runSteps(run)["one"].Message)
+	assert.Equal(t, PipelineStepSkipped, runSteps(run)["two"].Status)
+	assert.Equal(t, PipelineRunFailed, run.Status)
+}
+
+func TestPipelineService_CancelAndDelete(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	jobs := &JobServer{DB: db}
+	svc := &PipelineServer{DB: db, Jobs: jobs}
+	ctx := context.Background()
+
+	p, err := svc.CreatePipeline(ctx, &pb.CreatePipelineRequest{Name: "Sequence", Sequential: true, Steps: []*pb.PipelineStep{
+		{Id: "one", Job: pipelineJob("first")},
+		{Id: "two", Job: pipelineJob("second")},
+	}})
+	assert.NoError(t, err)
+
+	run, err := svc.StartPipeline(ctx, &pb.StartPipelineRequest{Id: p.Id})
+	assert.NoError(t, err)
+	jobID := runSteps(run)["one"].JobId
+	assert.NotEmpty(t, jobID)
+	assert.Empty(t, runSteps(run)["two"].JobId)
+
+	_, err = svc.DeletePipeline(ctx, &pb.DeletePipelineRequest{Id: p.Id})
+	assert.Error(t, err)
+
+	run, err = svc.CancelPipelineRun(ctx, &pb.CancelPipelineRunRequest{Id: run.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, PipelineRunCancelled, run.Status)
+	assert.Equal(t, PipelineStepCancelled, runSteps(run)["one"].Status)
+	assert.Equal(t, PipelineStepCancelled, runSteps(run)["two"].Status)
+
+	job, err := jobs.GetJob(ctx, &pb.GetJobRequest{Id: jobID})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_CANCELLED, job.State)
+
+	_, err = svc.CancelPipelineRun(ctx, &pb.CancelPipelineRunRequest{Id: run.Id})
+	assert.Error(t, err)
+
+	// Updating the pipeline does not touch existing runs
+	updated, err := svc.UpdatePipeline(ctx, &pb.UpdatePipelineRequest{Id: p.Id, Name: "Renamed", Steps: []*pb.PipelineStep{{Id: "only", Job: pipelineJob("x")}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Renamed", updated.Name)
+	run, err = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "Sequence", run.PipelineName)
+	assert.Len(t, run.Steps, 2)
+
+	_, err = svc.DeletePipeline(ctx, &pb.DeletePipelineRequest{Id: p.Id})
+	assert.NoError(t, err)
+	_, err = svc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.Error(t, err)
+	_, err = svc.GetPipeline(ctx, &pb.GetPipelineRequest{Id: p.Id})
+	assert.Error(t, err)
+}
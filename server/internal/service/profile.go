@@ -59,18 +59,25 @@ func (s *ProfileServer) CreateProfile(ctx context.Context, req *pb.CreateProfile
 }
 
 func (s *ProfileServer) DeleteProfile(ctx context.Context, req *pb.DeleteProfileRequest) (*emptypb.Empty, error) {
-	if req.Id == "" {
-		return nil, fmt.Errorf("id is required")
+	if err := deleteProfile(ctx, s.DB, req.Id); err != nil {
+		return nil, err
 	}
+	return &emptypb.Empty{}, nil
+}
 
-	if req.Id == "default" {
-		return nil, fmt.Errorf("cannot delete default profile")
+func deleteProfile(ctx context.Context, db execer, id string) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
 	}
 
-	_, err := s.DB.Exec("DELETE FROM profiles WHERE id = ?", req.Id)
+	if id == "default" {
+		return fmt.Errorf("cannot delete default profile")
+	}
+
+	_, err := db.ExecContext(ctx, "DELETE FROM profiles WHERE id = ?", id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete profile: %w", err)
+		return fmt.Errorf("failed to delete profile: %w", err)
 	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	pb "github.com/mcpany/jules/proto"
+)
+
+// Tracked PR states, stored in the pull_requests table.
+const (
+	PRStateOpen   = "open"
+	PRStateClosed = "closed"
+	PRStateMerged = "merged"
+)
+
+type PullRequestServer struct {
+	pb.UnimplementedPullRequestServiceServer
+	DB *sql.DB
+}
+
+func (s *PullRequestServer) ListTrackedPullRequests(ctx context.Context, req *pb.ListTrackedPullRequestsRequest) (*pb.ListTrackedPullRequestsResponse, error) {
+	switch req.State {
+	case "", PRStateOpen, PRStateClosed, PRStateMerged:
+	default:
+		return nil, fmt.Errorf("invalid state %q: must be %s, %s or %s", req.State, PRStateOpen, PRStateClosed, PRStateMerged)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	var where []string
+	var args []interface{}
+	if req.Repo != "" {
+		where = append(where, "repo = ?")
+		args = append(args, req.Repo)
+	}
+	if req.State != "" {
+		where = append(where, "state = ?")
+		args = append(args, req.State)
+	}
+	query := `
+		SELECT repo, number, url, session_id, head_sha, state, last_status, nag_count, first_failed_at,
+			last_action, last_action_at, merged_by_hub, closed_by_hub, created_at, updated_at, last_report_at, escalated_at
+		FROM pull_requests`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY updated_at DESC, repo, number DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracked pull requests: %w", err)
+	}
+	defer rows.Close()
+
+	resp := &pb.ListTrackedPullRequestsResponse{}
+	for rows.Next() {
+		var p pb.TrackedPullRequest
+		var sessionID, headSHA, lastStatus, firstFailedAt, lastAction, lastActionAt, lastReportAt, escalatedAt sql.NullString
+		if err := rows.Scan(&p.Repo, &p.Number, &p.Url, &sessionID, &headSHA, &p.State, &lastStatus, &p.NagCount, &firstFailedAt,
+			&lastAction, &lastActionAt, &p.MergedByHub, &p.ClosedByHub, &p.CreatedAt, &p.UpdatedAt, &lastReportAt, &escalatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tracked pull request: %w", err)
+		}
+		p.SessionId, p.HeadSha, p.LastStatus = sessionID.String, headSHA.String, lastStatus.String
+		p.FirstFailedAt, p.LastAction, p.LastActionAt = firstFailedAt.String, lastAction.String, lastActionAt.String
+		p.LastReportAt, p.EscalatedAt = lastReportAt.String, escalatedAt.String
+		resp.PullRequests = append(resp.PullRequests, &p)
+	}
+	return resp, rows.Err()
+}
+
+// ListFlakyChecks returns the checks that passed when the PR monitor reran them after they
+// failed, by flake rate.
+func (s *PullRequestServer) ListFlakyChecks(ctx context.Context, req *pb.ListFlakyChecksRequest) (*pb.ListFlakyChecksResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	minFlakes := req.MinFlakes
+	if minFlakes <= 0 {
+		minFlakes = 1
+	}
+
+	query := "SELECT " + flakyCheckColumns + " FROM check_flakes WHERE flakes >= ?"
+	args := []interface{}{minFlakes}
+	if req.Repo != "" {
+		query += " AND repo = ?"
+		args = append(args, req.Repo)
+	}
+	query += " ORDER BY CAST(flakes AS REAL) / MAX(failures, 1) DESC, flakes DESC, repo, check_name LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flaky checks: %w", err)
+	}
+	defer rows.Close()
+
+	resp := &pb.ListFlakyChecksResponse{}
+	for rows.Next() {
+		c, err := scanFlakyCheck(rows)
+		if err != nil {
+			return nil, err
+		}
+		resp.Checks = append(resp.Checks, c)
+	}
+	return resp, rows.Err()
+}
+
+// SetFlakyCheckIgnored sets whether the PR monitor ignores the failures of a check. Only checks
+// the monitor has seen fail can be ignored.
+func (s *PullRequestServer) SetFlakyCheckIgnored(ctx context.Context, req *pb.SetFlakyCheckIgnoredRequest) (*pb.FlakyCheck, error) {
+	if req.Repo == "" || req.CheckName == "" {
+		return nil, fmt.Errorf("repo and check name are required")
+	}
+	res, err := s.DB.ExecContext(ctx, "UPDATE check_flakes SET ignored = ? WHERE repo = ? AND check_name = ?", req.Ignored, req.Repo, req.CheckName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update flaky check: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, fmt.Errorf("check %q of %s not found", req.CheckName, req.Repo)
+	}
+	row := s.DB.QueryRowContext(ctx, "SELECT "+flakyCheckColumns+" FROM check_flakes WHERE repo = ? AND check_name = ?", req.Repo, req.CheckName)
+	return scanFlakyCheck(row)
+}
+
+const flakyCheckColumns = "repo, check_name, failures, reruns, flakes, ignored, last_failed_at, last_flake_at"
+
+func scanFlakyCheck(row scanner) (*pb.FlakyCheck, error) {
+	var c pb.FlakyCheck
+	var lastFailedAt, lastFlakeAt sql.NullString
+	if err := row.Scan(&c.Repo, &c.CheckName, &c.Failures, &c.Reruns, &c.Flakes, &c.Ignored, &lastFailedAt, &lastFlakeAt); err != nil {
+		return nil, fmt.Errorf("failed to scan flaky check: %w", err)
+	}
+	c.LastFailedAt, c.LastFlakeAt = lastFailedAt.String, lastFlakeAt.String
+	if c.Failures > 0 {
+		c.FlakeRate = float64(c.Flakes) / float64(c.Failures)
+	}
+	return &c, nil
+}
+
+// ListMergeDecisions returns the decisions of the auto-merge policy, newest first.
+func (s *PullRequestServer) ListMergeDecisions(ctx context.Context, req *pb.ListMergeDecisionsRequest) (*pb.ListMergeDecisionsResponse, error) {
+	if req.Number != 0 && req.Repo == "" {
+		return nil, fmt.Errorf("repo is required with a PR number")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	var where []string
+	var args []interface{}
+	if req.Repo != "" {
+		where = append(where, "repo = ?")
+		args = append(args, req.Repo)
+	}
+	if req.Number != 0 {
+		where = append(where, "number = ?")
+		args = append(args, req.Number)
+	}
+	query := "SELECT id, repo, number, head_sha, merged, reason, created_at FROM merge_decisions"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY created_at DESC, rowid DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merge decisions: %w", err)
+	}
+	defer rows.Close()
+
+	resp := &pb.ListMergeDecisionsResponse{}
+	for rows.Next() {
+		var d pb.MergeDecision
+		var headSHA sql.NullString
+		if err := rows.Scan(&d.Id, &d.Repo, &d.Number, &headSHA, &d.Merged, &d.Reason, &d.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan merge decision: %w", err)
+		}
+		d.HeadSha = headSHA.String
+		resp.Decisions = append(resp.Decisions, &d)
+	}
+	return resp, rows.Err()
+}
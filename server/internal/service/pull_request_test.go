@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestService_ListTrackedPullRequests(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &PullRequestServer{DB: db}
+	ctx := context.Background()
+
+	_, err := db.Exec(`
+		INSERT INTO pull_requests (repo, number, url, session_id, head_sha, state, last_status, nag_count, first_failed_at,
+			last_action, last_action_at, merged_by_hub, created_at, updated_at) VALUES
+		('o/r', 1, 'https://github.com/o/r/pull/1', 's1', 'abc', 'merged', 'success', 0, NULL, 'merged', '2024-01-02T00:00:00Z', 1, '2024-01-01T00:00:00Z', '2024-01-02T00:00:00Z'),
+		('o/r', 2, 'https://github.com/o/r/pull/2', NULL, 'def', 'open', 'failure', 2, '2024-01-03T00:00:00Z', 'reported failure', '2024-01-03T00:00:00Z', 0, '2024-01-01T00:00:00Z', '2024-01-03T00:00:00Z'),
+		('ghe.example.com/o/x', 3, 'https://ghe.example.com/o/x/pull/3', NULL, NULL, 'open', NULL, 0, NULL, NULL, NULL, 0, '2024-01-01T00:00:00Z', '2024-01-01T00:00:00Z')`)
+	assert.NoError(t, err)
+
+	resp, err := svc.ListTrackedPullRequests(ctx, &pb.ListTrackedPullRequestsRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.PullRequests, 3) {
+		p := resp.PullRequests[0]
+		assert.Equal(t, int32(2), p.Number)
+		assert.Equal(t, "failure", p.LastStatus)
+		assert.Equal(t, int32(2), p.NagCount)
+		assert.Equal(t, "2024-01-03T00:00:00Z", p.FirstFailedAt)
+		assert.Equal(t, "", p.SessionId)
+		assert.True(t, resp.PullRequests[1].MergedByHub)
+		assert.Equal(t, "s1", resp.PullRequests[1].SessionId)
+	}
+
+	resp, err = svc.ListTrackedPullRequests(ctx, &pb.ListTrackedPullRequestsRequest{State: "open", Repo: "o/r"})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.PullRequests, 1) {
+		assert.Equal(t, int32(2), resp.PullRequests[0].Number)
+	}
+
+	resp, err = svc.ListTrackedPullRequests(ctx, &pb.ListTrackedPullRequestsRequest{Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, resp.PullRequests, 1)
+
+	_, err = svc.ListTrackedPullRequests(ctx, &pb.ListTrackedPullRequestsRequest{State: "draft"})
+	assert.Error(t, err)
+}
+
+func TestPullRequestService_FlakyChecks(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &PullRequestServer{DB: db}
+	ctx := context.Background()
+
+	_, err := db.Exec(`
+		INSERT INTO check_flakes (repo, check_name, failures, reruns, flakes, last_failed_at, last_flake_at) VALUES
+		('o/r', 'e2e', 4, 4, 3, '2024-01-03T00:00:00Z', '2024-01-03T00:00:00Z'),
+		('o/r', 'lint', 5, 5, 1, '2024-01-02T00:00:00Z', '2024-01-01T00:00:00Z'),
+		('o/r', 'unit', 2, 2, 0, '2024-01-02T00:00:00Z', NULL),
+		('o/x', 'e2e', 1, 1, 1, '2024-01-02T00:00:00Z', '2024-01-02T00:00:00Z')`)
+	assert.NoError(t, err)
+
+	resp, err := svc.ListFlakyChecks(ctx, &pb.ListFlakyChecksRequest{Repo: "o/r"})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Checks, 2) {
+		c := resp.Checks[0]
+		assert.Equal(t, "e2e", c.CheckName)
+		assert.Equal(t, int32(4), c.Failures)
+		assert.Equal(t, int32(3), c.Flakes)
+		assert.Equal(t, 0.75, c.FlakeRate)
+		assert.Equal(t, "2024-01-03T00:00:00Z", c.LastFlakeAt)
+		assert.Equal(t, "lint", resp.Checks[1].CheckName)
+	}
+
+	resp, err = svc.ListFlakyChecks(ctx, &pb.ListFlakyChecksRequest{MinFlakes: 2})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Checks, 1)
+
+	c, err := svc.SetFlakyCheckIgnored(ctx, &pb.SetFlakyCheckIgnoredRequest{Repo: "o/r", CheckName: "e2e", Ignored: true})
+	assert.NoError(t, err)
+	assert.True(t, c.Ignored)
+	var ignored bool
+	assert.NoError(t, db.QueryRow("SELECT ignored FROM check_flakes WHERE repo = 'o/x' AND check_name = 'e2e'").Scan(&ignored))
+	assert.False(t, ignored)
+
+	_, err = svc.SetFlakyCheckIgnored(ctx, &pb.SetFlakyCheckIgnoredRequest{Repo: "o/r", CheckName: "build", Ignored: true})
+	assert.Error(t, err)
+}
+
+func TestPullRequestService_ListMergeDecisions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &PullRequestServer{DB: db}
+	ctx := context.Background()
+
+	_, err := db.Exec(`
+		INSERT INTO merge_decisions (id, repo, number, head_sha, merged, reason, created_at) VALUES
+		('d1', 'o/r', 1, 'abc', 0, 'required check test is pending', '2024-01-01T00:00:00Z'),
+		('d2', 'o/r', 1, 'abc', 1, 'all policy checks passed', '2024-01-01T00:05:00Z'),
+		('d3', 'o/r', 2, NULL, 0, 'PR has 2 approvals, 1 required', '2024-01-02T00:00:00Z'),
+		('d4', 'o/x', 1, 'def', 0, 'PR is not in the auto-merge scope', '2024-01-03T00:00:00Z')`)
+	assert.NoError(t, err)
+
+	resp, err := svc.ListMergeDecisions(ctx, &pb.ListMergeDecisionsRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Decisions, 4) {
+		assert.Equal(t, "d4", resp.Decisions[0].Id)
+		assert.Equal(t, "", resp.Decisions[1].HeadSha)
+	}
+
+	resp, err = svc.ListMergeDecisions(ctx, &pb.ListMergeDecisionsRequest{Repo: "o/r", Number: 1, Limit: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Decisions, 1) {
+		d := resp.Decisions[0]
+		assert.True(t, d.Merged)
+		assert.Equal(t, "all policy checks passed", d.Reason)
+		assert.Equal(t, "abc", d.HeadSha)
+	}
+
+	_, err = svc.ListMergeDecisions(ctx, &pb.ListMergeDecisionsRequest{Number: 1})
+	assert.Error(t, err)
+}
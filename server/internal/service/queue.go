@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/mcpany/jules/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultRepoConcurrency is how many sessions/PRs may be active on a repo before queued jobs wait.
+const DefaultRepoConcurrency = 1
+
+// activePRWindow bounds how long an unmerged PR counts as active. Merges are not always recorded,
+// so older PRs are assumed to be closed rather than blocking the queue forever.
+const activePRWindow = 3 * 24 * time.Hour
+
+// queueOrder is the start order of queued jobs.
+const queueOrder = "priority DESC, queue_position IS NULL, queue_position, created_at, id"
+
+type QueueServer struct {
+	pb.UnimplementedQueueServiceServer
+	DB   *sql.DB
+	Jobs *JobServer
+}
+
+// EnqueueJob creates a queued background job at the end of its repo queue.
+func (s *QueueServer) EnqueueJob(ctx context.Context, req *pb.EnqueueJobRequest) (*pb.Job, error) {
+	if req.Job == nil {
+		return nil, fmt.Errorf("job required")
+	}
+	jobReq := proto.Clone(req.Job).(*pb.CreateJobRequest)
+	jobReq.Status = JobStatusQueued
+	jobReq.Background = true
+	return s.Jobs.CreateJob(ctx, jobReq)
+}
+
+// ListQueue returns the queued jobs of each repo, in start order, with the repo's limit and active count.
+func (s *QueueServer) ListQueue(ctx context.Context, req *pb.ListQueueRequest) (*pb.ListQueueResponse, error) {
+	repos := []string{req.Repo}
+	if req.Repo == "" {
+		var err error
+		if repos, err = s.Jobs.queuedRepos(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &pb.ListQueueResponse{}
+	for _, repo := range repos {
+		q, err := s.repoQueue(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+		resp.Repos = append(resp.Repos, q)
+	}
+	return resp, nil
+}
+
+// SetJobPriority changes the priority of a job. Queued jobs with a higher priority start first.
+func (s *QueueServer) SetJobPriority(ctx context.Context, req *pb.SetJobPriorityRequest) (*pb.Job, error) {
+	res, err := s.DB.ExecContext(ctx, "UPDATE jobs SET priority = ? WHERE id = ?", req.Priority, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update job priority: %w", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, fmt.Errorf("job not found")
+	}
+	return s.Jobs.GetJob(ctx, &pb.GetJobRequest{Id: req.Id})
+}
+
+// MoveQueuedJob moves a queued job to a position among the queued jobs of its repo with the same priority.
+func (s *QueueServer) MoveQueuedJob(ctx context.Context, req *pb.MoveQueuedJobRequest) (*pb.RepoQueue, error) {
+	job, err := s.Jobs.GetJob(ctx, &pb.GetJobRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	if job.State != pb.JobStatus_JOB_STATUS_QUEUED {
+		return nil, fmt.Errorf("job is not queued")
+	}
+	if req.Position < 0 {
+		return nil, fmt.Errorf("position must not be negative")
+	}
+
+	rows, err := s.DB.QueryContext(ctx, "SELECT id FROM jobs WHERE status = ? AND repo = ? AND priority = ? AND id != ? ORDER BY "+queueOrder,
+		JobStatusQueued, job.Repo, job.Priority, job.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queued jobs: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan queued job: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	pos := int(req.Position)
+	if pos > len(ids) {
+		pos = len(ids)
+	}
+	ids = append(ids[:pos], append([]string{job.Id}, ids[pos:]...)...)
+
+	// Renumber the group, keeping it behind jobs queued before it in other groups
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	var base int64
+	if err := tx.QueryRowContext(ctx, "SELECT COALESCE(MIN(queue_position), 1) FROM jobs WHERE status = ? AND repo = ? AND priority = ?", JobStatusQueued, job.Repo, job.Priority).Scan(&base); err != nil {
+		return nil, fmt.Errorf("failed to get queue position: %w", err)
+	}
+	for i, id := range ids {
+		if _, err := tx.ExecContext(ctx, "UPDATE jobs SET queue_position = ? WHERE id = ?", base+int64(i), id); err != nil {
+			return nil, fmt.Errorf("failed to move queued job: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.repoQueue(ctx, job.Repo)
+}
+
+// SetRepoConcurrency sets how many sessions/PRs may be active on a repo. 0 resets it to the default.
+func (s *QueueServer) SetRepoConcurrency(ctx context.Context, req *pb.SetRepoConcurrencyRequest) (*pb.RepoQueue, error) {
+	if err := ValidateRepo(req.Repo); err != nil {
+		return nil, err
+	}
+	if req.MaxActive < 0 || req.MaxActive > 100 {
+		return nil, fmt.Errorf("max active must be between 0 and 100")
+	}
+
+	var err error
+	if req.MaxActive == 0 {
+		_, err = s.DB.ExecContext(ctx, "DELETE FROM repo_queues WHERE repo = ?", req.Repo)
+	} else {
+		_, err = s.DB.ExecContext(ctx, `INSERT INTO repo_queues (repo, max_active, updated_at) VALUES (?, ?, ?)
+			ON CONFLICT(repo) DO UPDATE SET max_active = excluded.max_active, updated_at = excluded.updated_at`,
+			req.Repo, req.MaxActive, time.Now().Format(time.RFC3339))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set repo concurrency: %w", err)
+	}
+	return s.repoQueue(ctx, req.Repo)
+}
+
+func (s *QueueServer) repoQueue(ctx context.Context, repo string) (*pb.RepoQueue, error) {
+	limit, err := s.Jobs.repoConcurrency(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	active, err := s.Jobs.activeOnRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.Jobs.queuedJobs(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &pb.RepoQueue{Repo: repo, MaxActive: int32(limit), Active: int32(active)}
+	for _, id := range ids {
+		job, err := s.Jobs.GetJob(ctx, &pb.GetJobRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		q.Jobs = append(q.Jobs, job)
+	}
+	return q, nil
+}
+
+// PromoteQueuedJobs moves queued jobs to PENDING, in queue order, while their repo has fewer
+// active sessions/PRs than its limit. It returns the promoted job ids.
+func (s *JobServer) PromoteQueuedJobs(ctx context.Context) ([]string, error) {
+	repos, err := s.queuedRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var promoted []string
+	for _, repo := range repos {
+		limit, err := s.repoConcurrency(ctx, repo)
+		if err != nil {
+			return promoted, err
+		}
+		active, err := s.activeOnRepo(ctx, repo)
+		if err != nil {
+			return promoted, err
+		}
+		if active >= limit {
+			continue
+		}
+
+		ids, err := s.queuedJobs(ctx, repo)
+		if err != nil {
+			return promoted, err
+		}
+		for _, id := range ids {
+			if active >= limit {
+				break
+			}
+			var sessionCount sql.NullInt64
+			if err := s.DB.QueryRowContext(ctx, "SELECT session_count FROM jobs WHERE id = ?", id).Scan(&sessionCount); err != nil {
+				return promoted, fmt.Errorf("failed to get job: %w", err)
+			}
+			// Cancelled in the meantime
+			if err := s.TransitionJob(ctx, id, pb.JobStatus_JOB_STATUS_PENDING); err != nil {
+				continue
+			}
+			promoted = append(promoted, id)
+			if sessionCount.Int64 > 1 {
+				active += int(sessionCount.Int64)
+			} else {
+				active++
+			}
+		}
+	}
+	return promoted, nil
+}
+
+func (s *JobServer) queuedRepos(ctx context.Context) ([]string, error) {
+	rows, err := s.DB.QueryContext(ctx, "SELECT DISTINCT repo FROM jobs WHERE status = ? ORDER BY repo", JobStatusQueued)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queued repos: %w", err)
+	}
+	defer rows.Close()
+	var repos []string
+	for rows.Next() {
+		var repo string
+		if err := rows.Scan(&repo); err != nil {
+			return nil, fmt.Errorf("failed to scan repo: %w", err)
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+func (s *JobServer) queuedJobs(ctx context.Context, repo string) ([]string, error) {
+	rows, err := s.DB.QueryContext(ctx, "SELECT id FROM jobs WHERE status = ? AND repo = ? ORDER BY "+queueOrder, JobStatusQueued, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queued jobs: %w", err)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan queued job: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (s *JobServer) repoConcurrency(ctx context.Context, repo string) (int, error) {
+	var limit int
+	err := s.DB.QueryRowContext(ctx, "SELECT max_active FROM repo_queues WHERE repo = ?", repo).Scan(&limit)
+	if err == sql.ErrNoRows || (err == nil && limit <= 0) {
+		return DefaultRepoConcurrency, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to get repo concurrency: %w", err)
+	}
+	return limit, nil
+}
+
+// activeOnRepo counts the sessions of the repo's jobs that are about to be created, still running,
+// or waiting for their PR to be merged.
+func (s *JobServer) activeOnRepo(ctx context.Context, repo string) (int, error) {
+	cutoff := time.Now().UTC().Add(-activePRWindow).Format(time.RFC3339)
+
+	// Sessions of pending jobs that have no slots yet
+	var pending int
+	err := s.DB.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(MAX(COALESCE(session_count, 1), 1)), 0) FROM jobs
+		WHERE repo = ? AND status IN (?, ?) AND NOT EXISTS (SELECT 1 FROM job_sessions js WHERE js.job_id = jobs.id)`,
+		repo, JobStatusPending, JobStatusProcessing).Scan(&pending)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending sessions: %w", err)
+	}
+
+	var active int
+	err = s.DB.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM job_sessions js
+		JOIN jobs j ON j.id = js.job_id
+		LEFT JOIN sessions s ON s.id = js.session_id
+		WHERE j.repo = ? AND j.status != ? AND (
+			(js.status IN (?, ?) AND j.status IN (?, ?))
+			OR (js.status = ? AND (
+				s.id IS NULL
+				OR COALESCE(s.state, '') NOT IN ('COMPLETED', 'FAILED')
+				OR (COALESCE(s.pr_url, '') != '' AND COALESCE(s.is_pr_merged, 0) = 0 AND COALESCE(s.create_time, '') >= ?)
+			))
+		)`,
+		repo, JobStatusCancelled,
+		SlotStatusPending, SlotStatusCreating, JobStatusPending, JobStatusProcessing,
+		SlotStatusCreated, cutoff).Scan(&active)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active sessions: %w", err)
+	}
+	return pending + active, nil
+}
+
+// appendToQueue puts a queued job behind every job queued so far.
+func (s *JobServer) appendToQueue(ctx context.Context, id string) error {
+	_, err := s.DB.ExecContext(ctx, "UPDATE jobs SET queue_position = (SELECT COALESCE(MAX(queue_position), 0) + 1 FROM jobs) WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to queue job: %w", err)
+	}
+	return nil
+}
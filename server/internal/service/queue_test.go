@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func queueIDs(q *pb.RepoQueue) []string {
+	var ids []string
+	for _, j := range q.Jobs {
+		ids = append(ids, j.Id)
+	}
+	return ids
+}
+
+func TestQueueService_Order(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	jobs := &JobServer{DB: db}
+	svc := &QueueServer{DB: db, Jobs: jobs}
+	ctx := context.Background()
+
+	for _, id := range []string{"q1", "q2", "q3"} {
+		job, err := svc.EnqueueJob(ctx, &pb.EnqueueJobRequest{Job: &pb.CreateJobRequest{Id: id, Name: id, Repo: "org/a", Branch: "main", SessionCount: 1}})
+		assert.NoError(t, err)
+		assert.Equal(t, pb.JobStatus_JOB_STATUS_QUEUED, job.State)
+	}
+
+	resp, err := svc.ListQueue(ctx, &pb.ListQueueRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Repos, 1)
+	assert.Equal(t, int32(DefaultRepoConcurrency), resp.Repos[0].MaxActive)
+	assert.Equal(t, []string{"q1", "q2", "q3"}, queueIDs(resp.Repos[0]))
+
+	// Reorder within the same priority, then bump a job ahead of everything
+	q, err := svc.MoveQueuedJob(ctx, &pb.MoveQueuedJobRequest{Id: "q3", Position: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"q3", "q1", "q2"}, queueIDs(q))
+
+	_, err = svc.SetJobPriority(ctx, &pb.SetJobPriorityRequest{Id: "q2", Priority: 5})
+	assert.NoError(t, err)
+	resp, err = svc.ListQueue(ctx, &pb.ListQueueRequest{Repo: "org/a"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"q2", "q3", "q1"}, queueIDs(resp.Repos[0]))
+
+	_, err = svc.EnqueueJob(ctx, &pb.EnqueueJobRequest{Job: &pb.CreateJobRequest{Name: "m", Matrix: &pb.JobMatrix{Targets: []*pb.JobTarget{{Repo: "org/a", Branch: "main"}}}}})
+	assert.Error(t, err)
+	_, err = svc.MoveQueuedJob(ctx, &pb.MoveQueuedJobRequest{Id: "missing"})
+	assert.Error(t, err)
+}
+
+func TestQueueService_PromoteRespectsRepoLimit(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	jobs := &JobServer{DB: db}
+	svc := &QueueServer{DB: db, Jobs: jobs}
+	ctx := context.Background()
+
+	for _, req := range []*pb.CreateJobRequest{
+		{Id: "a1", Name: "a1", Repo: "org/a", Branch: "main", SessionCount: 1},
+		{Id: "a2", Name: "a2", Repo: "org/a", Branch: "main", SessionCount: 1},
+		{Id: "a3", Name: "a3", Repo: "org/a", Branch: "main", SessionCount: 1},
+		{Id: "b1", Name: "b1", Repo: "org/b", Branch: "main", SessionCount: 1},
+	} {
+		_, err := svc.EnqueueJob(ctx, &pb.EnqueueJobRequest{Job: req})
+		assert.NoError(t, err)
+	}
+
+	promoted, err := jobs.PromoteQueuedJobs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a1", "b1"}, promoted)
+
+	// a1 is pending, so org/a is full
+	promoted, err = jobs.PromoteQueuedJobs(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, promoted)
+
+	// a1's session runs and then opens a PR: still active
+	a1, err := jobs.GetJob(ctx, &pb.GetJobRequest{Id: "a1"})
+	assert.NoError(t, err)
+	assert.NoError(t, jobs.TransitionJob(ctx, "a1", pb.JobStatus_JOB_STATUS_PROCESSING))
+	assert.NoError(t, jobs.InitJobSessions(ctx, a1))
+	assert.NoError(t, jobs.UpdateJobSession(ctx, "a1", &pb.JobSessionSlot{SlotIndex: 0, SessionId: "s1", Status: SlotStatusCreated, Attempts: 1}))
+	assert.NoError(t, jobs.TransitionJob(ctx, "a1", pb.JobStatus_JOB_STATUS_COMPLETED))
+	_, err = db.Exec("INSERT INTO sessions (id, name, state, pr_url, create_time) VALUES ('s1', 'sessions/s1', 'IN_PROGRESS', NULL, ?)", time.Now().UTC().Format(time.RFC3339))
+	assert.NoError(t, err)
+	promoted, err = jobs.PromoteQueuedJobs(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, promoted)
+
+	_, err = db.Exec("UPDATE sessions SET state = 'COMPLETED', pr_url = 'https://github.com/org/a/pull/1' WHERE id = 's1'")
+	assert.NoError(t, err)
+	promoted, err = jobs.PromoteQueuedJobs(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, promoted)
+
+	// Raising the limit lets the next job start
+	q, err := svc.SetRepoConcurrency(ctx, &pb.SetRepoConcurrencyRequest{Repo: "org/a", MaxActive: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), q.MaxActive)
+	assert.Equal(t, int32(1), q.Active)
+	promoted, err = jobs.PromoteQueuedJobs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a2"}, promoted)
+
+	// Once the PR merges there is room for one more
+	_, err = db.Exec("UPDATE sessions SET is_pr_merged = 1 WHERE id = 's1'")
+	assert.NoError(t, err)
+	promoted, err = jobs.PromoteQueuedJobs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a3"}, promoted)
+
+	_, err = svc.SetRepoConcurrency(ctx, &pb.SetRepoConcurrencyRequest{Repo: "org/a", MaxActive: -1})
+	assert.Error(t, err)
+	q, err = svc.SetRepoConcurrency(ctx, &pb.SetRepoConcurrencyRequest{Repo: "org/a"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(DefaultRepoConcurrency), q.MaxActive)
+}
@@ -269,6 +269,15 @@ func (s *SessionServer) UpdateSession(ctx context.Context, req *pb.UpdateSession
 }
 
 func (s *SessionServer) DeleteSession(ctx context.Context, req *pb.DeleteSessionRequest) (*emptypb.Empty, error) {
+	if req.DeleteRemote {
+		if !isValidSessionID(req.Id) {
+			return nil, fmt.Errorf("invalid session id")
+		}
+		if err := s.deleteRemoteSession(req.Id); err != nil {
+			return nil, fmt.Errorf("remote delete failed: %w", err)
+		}
+	}
+
 	_, err := s.DB.Exec("DELETE FROM sessions WHERE id = ?", req.Id)
 	if err != nil {
 		return nil, err
@@ -396,6 +405,37 @@ func (s *SessionServer) approveRemotePlan(id string) error {
 	return nil
 }
 
+// deleteRemoteSession deletes the session from the Jules API, which also stops it.
+// A session that no longer exists remotely is treated as deleted.
+func (s *SessionServer) deleteRemoteSession(id string) error {
+	apiKey := s.getAPIKey()
+	if apiKey == "" {
+		return fmt.Errorf("JULES_API_KEY not set")
+	}
+
+	url := fmt.Sprintf("%s/sessions/%s", s.getBaseURL(), id)
+	client := s.getClient()
+	r, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set("X-Goog-Api-Key", apiKey)
+
+	resp, err := client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		b, _ := io.ReadAll(resp.Body)
+		sanitized := sanitizeErrorBody(b)
+		return fmt.Errorf("remote delete failed %d: %s", resp.StatusCode, sanitized)
+	}
+	return nil
+}
+
 // sanitizeErrorBody attempts to extract a clean error message from the response body.
 // It avoids logging the full body which might contain sensitive information.
 func sanitizeErrorBody(body []byte) string {
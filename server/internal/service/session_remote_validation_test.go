@@ -78,3 +78,51 @@ func TestCreateSession_InvalidRemoteID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, sess.Id, id)
 }
+
+func TestDeleteSession_Remote(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	var method, path string
+	status := http.StatusOK
+	svc := &SessionServer{
+		DB: db,
+		HTTPClient: &http.Client{
+			Transport: &MockRoundTripper{
+				RoundTripFunc: func(req *http.Request) *http.Response {
+					method, path = req.Method, req.URL.Path
+					return &http.Response{
+						StatusCode: status,
+						Body:       io.NopCloser(bytes.NewBufferString(`{"error": {"message": "boom"}}`)),
+						Header:     make(http.Header),
+					}
+				},
+			},
+		},
+		BaseURL: "https://mock.api",
+		Limiter: ratelimit.New(1 * time.Nanosecond),
+	}
+	t.Setenv("JULES_API_KEY", "dummy-key")
+	ctx := context.Background()
+
+	_, err := db.Exec("INSERT INTO sessions (id, name, state) VALUES ('s1', 'sessions/s1', 'IN_PROGRESS'), ('s2', 'sessions/s2', 'IN_PROGRESS')")
+	assert.NoError(t, err)
+
+	_, err = svc.DeleteSession(ctx, &pb.DeleteSessionRequest{Id: "s1", DeleteRemote: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE", method)
+	assert.Equal(t, "/sessions/s1", path)
+
+	// Remote failure keeps the local row
+	status = http.StatusInternalServerError
+	_, err = svc.DeleteSession(ctx, &pb.DeleteSessionRequest{Id: "s2", DeleteRemote: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+
+	var count int
+	db.QueryRow("SELECT COUNT(*) FROM sessions").Scan(&count)
+	assert.Equal(t, 1, count)
+
+	_, err = svc.DeleteSession(ctx, &pb.DeleteSessionRequest{Id: "../x", DeleteRemote: true})
+	assert.Error(t, err)
+}
@@ -4,10 +4,43 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	pb "github.com/mcpany/jules/proto"
 )
 
+// DefaultIssueAutomationLabel is the label that marks issues for the issue automation.
+const DefaultIssueAutomationLabel = "jules"
+
+// Escalations of PRs whose checks keep failing after check_failing_actions_threshold failure reports.
+const (
+	EscalationLabel           = "label"
+	EscalationRequestReviewer = "request_reviewer"
+	EscalationClose           = "close"
+	EscalationNotify          = "notify"
+)
+
+// DefaultEscalationLabel is the label added to PRs escalated with EscalationLabel.
+const DefaultEscalationLabel = "needs-human"
+
+// Scopes of the auto-merge policy: the PRs that may be merged.
+const (
+	AutoMergeScopeAll      = "all"
+	AutoMergeScopeSessions = "sessions" // PRs opened by Jules sessions or carrying the auto-merge label
+	AutoMergeScopeLabeled  = "labeled"  // PRs carrying the auto-merge label
+)
+
+// DefaultAutoMergeLabel is the label that opts PRs into auto-merge.
+const DefaultAutoMergeLabel = "automerge"
+
+// maxIssueAutomationLabelLength leaves room for the in-progress suffix within GitHub's 50 character label limit.
+const maxIssueAutomationLabelLength = 38
+
+// IssueInProgressLabel is the label added to an issue once the issue automation started a job for it.
+func IssueInProgressLabel(label string) string {
+	return label + ":in-progress"
+}
+
 type SettingsServer struct {
 	pb.UnimplementedSettingsServiceServer
 	DB *sql.DB
@@ -19,7 +52,8 @@ func (s *SettingsServer) GetSettings(ctx context.Context, req *pb.GetSettingsReq
 		profileId = "default"
 	}
 
-	query := `SELECT id, idle_poll_interval, active_poll_interval, title_truncate_length, line_clamp, session_items_per_page, jobs_per_page, default_session_count, pr_status_poll_interval, theme, auto_approval_interval, auto_retry_enabled, auto_retry_message, auto_continue_enabled, auto_continue_message, session_cache_in_progress_interval, session_cache_completed_no_pr_interval, session_cache_pending_approval_interval, session_cache_max_age_days, auto_delete_stale_branches, auto_delete_stale_branches_after_days, check_failing_actions_enabled, check_failing_actions_interval, check_failing_actions_threshold, auto_close_stale_conflicted_prs, stale_conflicted_prs_duration_days, history_prompts_count, min_session_interaction_interval, retry_timeout, profile_id, auto_approval_enabled, auto_approval_all_sessions, auto_continue_all_sessions, auto_merge_enabled, auto_merge_method, COALESCE(auto_merge_message, ''), COALESCE(auto_close_on_conflict_message, ''), close_pr_on_conflict_enabled FROM settings WHERE profile_id = ? LIMIT 1`
+	query := `SELECT id, idle_poll_interval, active_poll_interval, title_truncate_length, line_clamp, session_items_per_page, jobs_per_page, default_session_count, pr_status_poll_interval, theme, auto_approval_interval, auto_retry_enabled, auto_retry_message, auto_continue_enabled, auto_continue_message, session_cache_in_progress_interval, session_cache_completed_no_pr_interval, session_cache_pending_approval_interval, session_cache_max_age_days, auto_delete_stale_branches, auto_delete_stale_branches_after_days, check_failing_actions_enabled, check_failing_actions_interval, check_failing_actions_threshold, auto_close_stale_conflicted_prs, stale_conflicted_prs_duration_days, history_prompts_count, min_session_interaction_interval, retry_timeout, profile_id, auto_approval_enabled, auto_approval_all_sessions, auto_continue_all_sessions, auto_merge_enabled, auto_merge_method, COALESCE(auto_merge_message, ''), COALESCE(auto_close_on_conflict_message, ''), close_pr_on_conflict_enabled, COALESCE(max_concurrent_background_workers, 5), COALESCE(issue_automation_enabled, 0), COALESCE(issue_automation_label, 'jules'), COALESCE(issue_automation_repos, ''), COALESCE(chat_ops_enabled, 0), COALESCE(check_failing_actions_escalation, 'label'), COALESCE(check_failing_actions_escalation_target, ''), COALESCE(flaky_check_max_reruns, 1), COALESCE(informational_checks, ''),
+		COALESCE(auto_merge_scope, 'all'), COALESCE(auto_merge_label, 'automerge'), COALESCE(auto_merge_required_approvals, 0), COALESCE(auto_merge_block_changes_requested, 1), COALESCE(auto_merge_max_additions, 0), COALESCE(auto_merge_max_deletions, 0), COALESCE(auto_merge_max_files, 0), COALESCE(auto_merge_protected_paths, ''), COALESCE(auto_merge_require_base_green, 0) FROM settings WHERE profile_id = ? LIMIT 1`
 
 	var settings pb.Settings
 	err := s.DB.QueryRow(query, profileId).Scan(
@@ -34,8 +68,13 @@ func (s *SettingsServer) GetSettings(ctx context.Context, req *pb.GetSettingsReq
 		&settings.MinSessionInteractionInterval, &settings.RetryTimeout, &settings.ProfileId, &settings.AutoApprovalEnabled,
 		&settings.AutoApprovalAllSessions, &settings.AutoContinueAllSessions, &settings.AutoMergeEnabled, &settings.AutoMergeMethod,
 		&settings.AutoMergeMessage, &settings.AutoCloseOnConflictMessage, &settings.ClosePrOnConflictEnabled,
+		&settings.MaxConcurrentBackgroundWorkers, &settings.IssueAutomationEnabled, &settings.IssueAutomationLabel, &settings.IssueAutomationRepos,
+		&settings.ChatOpsEnabled, &settings.CheckFailingActionsEscalation, &settings.CheckFailingActionsEscalationTarget,
+		&settings.FlakyCheckMaxReruns, &settings.InformationalChecks,
+		&settings.AutoMergeScope, &settings.AutoMergeLabel, &settings.AutoMergeRequiredApprovals, &settings.AutoMergeBlockChangesRequested,
+		&settings.AutoMergeMaxAdditions, &settings.AutoMergeMaxDeletions, &settings.AutoMergeMaxFiles, &settings.AutoMergeProtectedPaths,
+		&settings.AutoMergeRequireBaseGreen,
 	)
-	settings.MaxConcurrentBackgroundWorkers = 5
 
 	if err == sql.ErrNoRows {
 		return &pb.Settings{
@@ -62,6 +101,11 @@ func (s *SettingsServer) GetSettings(ctx context.Context, req *pb.GetSettingsReq
 			CheckFailingActionsEnabled:          true,
 			CheckFailingActionsInterval:         600,
 			CheckFailingActionsThreshold:        10,
+			CheckFailingActionsEscalation:       EscalationLabel,
+			FlakyCheckMaxReruns:                 1,
+			AutoMergeScope:                      AutoMergeScopeAll,
+			AutoMergeLabel:                      DefaultAutoMergeLabel,
+			AutoMergeBlockChangesRequested:      true,
 			AutoCloseStaleConflictedPrs:         false,
 			StaleConflictedPrsDurationDays:      3,
 			HistoryPromptsCount:                 10,
@@ -75,6 +119,8 @@ func (s *SettingsServer) GetSettings(ctx context.Context, req *pb.GetSettingsReq
 			AutoMergeMethod:                     "squash",
 			AutoMergeMessage:                    "Automatically merged by bot as all checks passed",
 			ClosePrOnConflictEnabled:            false,
+			IssueAutomationEnabled:              false,
+			IssueAutomationLabel:                DefaultIssueAutomationLabel,
 		}, nil
 	} else if err != nil {
 		return nil, fmt.Errorf("failed to scan settings: %w", err)
@@ -84,9 +130,16 @@ func (s *SettingsServer) GetSettings(ctx context.Context, req *pb.GetSettingsReq
 }
 
 func (s *SettingsServer) UpdateSettings(ctx context.Context, req *pb.UpdateSettingsRequest) (*pb.UpdateSettingsResponse, error) {
-	newSettings := req.Settings
+	if err := saveSettings(ctx, s.DB, req.Settings); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateSettingsResponse{Success: true}, nil
+}
+
+// saveSettings validates the settings of a profile and creates or updates them.
+func saveSettings(ctx context.Context, db execer, newSettings *pb.Settings) error {
 	if newSettings == nil {
-		return nil, fmt.Errorf("settings are required")
+		return fmt.Errorf("settings are required")
 	}
 
 	// Validate inputs
@@ -94,34 +147,88 @@ func (s *SettingsServer) UpdateSettings(ctx context.Context, req *pb.UpdateSetti
 	case "light", "dark", "system":
 		// ok
 	default:
-		return nil, fmt.Errorf("invalid theme: %s", newSettings.GetTheme())
+		return fmt.Errorf("invalid theme: %s", newSettings.GetTheme())
 	}
 
 	switch newSettings.GetAutoMergeMethod() {
 	case "merge", "squash", "rebase":
 		// ok
 	default:
-		return nil, fmt.Errorf("invalid auto merge method: %s", newSettings.GetAutoMergeMethod())
+		return fmt.Errorf("invalid auto merge method: %s", newSettings.GetAutoMergeMethod())
 	}
 
 	if len(newSettings.GetAutoRetryMessage()) > 1000 {
-		return nil, fmt.Errorf("auto retry message is too long (max 1000 characters)")
+		return fmt.Errorf("auto retry message is too long (max 1000 characters)")
 	}
 	if len(newSettings.GetAutoContinueMessage()) > 1000 {
-		return nil, fmt.Errorf("auto continue message is too long (max 1000 characters)")
+		return fmt.Errorf("auto continue message is too long (max 1000 characters)")
 	}
 	if len(newSettings.GetAutoMergeMessage()) > 1000 {
-		return nil, fmt.Errorf("auto merge message is too long (max 1000 characters)")
+		return fmt.Errorf("auto merge message is too long (max 1000 characters)")
 	}
 	if len(newSettings.GetAutoCloseOnConflictMessage()) > 1000 {
-		return nil, fmt.Errorf("auto close on conflict message is too long (max 1000 characters)")
+		return fmt.Errorf("auto close on conflict message is too long (max 1000 characters)")
+	}
+
+	switch newSettings.GetCheckFailingActionsEscalation() {
+	case "":
+		newSettings.CheckFailingActionsEscalation = EscalationLabel
+	case EscalationLabel, EscalationClose, EscalationNotify:
+	case EscalationRequestReviewer:
+		if strings.TrimSpace(newSettings.GetCheckFailingActionsEscalationTarget()) == "" {
+			return fmt.Errorf("check failing actions escalation %s needs reviewers", EscalationRequestReviewer)
+		}
+	default:
+		return fmt.Errorf("invalid check failing actions escalation: %s", newSettings.GetCheckFailingActionsEscalation())
+	}
+	switch newSettings.GetAutoMergeScope() {
+	case "":
+		newSettings.AutoMergeScope = AutoMergeScopeAll
+	case AutoMergeScopeAll, AutoMergeScopeSessions, AutoMergeScopeLabeled:
+	default:
+		return fmt.Errorf("invalid auto merge scope %q: must be %s, %s or %s", newSettings.GetAutoMergeScope(), AutoMergeScopeAll, AutoMergeScopeSessions, AutoMergeScopeLabeled)
+	}
+	if strings.TrimSpace(newSettings.GetAutoMergeLabel()) == "" {
+		newSettings.AutoMergeLabel = DefaultAutoMergeLabel
+	}
+	if newSettings.GetAutoMergeRequiredApprovals() < 0 || newSettings.GetAutoMergeMaxAdditions() < 0 ||
+		newSettings.GetAutoMergeMaxDeletions() < 0 || newSettings.GetAutoMergeMaxFiles() < 0 {
+		return fmt.Errorf("auto merge approvals and limits must not be negative")
+	}
+	if len(newSettings.GetAutoMergeProtectedPaths()) > 10000 {
+		return fmt.Errorf("auto merge protected paths are too long (max 10000 characters)")
+	}
+	if newSettings.GetFlakyCheckMaxReruns() < 0 {
+		return fmt.Errorf("flaky check max reruns must not be negative")
+	}
+	if len(newSettings.GetCheckFailingActionsEscalationTarget()) > 1000 {
+		return fmt.Errorf("check failing actions escalation target is too long (max 1000 characters)")
+	}
+
+	if newSettings.GetIssueAutomationLabel() == "" {
+		newSettings.IssueAutomationLabel = DefaultIssueAutomationLabel
+	}
+	if len(newSettings.GetIssueAutomationLabel()) > maxIssueAutomationLabelLength {
+		return fmt.Errorf("issue automation label is too long (max %d characters)", maxIssueAutomationLabelLength)
+	}
+	if _, err := ParseRepoList(newSettings.GetIssueAutomationRepos()); err != nil {
+		return fmt.Errorf("invalid issue automation repos: %w", err)
+	}
+	if _, err := ParseInformationalChecks(newSettings.GetInformationalChecks()); err != nil {
+		return fmt.Errorf("invalid informational checks: %w", err)
 	}
 
 	if newSettings.GetIdlePollInterval() < 0 {
-		return nil, fmt.Errorf("idle poll interval must be positive")
+		return fmt.Errorf("idle poll interval must be positive")
 	}
 	if newSettings.GetActivePollInterval() < 0 {
-		return nil, fmt.Errorf("active poll interval must be positive")
+		return fmt.Errorf("active poll interval must be positive")
+	}
+	if newSettings.GetMaxConcurrentBackgroundWorkers() < 0 || newSettings.GetMaxConcurrentBackgroundWorkers() > 100 {
+		return fmt.Errorf("max concurrent background workers must be between 0 and 100")
+	}
+	if newSettings.GetMaxConcurrentBackgroundWorkers() == 0 {
+		newSettings.MaxConcurrentBackgroundWorkers = 5
 	}
 
 	profileId := newSettings.ProfileId
@@ -131,10 +238,10 @@ func (s *SettingsServer) UpdateSettings(ctx context.Context, req *pb.UpdateSetti
 	}
 
 	var existingId int64
-	err := s.DB.QueryRow("SELECT id FROM settings WHERE profile_id = ?", profileId).Scan(&existingId)
+	err := db.QueryRowContext(ctx, "SELECT id FROM settings WHERE profile_id = ?", profileId).Scan(&existingId)
 
 	if err == sql.ErrNoRows {
-		_, err = s.DB.Exec(`
+		_, err = db.ExecContext(ctx, `
 			INSERT INTO settings (
 				idle_poll_interval, active_poll_interval, title_truncate_length, line_clamp, 
 				session_items_per_page, jobs_per_page, default_session_count, pr_status_poll_interval, 
@@ -145,8 +252,13 @@ func (s *SettingsServer) UpdateSettings(ctx context.Context, req *pb.UpdateSetti
 				check_failing_actions_enabled, check_failing_actions_interval, check_failing_actions_threshold, 
 				auto_close_stale_conflicted_prs, stale_conflicted_prs_duration_days, history_prompts_count, 
 				min_session_interaction_interval, retry_timeout, profile_id, auto_approval_enabled,
-				auto_approval_all_sessions, auto_continue_all_sessions, auto_merge_enabled, auto_merge_method, auto_merge_message, auto_close_on_conflict_message, close_pr_on_conflict_enabled
-			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+				auto_approval_all_sessions, auto_continue_all_sessions, auto_merge_enabled, auto_merge_method, auto_merge_message, auto_close_on_conflict_message, close_pr_on_conflict_enabled,
+				max_concurrent_background_workers, issue_automation_enabled, issue_automation_label, issue_automation_repos, chat_ops_enabled,
+				check_failing_actions_escalation, check_failing_actions_escalation_target, flaky_check_max_reruns, informational_checks,
+				auto_merge_scope, auto_merge_label, auto_merge_required_approvals, auto_merge_block_changes_requested,
+				auto_merge_max_additions, auto_merge_max_deletions, auto_merge_max_files, auto_merge_protected_paths, auto_merge_require_base_green
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
+				?, ?, ?, ?, ?, ?, ?, ?, ?)
 		`,
 			newSettings.GetIdlePollInterval(), newSettings.GetActivePollInterval(), newSettings.GetTitleTruncateLength(), newSettings.GetLineClamp(),
 			newSettings.GetSessionItemsPerPage(), newSettings.GetJobsPerPage(), newSettings.GetDefaultSessionCount(), newSettings.GetPrStatusPollInterval(),
@@ -159,9 +271,17 @@ func (s *SettingsServer) UpdateSettings(ctx context.Context, req *pb.UpdateSetti
 			newSettings.GetMinSessionInteractionInterval(), newSettings.GetRetryTimeout(), newSettings.GetProfileId(), newSettings.GetAutoApprovalEnabled(),
 			newSettings.GetAutoApprovalAllSessions(), newSettings.GetAutoContinueAllSessions(),
 			newSettings.GetAutoMergeEnabled(), newSettings.GetAutoMergeMethod(), newSettings.GetAutoMergeMessage(), newSettings.GetAutoCloseOnConflictMessage(), newSettings.GetClosePrOnConflictEnabled(),
+			newSettings.GetMaxConcurrentBackgroundWorkers(),
+			newSettings.GetIssueAutomationEnabled(), newSettings.GetIssueAutomationLabel(), newSettings.GetIssueAutomationRepos(),
+			newSettings.GetChatOpsEnabled(),
+			newSettings.GetCheckFailingActionsEscalation(), newSettings.GetCheckFailingActionsEscalationTarget(),
+			newSettings.GetFlakyCheckMaxReruns(), newSettings.GetInformationalChecks(),
+			newSettings.GetAutoMergeScope(), newSettings.GetAutoMergeLabel(), newSettings.GetAutoMergeRequiredApprovals(), newSettings.GetAutoMergeBlockChangesRequested(),
+			newSettings.GetAutoMergeMaxAdditions(), newSettings.GetAutoMergeMaxDeletions(), newSettings.GetAutoMergeMaxFiles(), newSettings.GetAutoMergeProtectedPaths(),
+			newSettings.GetAutoMergeRequireBaseGreen(),
 		)
 	} else if err == nil {
-		_, err = s.DB.Exec(`
+		_, err = db.ExecContext(ctx, `
 			UPDATE settings SET
 				idle_poll_interval=?, active_poll_interval=?, title_truncate_length=?, line_clamp=?, 
 				session_items_per_page=?, jobs_per_page=?, default_session_count=?, pr_status_poll_interval=?, 
@@ -172,7 +292,11 @@ func (s *SettingsServer) UpdateSettings(ctx context.Context, req *pb.UpdateSetti
 				check_failing_actions_enabled=?, check_failing_actions_interval=?, check_failing_actions_threshold=?, 
 				auto_close_stale_conflicted_prs=?, stale_conflicted_prs_duration_days=?, history_prompts_count=?, 
 				min_session_interaction_interval=?, retry_timeout=?, auto_approval_enabled=?,
-				auto_approval_all_sessions=?, auto_continue_all_sessions=?, auto_merge_enabled=?, auto_merge_method=?, auto_merge_message=?, auto_close_on_conflict_message=?, close_pr_on_conflict_enabled=?
+				auto_approval_all_sessions=?, auto_continue_all_sessions=?, auto_merge_enabled=?, auto_merge_method=?, auto_merge_message=?, auto_close_on_conflict_message=?, close_pr_on_conflict_enabled=?,
+				max_concurrent_background_workers=?, issue_automation_enabled=?, issue_automation_label=?, issue_automation_repos=?, chat_ops_enabled=?,
+				check_failing_actions_escalation=?, check_failing_actions_escalation_target=?, flaky_check_max_reruns=?, informational_checks=?,
+				auto_merge_scope=?, auto_merge_label=?, auto_merge_required_approvals=?, auto_merge_block_changes_requested=?,
+				auto_merge_max_additions=?, auto_merge_max_deletions=?, auto_merge_max_files=?, auto_merge_protected_paths=?, auto_merge_require_base_green=?
 			WHERE id = ?
 		`,
 			newSettings.GetIdlePollInterval(), newSettings.GetActivePollInterval(), newSettings.GetTitleTruncateLength(), newSettings.GetLineClamp(),
@@ -186,13 +310,21 @@ func (s *SettingsServer) UpdateSettings(ctx context.Context, req *pb.UpdateSetti
 			newSettings.GetMinSessionInteractionInterval(), newSettings.GetRetryTimeout(), newSettings.GetAutoApprovalEnabled(),
 			newSettings.GetAutoApprovalAllSessions(), newSettings.GetAutoContinueAllSessions(),
 			newSettings.GetAutoMergeEnabled(), newSettings.GetAutoMergeMethod(), newSettings.GetAutoMergeMessage(), newSettings.GetAutoCloseOnConflictMessage(), newSettings.GetClosePrOnConflictEnabled(),
+			newSettings.GetMaxConcurrentBackgroundWorkers(),
+			newSettings.GetIssueAutomationEnabled(), newSettings.GetIssueAutomationLabel(), newSettings.GetIssueAutomationRepos(),
+			newSettings.GetChatOpsEnabled(),
+			newSettings.GetCheckFailingActionsEscalation(), newSettings.GetCheckFailingActionsEscalationTarget(),
+			newSettings.GetFlakyCheckMaxReruns(), newSettings.GetInformationalChecks(),
+			newSettings.GetAutoMergeScope(), newSettings.GetAutoMergeLabel(), newSettings.GetAutoMergeRequiredApprovals(), newSettings.GetAutoMergeBlockChangesRequested(),
+			newSettings.GetAutoMergeMaxAdditions(), newSettings.GetAutoMergeMaxDeletions(), newSettings.GetAutoMergeMaxFiles(), newSettings.GetAutoMergeProtectedPaths(),
+			newSettings.GetAutoMergeRequireBaseGreen(),
 			existingId,
 		)
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to save settings: %w", err)
+		return fmt.Errorf("failed to save settings: %w", err)
 	}
 
-	return &pb.UpdateSettingsResponse{Success: true}, nil
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 
 	pb "github.com/mcpany/jules/proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestSettingsService_GetUpdate(t *testing.T) {
@@ -42,6 +43,48 @@ func TestSettingsService_GetUpdate(t *testing.T) {
 	got2, err := svc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
 	assert.NoError(t, err)
 	assert.Equal(t, int32(600), got2.IdlePollInterval)
+	assert.Equal(t, int32(5), got2.MaxConcurrentBackgroundWorkers)
+
+	// Worker concurrency is persisted
+	got2.MaxConcurrentBackgroundWorkers = 20
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: got2})
+	assert.NoError(t, err)
+	got3, err := svc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(20), got3.MaxConcurrentBackgroundWorkers)
+
+	// Escalation of failing checks is persisted
+	assert.Equal(t, EscalationLabel, got3.CheckFailingActionsEscalation)
+	got3.CheckFailingActionsEscalation, got3.CheckFailingActionsEscalationTarget = EscalationNotify, "alice"
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: got3})
+	assert.NoError(t, err)
+	got4, err := svc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, EscalationNotify, got4.CheckFailingActionsEscalation)
+	assert.Equal(t, "alice", got4.CheckFailingActionsEscalationTarget)
+
+	// Failing checks are rerun once unless configured otherwise
+	assert.Equal(t, int32(1), got4.FlakyCheckMaxReruns)
+	got4.FlakyCheckMaxReruns = 3
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: got4})
+	assert.NoError(t, err)
+	got5, err := svc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), got5.FlakyCheckMaxReruns)
+
+	// The auto-merge policy merges all PRs by default, but not over requested changes
+	assert.Equal(t, AutoMergeScopeAll, got5.AutoMergeScope)
+	assert.Equal(t, DefaultAutoMergeLabel, got5.AutoMergeLabel)
+	assert.True(t, got5.AutoMergeBlockChangesRequested)
+	got5.AutoMergeScope, got5.AutoMergeRequiredApprovals, got5.AutoMergeProtectedPaths = AutoMergeScopeSessions, 1, "migrations/**"
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: got5})
+	assert.NoError(t, err)
+	got6, err := svc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, AutoMergeScopeSessions, got6.AutoMergeScope)
+	assert.Equal(t, int32(1), got6.AutoMergeRequiredApprovals)
+	assert.Equal(t, "migrations/**", got6.AutoMergeProtectedPaths)
+	assert.True(t, got6.AutoMergeBlockChangesRequested)
 
 	// Error path
 	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: nil})
@@ -57,30 +100,84 @@ func TestSettingsService_Validation(t *testing.T) {
 	base, _ := svc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
 
 	// Test 1: Invalid Theme
-	invalidTheme := *base
+	invalidTheme := proto.Clone(base).(*pb.Settings)
 	invalidTheme.Theme = "hacker-green"
-	_, err := svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: &invalidTheme})
+	_, err := svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: invalidTheme})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid theme")
 
 	// Test 2: Invalid AutoMergeMethod
-	invalidMerge := *base
+	invalidMerge := proto.Clone(base).(*pb.Settings)
 	invalidMerge.AutoMergeMethod = "force-push"
-	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: &invalidMerge})
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: invalidMerge})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid auto merge method")
 
 	// Test 3: Message too long
-	longMsg := *base
+	longMsg := proto.Clone(base).(*pb.Settings)
 	longMsg.AutoRetryMessage = strings.Repeat("a", 1001)
-	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: &longMsg})
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: longMsg})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "too long")
 
 	// Test 4: Negative interval
-	negInterval := *base
+	negInterval := proto.Clone(base).(*pb.Settings)
 	negInterval.IdlePollInterval = -1
-	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: &negInterval})
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: negInterval})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "must be positive")
+
+	// Test 5: Invalid issue automation repos
+	invalidRepos := proto.Clone(base).(*pb.Settings)
+	invalidRepos.IssueAutomationRepos = "o/r, not a repo"
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: invalidRepos})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid issue automation repos")
+
+	// Test 6: Issue automation label too long
+	longLabel := proto.Clone(base).(*pb.Settings)
+	longLabel.IssueAutomationLabel = strings.Repeat("a", 39)
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: longLabel})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "too long")
+
+	// Test 7: Invalid escalation, and reviewer escalation without reviewers
+	invalidEscalation := proto.Clone(base).(*pb.Settings)
+	invalidEscalation.CheckFailingActionsEscalation = "page-everyone"
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: invalidEscalation})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid check failing actions escalation")
+
+	noReviewers := proto.Clone(base).(*pb.Settings)
+	noReviewers.CheckFailingActionsEscalation = EscalationRequestReviewer
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: noReviewers})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "needs reviewers")
+
+	// Test 8: Negative reruns
+	negativeReruns := proto.Clone(base).(*pb.Settings)
+	negativeReruns.FlakyCheckMaxReruns = -1
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: negativeReruns})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must not be negative")
+
+	// Test 9: Informational checks without a repo
+	noRepo := proto.Clone(base).(*pb.Settings)
+	noRepo.InformationalChecks = "codecov/patch"
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: noRepo})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid informational checks")
+
+	// Test 10: Invalid auto merge scope, and negative limits
+	invalidScope := proto.Clone(base).(*pb.Settings)
+	invalidScope.AutoMergeScope = "friends"
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: invalidScope})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid auto merge scope")
+
+	negativeLimit := proto.Clone(base).(*pb.Settings)
+	negativeLimit.AutoMergeMaxFiles = -1
+	_, err = svc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: negativeLimit})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must not be negative")
 }
@@ -0,0 +1,630 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcpany/jules/internal/logger"
+	pb "github.com/mcpany/jules/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/yaml.v3"
+)
+
+// StateServer applies a declarative state file (profiles, settings, prompts and cron jobs).
+// Applying the same file twice is a no-op, so it can be run at every startup.
+type StateServer struct {
+	pb.UnimplementedStateServiceServer
+	DB *sql.DB
+}
+
+// StateFile is the root of the YAML state document.
+type StateFile struct {
+	Profiles []StateProfile `yaml:"profiles"`
+}
+
+// StateProfile declares a profile and everything that belongs to it.
+// Settings and cron jobs use the proto field names (e.g. auto_merge_enabled).
+type StateProfile struct {
+	ID                string                   `yaml:"id"`
+	Name              string                   `yaml:"name"`
+	Settings          map[string]interface{}   `yaml:"settings"`
+	PredefinedPrompts []StatePrompt            `yaml:"predefined_prompts"`
+	QuickReplies      []StatePrompt            `yaml:"quick_replies"`
+	RepoPrompts       []StateRepoPrompt        `yaml:"repo_prompts"`
+	CronJobs          []map[string]interface{} `yaml:"cron_jobs"`
+}
+
+// StatePrompt declares a predefined prompt or quick reply. Without an id it is matched by title.
+type StatePrompt struct {
+	ID     string `yaml:"id"`
+	Title  string `yaml:"title"`
+	Prompt string `yaml:"prompt"`
+}
+
+// StateRepoPrompt declares the prompt attached to a repository.
+type StateRepoPrompt struct {
+	Repo   string `yaml:"repo"`
+	Prompt string `yaml:"prompt"`
+}
+
+// ParseStateFile decodes and validates a YAML state document.
+func ParseStateFile(data []byte) (*StateFile, error) {
+	var f StateFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("invalid state file: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for i, p := range f.Profiles {
+		if p.ID == "" {
+			return nil, fmt.Errorf("profiles[%d]: id is required", i)
+		}
+		if seen[p.ID] {
+			return nil, fmt.Errorf("profiles[%d]: duplicate profile id %q", i, p.ID)
+		}
+		seen[p.ID] = true
+		if len(p.Name) > 255 {
+			return nil, fmt.Errorf("profiles[%d]: name is too long (max 255 characters)", i)
+		}
+		for j, r := range p.RepoPrompts {
+			if r.Repo == "" {
+				return nil, fmt.Errorf("profiles[%d].repo_prompts[%d]: repo is required", i, j)
+			}
+			if err := ValidateRepo(r.Repo); err != nil {
+				return nil, fmt.Errorf("profiles[%d].repo_prompts[%d]: %w", i, j, err)
+			}
+		}
+		for j, c := range p.CronJobs {
+			if name, _ := c["name"].(string); name == "" {
+				return nil, fmt.Errorf("profiles[%d].cron_jobs[%d]: name is required", i, j)
+			}
+		}
+	}
+	return &f, nil
+}
+
+// execer is implemented by *sql.DB and *sql.Tx, so that writes can run inside a transaction.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// stateOp is a single planned change and the function that performs it.
+type stateOp struct {
+	change *pb.StateChange
+	apply  func(ctx context.Context, db execer) error
+}
+
+func (s *StateServer) ApplyState(ctx context.Context, req *pb.ApplyStateRequest) (*pb.ApplyStateResponse, error) {
+	f, err := ParseStateFile([]byte(req.State))
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := s.plan(ctx, f, req.Prune)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ApplyStateResponse{DryRun: req.DryRun}
+	for _, op := range ops {
+		resp.Changes = append(resp.Changes, op.change)
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+
+	// The file is applied as a whole, so a failing change leaves the database untouched
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for _, op := range ops {
+		if err := op.apply(ctx, tx); err != nil {
+			return nil, fmt.Errorf("failed to %s %s %s: %w", op.change.Action, op.change.Kind, op.change.Id, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	logger.Info("StateServer: applied %d changes", len(ops))
+	return resp, nil
+}
+
+func (s *StateServer) plan(ctx context.Context, f *StateFile, prune bool) ([]stateOp, error) {
+	var ops, deletes []stateOp
+
+	declared := make(map[string]bool)
+	for _, p := range f.Profiles {
+		declared[p.ID] = true
+
+		profileOps, err := s.planProfile(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, profileOps...)
+
+		settingsOps, err := s.planSettings(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, settingsOps...)
+
+		for _, table := range []string{"predefined_prompts", "quick_replies"} {
+			prompts := p.PredefinedPrompts
+			if table == "quick_replies" {
+				prompts = p.QuickReplies
+			}
+			promptOps, promptDeletes, err := s.planPrompts(ctx, table, p.ID, prompts, prune)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, promptOps...)
+			deletes = append(deletes, promptDeletes...)
+		}
+
+		repoOps, repoDeletes, err := s.planRepoPrompts(ctx, p.ID, p.RepoPrompts, prune)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, repoOps...)
+		deletes = append(deletes, repoDeletes...)
+
+		cronOps, cronDeletes, err := s.planCronJobs(ctx, p.ID, p.CronJobs, prune)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, cronOps...)
+		deletes = append(deletes, cronDeletes...)
+	}
+
+	if prune {
+		rows, err := s.DB.QueryContext(ctx, "SELECT id FROM profiles ORDER BY id")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list profiles: %w", err)
+		}
+		var stale []string
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan profile: %w", err)
+			}
+			if !declared[id] && id != "default" {
+				stale = append(stale, id)
+			}
+		}
+		rows.Close()
+
+		for _, id := range stale {
+			id := id
+			deletes = append(deletes, stateOp{
+				change: &pb.StateChange{Kind: "profile", Id: id, ProfileId: id, Action: "delete"},
+				apply: func(ctx context.Context, db execer) error {
+					return deleteProfile(ctx, db, id)
+				},
+			})
+		}
+	}
+
+	return append(ops, deletes...), nil
+}
+
+func (s *StateServer) planProfile(ctx context.Context, p StateProfile) ([]stateOp, error) {
+	name := p.Name
+	if name == "" {
+		name = p.ID
+	}
+
+	var existing string
+	err := s.DB.QueryRowContext(ctx, "SELECT name FROM profiles WHERE id = ?", p.ID).Scan(&existing)
+	switch {
+	case err == sql.ErrNoRows:
+		return []stateOp{{
+			change: &pb.StateChange{Kind: "profile", Id: p.ID, ProfileId: p.ID, Action: "create"},
+			apply: func(ctx context.Context, db execer) error {
+				_, err := db.ExecContext(ctx, "INSERT INTO profiles (id, name, created_at) VALUES (?, ?, ?)", p.ID, name, time.Now().Format(time.RFC3339))
+				return err
+			},
+		}}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get profile %s: %w", p.ID, err)
+	case existing != name:
+		return []stateOp{{
+			change: &pb.StateChange{Kind: "profile", Id: p.ID, ProfileId: p.ID, Action: "update", Fields: []string{"name"}},
+			apply: func(ctx context.Context, db execer) error {
+				_, err := db.ExecContext(ctx, "UPDATE profiles SET name = ? WHERE id = ?", name, p.ID)
+				return err
+			},
+		}}, nil
+	}
+	return nil, nil
+}
+
+func (s *StateServer) planSettings(ctx context.Context, p StateProfile) ([]stateOp, error) {
+	if len(p.Settings) == 0 {
+		return nil, nil
+	}
+
+	settingsSvc := &SettingsServer{DB: s.DB}
+	current, err := settingsSvc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: p.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	var desired pb.Settings
+	fields, err := overlayMessage(current, p.Settings, &desired, "id", "profile_id")
+	if err != nil {
+		return nil, fmt.Errorf("profile %s settings: %w", p.ID, err)
+	}
+	desired.Id = current.Id
+	desired.ProfileId = p.ID
+
+	var exists int
+	err = s.DB.QueryRowContext(ctx, "SELECT 1 FROM settings WHERE profile_id = ?", p.ID).Scan(&exists)
+	action := "update"
+	if err == sql.ErrNoRows {
+		action = "create"
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get settings for %s: %w", p.ID, err)
+	} else if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return []stateOp{{
+		change: &pb.StateChange{Kind: "settings", Id: p.ID, ProfileId: p.ID, Action: action, Fields: fields},
+		apply: func(ctx context.Context, db execer) error {
+			return saveSettings(ctx, db, &desired)
+		},
+	}}, nil
+}
+
+func (s *StateServer) planPrompts(ctx context.Context, table, profileID string, prompts []StatePrompt, prune bool) ([]stateOp, []stateOp, error) {
+	kind := "predefined_prompt"
+	if table == "quick_replies" {
+		kind = "quick_reply"
+	}
+
+	rows, err := s.DB.QueryContext(ctx, "SELECT id, title, prompt FROM "+table+" WHERE profile_id = ? ORDER BY id", profileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list %s: %w", table, err)
+	}
+	var existing []StatePrompt
+	for rows.Next() {
+		var p StatePrompt
+		if err := rows.Scan(&p.ID, &p.Title, &p.Prompt); err != nil {
+			rows.Close()
+			return nil, nil, fmt.Errorf("failed to scan %s: %w", table, err)
+		}
+		existing = append(existing, p)
+	}
+	rows.Close()
+
+	var ops, deletes []stateOp
+	matched := make(map[string]bool)
+	for i, p := range prompts {
+		if len(p.Title) > 255 {
+			return nil, nil, fmt.Errorf("%s[%d]: title is too long (max 255 characters)", table, i)
+		}
+		if len(p.Prompt) > 50000 {
+			return nil, nil, fmt.Errorf("%s[%d]: prompt is too long (max 50000 characters)", table, i)
+		}
+
+		var current *StatePrompt
+		for j := range existing {
+			if (p.ID != "" && existing[j].ID == p.ID) || (p.ID == "" && existing[j].Title == p.Title && !matched[existing[j].ID]) {
+				current = &existing[j]
+				break
+			}
+		}
+
+		if current == nil {
+			id := p.ID
+			if id == "" {
+				id = uuid.New().String()
+			}
+			p := p
+			ops = append(ops, stateOp{
+				change: &pb.StateChange{Kind: kind, Id: id, ProfileId: profileID, Action: "create"},
+				apply: func(ctx context.Context, db execer) error {
+					_, err := db.ExecContext(ctx, "INSERT INTO "+table+" (id, title, prompt, profile_id) VALUES (?, ?, ?, ?)", id, p.Title, p.Prompt, profileID)
+					return err
+				},
+			})
+			continue
+		}
+
+		matched[current.ID] = true
+		var fields []string
+		if current.Title != p.Title {
+			fields = append(fields, "title")
+		}
+		if current.Prompt != p.Prompt {
+			fields = append(fields, "prompt")
+		}
+		if len(fields) > 0 {
+			id, p := current.ID, p
+			ops = append(ops, stateOp{
+				change: &pb.StateChange{Kind: kind, Id: id, ProfileId: profileID, Action: "update", Fields: fields},
+				apply: func(ctx context.Context, db execer) error {
+					_, err := db.ExecContext(ctx, "UPDATE "+table+" SET title = ?, prompt = ? WHERE id = ?", p.Title, p.Prompt, id)
+					return err
+				},
+			})
+		}
+	}
+
+	if prune {
+		for _, e := range existing {
+			if matched[e.ID] {
+				continue
+			}
+			id := e.ID
+			deletes = append(deletes, stateOp{
+				change: &pb.StateChange{Kind: kind, Id: id, ProfileId: profileID, Action: "delete"},
+				apply: func(ctx context.Context, db execer) error {
+					_, err := db.ExecContext(ctx, "DELETE FROM "+table+" WHERE id = ?", id)
+					return err
+				},
+			})
+		}
+	}
+	return ops, deletes, nil
+}
+
+func (s *StateServer) planRepoPrompts(ctx context.Context, profileID string, prompts []StateRepoPrompt, prune bool) ([]stateOp, []stateOp, error) {
+	rows, err := s.DB.QueryContext(ctx, "SELECT repo, prompt FROM repo_prompts WHERE profile_id = ? ORDER BY repo", profileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list repo prompts: %w", err)
+	}
+	existing := make(map[string]string)
+	var repos []string
+	for rows.Next() {
+		var repo, prompt string
+		if err := rows.Scan(&repo, &prompt); err != nil {
+			rows.Close()
+			return nil, nil, fmt.Errorf("failed to scan repo prompt: %w", err)
+		}
+		existing[repo] = prompt
+		repos = append(repos, repo)
+	}
+	rows.Close()
+
+	var ops, deletes []stateOp
+	declared := make(map[string]bool)
+	for i, p := range prompts {
+		if len(p.Prompt) > 50000 {
+			return nil, nil, fmt.Errorf("repo_prompts[%d]: prompt is too long (max 50000 characters)", i)
+		}
+		declared[p.Repo] = true
+		p := p
+
+		current, ok := existing[p.Repo]
+		if !ok {
+			ops = append(ops, stateOp{
+				change: &pb.StateChange{Kind: "repo_prompt", Id: p.Repo, ProfileId: profileID, Action: "create"},
+				apply: func(ctx context.Context, db execer) error {
+					_, err := db.ExecContext(ctx, "INSERT INTO repo_prompts (repo, prompt, profile_id) VALUES (?, ?, ?)", p.Repo, p.Prompt, profileID)
+					return err
+				},
+			})
+		} else if current != p.Prompt {
+			ops = append(ops, stateOp{
+				change: &pb.StateChange{Kind: "repo_prompt", Id: p.Repo, ProfileId: profileID, Action: "update", Fields: []string{"prompt"}},
+				apply: func(ctx context.Context, db execer) error {
+					_, err := db.ExecContext(ctx, "UPDATE repo_prompts SET prompt = ? WHERE repo = ? AND profile_id = ?", p.Prompt, p.Repo, profileID)
+					return err
+				},
+			})
+		}
+	}
+
+	if prune {
+		for _, repo := range repos {
+			if declared[repo] {
+				continue
+			}
+			repo := repo
+			deletes = append(deletes, stateOp{
+				change: &pb.StateChange{Kind: "repo_prompt", Id: repo, ProfileId: profileID, Action: "delete"},
+				apply: func(ctx context.Context, db execer) error {
+					_, err := db.ExecContext(ctx, "DELETE FROM repo_prompts WHERE repo = ? AND profile_id = ?", repo, profileID)
+					return err
+				},
+			})
+		}
+	}
+	return ops, deletes, nil
+}
+
+func (s *StateServer) planCronJobs(ctx context.Context, profileID string, cronJobs []map[string]interface{}, prune bool) ([]stateOp, []stateOp, error) {
+	cronSvc := &CronJobServer{DB: s.DB}
+	list, err := cronSvc.ListCronJobs(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	var existing []*pb.CronJob
+	for _, c := range list.CronJobs {
+		if c.ProfileId == profileID {
+			existing = append(existing, c)
+		}
+	}
+
+	var ops, deletes []stateOp
+	matched := make(map[string]bool)
+	for i, decl := range cronJobs {
+		decl["profile_id"] = profileID
+		if _, ok := decl["enabled"]; !ok {
+			decl["enabled"] = true
+		}
+
+		// Checked against the full cron job, as updates are, so misspelt keys are rejected on create too
+		var desired pb.CronJob
+		if err := decodeStrict(decl, &desired); err != nil {
+			return nil, nil, fmt.Errorf("cron_jobs[%d]: %w", i, err)
+		}
+		if err := ValidateRepo(desired.Repo); err != nil {
+			return nil, nil, fmt.Errorf("cron_jobs[%d]: %w", i, err)
+		}
+		if err := ValidateBranch(desired.Branch); err != nil {
+			return nil, nil, fmt.Errorf("cron_jobs[%d]: %w", i, err)
+		}
+
+		var current *pb.CronJob
+		for _, c := range existing {
+			if (desired.Id != "" && c.Id == desired.Id) || (desired.Id == "" && c.Name == desired.Name && !matched[c.Id]) {
+				current = c
+				break
+			}
+		}
+
+		if current == nil {
+			var create pb.CreateCronJobRequest
+			if err := decodeMessage(decl, &create); err != nil {
+				return nil, nil, fmt.Errorf("cron_jobs[%d]: %w", i, err)
+			}
+			if create.Id == "" {
+				create.Id = uuid.New().String()
+			}
+			enabled := desired.Enabled
+			ops = append(ops, stateOp{
+				change: &pb.StateChange{Kind: "cron_job", Id: create.Id, ProfileId: profileID, Action: "create"},
+				apply: func(ctx context.Context, db execer) error {
+					if _, err := createCronJob(ctx, db, &create); err != nil {
+						return err
+					}
+					if !enabled {
+						_, err := toggleCronJob(ctx, db, &pb.ToggleCronJobRequest{Id: create.Id, Enabled: false})
+						return err
+					}
+					return nil
+				},
+			})
+			continue
+		}
+
+		matched[current.Id] = true
+		fields, err := overlayMessage(current, decl, &pb.CronJob{}, "id", "created_at", "updated_at", "last_run_at")
+		if err != nil {
+			return nil, nil, fmt.Errorf("cron_jobs[%d]: %w", i, err)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		update := &pb.UpdateCronJobRequest{}
+		if err := decodeMessage(decl, update); err != nil {
+			return nil, nil, fmt.Errorf("cron_jobs[%d]: %w", i, err)
+		}
+		update.Id = current.Id
+		ops = append(ops, stateOp{
+			change: &pb.StateChange{Kind: "cron_job", Id: current.Id, ProfileId: profileID, Action: "update", Fields: fields},
+			apply: func(ctx context.Context, db execer) error {
+				_, err := updateCronJob(ctx, db, update)
+				return err
+			},
+		})
+	}
+
+	if prune {
+		for _, c := range existing {
+			if matched[c.Id] {
+				continue
+			}
+			id := c.Id
+			deletes = append(deletes, stateOp{
+				change: &pb.StateChange{Kind: "cron_job", Id: id, ProfileId: profileID, Action: "delete"},
+				apply: func(ctx context.Context, db execer) error {
+					_, err := deleteCronJob(ctx, db, &pb.DeleteCronJobRequest{Id: id})
+					return err
+				},
+			})
+		}
+	}
+	return ops, deletes, nil
+}
+
+// decodeMessage converts a YAML map keyed by proto field names into msg.
+// Keys that msg does not know about are ignored, so one declaration can feed several request types.
+func decodeMessage(values map[string]interface{}, msg proto.Message) error {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, msg)
+}
+
+// decodeStrict is like decodeMessage but rejects keys that msg does not know about.
+func decodeStrict(values map[string]interface{}, msg proto.Message) error {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(b, msg)
+}
+
+// overlayMessage applies values on top of current and decodes the result into out.
+// It returns the sorted names of the fields whose value changed. Unknown keys are rejected.
+func overlayMessage(current proto.Message, values map[string]interface{}, out proto.Message, ignore ...string) ([]string, error) {
+	before, err := messageToMap(current)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]interface{}, len(before))
+	for k, v := range before {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	if err := protojson.Unmarshal(b, out); err != nil {
+		return nil, err
+	}
+
+	after, err := messageToMap(out)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := make(map[string]bool)
+	for _, k := range ignore {
+		skip[k] = true
+	}
+	var fields []string
+	for k, v := range after {
+		if !skip[k] && !reflect.DeepEqual(before[k], v) {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func messageToMap(msg proto.Message) (map[string]interface{}, error) {
+	b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
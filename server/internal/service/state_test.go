@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const testState = `
+profiles:
+  - id: team
+    name: Team
+    settings:
+      auto_approval_enabled: true
+      history_prompts_count: 3
+    predefined_prompts:
+      - id: p1
+        title: Fix tests
+        prompt: Fix the failing tests.
+    quick_replies:
+      - title: LGTM
+        prompt: Looks good, continue.
+    repo_prompts:
+      - repo: owner/repo
+        prompt: Follow the style guide.
+    cron_jobs:
+      - id: c1
+        name: Nightly
+        schedule: "0 3 * * *"
+        prompt: Clean up.
+        repo: owner/repo
+        branch: main
+        automation_mode: AUTO_CREATE_PR
+`
+
+func TestStateService_Apply(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &StateServer{DB: db}
+	ctx := context.Background()
+
+	// Dry run reports changes without writing
+	resp, err := svc.ApplyState(ctx, &pb.ApplyStateRequest{State: testState, DryRun: true})
+	assert.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Len(t, resp.Changes, 6)
+
+	var count int
+	db.QueryRow("SELECT COUNT(*) FROM profiles WHERE id = 'team'").Scan(&count)
+	assert.Equal(t, 0, count)
+
+	// Apply
+	resp, err = svc.ApplyState(ctx, &pb.ApplyStateRequest{State: testState})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Changes, 6)
+	for _, c := range resp.Changes {
+		assert.Equal(t, "create", c.Action)
+	}
+
+	settings, err := (&SettingsServer{DB: db}).GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "team"})
+	assert.NoError(t, err)
+	assert.True(t, settings.AutoApprovalEnabled)
+	assert.Equal(t, int32(3), settings.HistoryPromptsCount)
+
+	crons, err := (&CronJobServer{DB: db}).ListCronJobs(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, crons.CronJobs, 1)
+	assert.Equal(t, "c1", crons.CronJobs[0].Id)
+	assert.Equal(t, pb.AutomationMode_AUTO_CREATE_PR, crons.CronJobs[0].AutomationMode)
+	assert.True(t, crons.CronJobs[0].Enabled)
+
+	// Re-applying is a no-op
+	resp, err = svc.ApplyState(ctx, &pb.ApplyStateRequest{State: testState})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Changes)
+
+	// Updates report changed fields
+	_, err = db.Exec("UPDATE cron_jobs SET schedule = '0 4 * * *' WHERE id = 'c1'")
+	assert.NoError(t, err)
+	resp, err = svc.ApplyState(ctx, &pb.ApplyStateRequest{State: testState})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Changes, 1) {
+		assert.Equal(t, "update", resp.Changes[0].Action)
+		assert.Equal(t, []string{"schedule"}, resp.Changes[0].Fields)
+	}
+}
+
+func TestStateService_Prune(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &StateServer{DB: db}
+	ctx := context.Background()
+
+	_, err := svc.ApplyState(ctx, &pb.ApplyStateRequest{State: testState})
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO predefined_prompts (id, title, prompt, profile_id) VALUES ('extra', 'Extra', 'x', 'team')")
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO profiles (id, name, created_at) VALUES ('old', 'Old', '2024-01-01T00:00:00Z')")
+	assert.NoError(t, err)
+
+	// Without prune nothing is deleted
+	resp, err := svc.ApplyState(ctx, &pb.ApplyStateRequest{State: testState})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Changes)
+
+	resp, err = svc.ApplyState(ctx, &pb.ApplyStateRequest{State: testState, Prune: true})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Changes, 2)
+
+	var count int
+	db.QueryRow("SELECT COUNT(*) FROM predefined_prompts WHERE id = 'extra'").Scan(&count)
+	assert.Equal(t, 0, count)
+	db.QueryRow("SELECT COUNT(*) FROM profiles WHERE id = 'old'").Scan(&count)
+	assert.Equal(t, 0, count)
+}
+
+func TestStateService_Invalid(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &StateServer{DB: db}
+	ctx := context.Background()
+
+	_, err := svc.ApplyState(ctx, &pb.ApplyStateRequest{State: "profiles:\n  - name: missing id\n"})
+	assert.Error(t, err)
+
+	_, err = svc.ApplyState(ctx, &pb.ApplyStateRequest{State: "profiles:\n  - id: a\n    settings:\n      not_a_setting: 1\n"})
+	assert.Error(t, err)
+
+	// Unknown keys are rejected when a cron job is created, not only when it is updated
+	newCron := "profiles:\n  - id: a\n    cron_jobs:\n      - name: Nightly\n        schedule: \"0 3 * * *\"\n        repo: owner/repo\n        branch: main\n        skip_if_runing: true\n"
+	_, err = svc.ApplyState(ctx, &pb.ApplyStateRequest{State: newCron})
+	assert.ErrorContains(t, err, "skip_if_runing")
+	var count int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM cron_jobs").Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestStateService_ApplyRollsBackOnError(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	svc := &StateServer{DB: db}
+	ctx := context.Background()
+
+	// The profile is created before its settings fail validation, and is rolled back with them
+	_, err := svc.ApplyState(ctx, &pb.ApplyStateRequest{State: "profiles:\n  - id: a\n    settings:\n      theme: neon\n"})
+	assert.ErrorContains(t, err, "invalid theme")
+
+	var count int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM profiles WHERE id = 'a'").Scan(&count))
+	assert.Equal(t, 0, count)
+}
@@ -42,3 +42,50 @@ func ValidateBranch(branch string) error {
 	}
 	return nil
 }
+
+// ParseRepoList splits a comma or newline separated list of owner/repo names, skipping empty entries.
+func ParseRepoList(list string) ([]string, error) {
+	var repos []string
+	for _, r := range strings.FieldsFunc(list, func(c rune) bool { return c == ',' || c == '\n' }) {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		if err := ValidateRepo(r); err != nil {
+			return nil, fmt.Errorf("%s: %w", r, err)
+		}
+		repos = append(repos, r)
+	}
+	return repos, nil
+}
+
+// AllRepos matches every repo in the informational checks.
+const AllRepos = "*"
+
+// ParseInformationalChecks parses lines of "owner/repo: check, check" into the check names
+// per repo. The repo "*" applies to all repos.
+func ParseInformationalChecks(list string) (map[string][]string, error) {
+	checks := make(map[string][]string)
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		repo, names, ok := strings.Cut(line, ":")
+		repo = strings.TrimSpace(repo)
+		if !ok || repo == "" {
+			return nil, fmt.Errorf("%s: must be 'owner/repo: check, check'", line)
+		}
+		if repo != AllRepos {
+			if err := ValidateRepo(repo); err != nil {
+				return nil, fmt.Errorf("%s: %w", repo, err)
+			}
+		}
+		for _, name := range strings.Split(names, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				checks[repo] = append(checks[repo], name)
+			}
+		}
+	}
+	return checks, nil
+}
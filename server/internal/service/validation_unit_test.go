@@ -70,3 +70,32 @@ func TestValidateBranch(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRepoList(t *testing.T) {
+	repos, err := ParseRepoList(" o/a, o/b\no/c,,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repos) != 3 || repos[0] != "o/a" || repos[2] != "o/c" {
+		t.Errorf("unexpected repos: %v", repos)
+	}
+	if _, err := ParseRepoList("o/a, bad"); err == nil {
+		t.Error("expected error for invalid repo")
+	}
+}
+
+func TestParseInformationalChecks(t *testing.T) {
+	checks, err := ParseInformationalChecks("o/a: codecov/patch, docs\n\n *: license/cla \n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(checks["o/a"]) != 2 || checks["o/a"][1] != "docs" || len(checks[AllRepos]) != 1 || checks[AllRepos][0] != "license/cla" {
+		t.Errorf("unexpected checks: %v", checks)
+	}
+	if _, err := ParseInformationalChecks("codecov/patch"); err == nil {
+		t.Error("expected error for missing repo")
+	}
+	if _, err := ParseInformationalChecks("bad: docs"); err == nil {
+		t.Error("expected error for invalid repo")
+	}
+}
@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/google/go-github/v69/github"
+	gclient "github.com/mcpany/jules/internal/github"
+	"github.com/mcpany/jules/internal/logger"
+)
+
+// maxPayloadBytes is the largest payload GitHub delivers.
+const maxPayloadBytes = 25 << 20
+
+// PRQueue schedules evaluations of pull requests, e.g. PRMonitorWorker.
+type PRQueue interface {
+	EnqueuePR(repo string, number int)
+	EnqueueCommit(repo, sha string)
+	EnqueueRepo(repo string)
+}
+
+// GitHubHandler receives GitHub webhooks, verifies their HMAC signature and
+// turns PR related events into targeted PR evaluations.
+type GitHubHandler struct {
+	secret []byte
+	queue  PRQueue
+}
+
+func NewGitHubHandler(secret string, queue PRQueue) *GitHubHandler {
+	return &GitHubHandler{secret: []byte(secret), queue: queue}
+}
+
+func (h *GitHubHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// An empty secret would make ValidatePayload skip verification
+	if len(h.secret) == 0 {
+		http.Error(w, "webhook secret not configured", http.StatusInternalServerError)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxPayloadBytes)
+	payload, err := github.ValidatePayload(r, h.secret)
+	if err != nil {
+		logger.Warn("Rejected GitHub webhook delivery %s: %v", github.DeliveryID(r), err)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	event, err := github.ParseWebHook(github.WebHookType(r), payload)
+	if err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	h.dispatch(event)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// dispatch queues the evaluations an event calls for. Unsupported events are ignored.
+func (h *GitHubHandler) dispatch(event interface{}) {
+	switch e := event.(type) {
+	case *github.PullRequestEvent:
+		// Closed PRs are evaluated too, so that their tracked state follows
+		h.queue.EnqueuePR(repoID(e.GetRepo()), e.GetNumber())
+
+	case *github.CheckRunEvent:
+		if e.GetAction() == "completed" {
+			run := e.GetCheckRun()
+			h.enqueuePRs(repoID(e.GetRepo()), run.PullRequests, run.GetHeadSHA())
+		}
+
+	case *github.CheckSuiteEvent:
+		if e.GetAction() == "completed" {
+			suite := e.GetCheckSuite()
+			h.enqueuePRs(repoID(e.GetRepo()), suite.PullRequests, suite.GetHeadSHA())
+		}
+
+	case *github.StatusEvent:
+		if e.GetState() != "pending" && e.GetSHA() != "" {
+			h.queue.EnqueueCommit(repoID(e.GetRepo()), e.GetSHA())
+		}
+
+	case *github.IssueCommentEvent:
+		if e.GetAction() == "created" && e.GetIssue().IsPullRequest() {
+			h.queue.EnqueuePR(repoID(e.GetRepo()), e.GetIssue().GetNumber())
+		}
+
+	case *github.PushEvent:
+		// Open PRs may now be behind or conflicting with their base
+		repo := e.GetRepo()
+		if repo.GetDefaultBranch() != "" && e.GetRef() == "refs/heads/"+repo.GetDefaultBranch() {
+			h.queue.EnqueueRepo(gclient.RepoID(repo.GetHTMLURL(), repo.GetFullName()))
+		}
+	}
+}
+
+// enqueuePRs queues the PRs of a check, or the PRs containing its head commit when the check
+// lists none, as it does for PRs from forks.
+func (h *GitHubHandler) enqueuePRs(repo string, prs []*github.PullRequest, headSHA string) {
+	if len(prs) == 0 {
+		if headSHA != "" {
+			h.queue.EnqueueCommit(repo, headSHA)
+		}
+		return
+	}
+	for _, pr := range prs {
+		h.queue.EnqueuePR(repo, pr.GetNumber())
+	}
+}
+
+// repoID identifies the event's repo, including the host for GitHub Enterprise Server.
+func repoID(repo *github.Repository) string {
+	return gclient.RepoID(repo.GetHTMLURL(), repo.GetFullName())
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeQueue struct {
+	calls []string
+}
+
+func (q *fakeQueue) EnqueuePR(repo string, number int) {
+	q.calls = append(q.calls, fmt.Sprintf("pr %s#%d", repo, number))
+}
+
+func (q *fakeQueue) EnqueueCommit(repo, sha string) {
+	q.calls = append(q.calls, fmt.Sprintf("commit %s@%s", repo, sha))
+}
+
+func (q *fakeQueue) EnqueueRepo(repo string) {
+	q.calls = append(q.calls, "repo "+repo)
+}
+
+func deliver(h http.Handler, secret, event, payload string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", event)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestGitHubHandler_Signature(t *testing.T) {
+	q := &fakeQueue{}
+	h := NewGitHubHandler("s3cret", q)
+	payload := `{"action":"opened","number":3,"repository":{"full_name":"o/r"}}`
+
+	assert.Equal(t, http.StatusUnauthorized, deliver(h, "wrong", "pull_request", payload).Code)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Without a secret nothing is accepted
+	assert.Equal(t, http.StatusInternalServerError, deliver(NewGitHubHandler("", q), "", "pull_request", payload).Code)
+	assert.Empty(t, q.calls)
+
+	assert.Equal(t, http.StatusAccepted, deliver(h, "s3cret", "pull_request", payload).Code)
+	assert.Equal(t, []string{"pr o/r#3"}, q.calls)
+}
+
+func TestGitHubHandler_Events(t *testing.T) {
+	q := &fakeQueue{}
+	h := NewGitHubHandler("s3cret", q)
+
+	deliveries := []struct{ event, payload string }{
+		{"ping", `{"zen":"Keep it logically awesome."}`},
+		{"pull_request", `{"action":"closed","number":1,"repository":{"full_name":"o/r"}}`},
+		{"pull_request", `{"action":"synchronize","number":2,"repository":{"full_name":"o/r"}}`},
+		{"check_run", `{"action":"created","check_run":{"pull_requests":[{"number":9}]},"repository":{"full_name":"o/r"}}`},
+		{"check_run", `{"action":"completed","check_run":{"pull_requests":[{"number":3}]},"repository":{"full_name":"o/r"}}`},
+		// Checks of PRs from forks list no PRs
+		{"check_run", `{"action":"completed","check_run":{"head_sha":"f0f","pull_requests":[]},"repository":{"full_name":"o/r"}}`},
+		{"check_suite", `{"action":"completed","check_suite":{"head_branch":"fix","head_sha":"abc","pull_requests":[]},"repository":{"full_name":"o/r"}}`},
+		{"status", `{"sha":"abc","state":"pending","branches":[{"name":"fix","commit":{"sha":"abc"}}],"repository":{"full_name":"o/r"}}`},
+		{"status", `{"sha":"abc","state":"failure","branches":[{"name":"fix","commit":{"sha":"abc"}},{"name":"old","commit":{"sha":"def"}}],"repository":{"full_name":"o/r"}}`},
+		{"issue_comment", `{"action":"created","issue":{"number":4},"repository":{"full_name":"o/r"}}`},
+		{"issue_comment", `{"action":"created","issue":{"number":5,"pull_request":{"url":"x"}},"repository":{"full_name":"o/r"}}`},
+		{"push", `{"ref":"refs/heads/feature","repository":{"full_name":"o/r","default_branch":"main"}}`},
+		{"push", `{"ref":"refs/heads/main","repository":{"full_name":"o/r","default_branch":"main"}}`},
+		// Repos of GitHub Enterprise Server include the host
+		{"pull_request", `{"action":"opened","number":6,"repository":{"full_name":"o/r","html_url":"https://ghe.example.com/o/r"}}`},
+		{"push", `{"ref":"refs/heads/main","repository":{"full_name":"o/r","html_url":"https://ghe.example.com/o/r","default_branch":"main"}}`},
+	}
+	for _, d := range deliveries {
+		assert.Equal(t, http.StatusAccepted, deliver(h, "s3cret", d.event, d.payload).Code, d.event)
+	}
+
+	assert.Equal(t, []string{
+		"pr o/r#1",
+		"pr o/r#2",
+		"pr o/r#3",
+		"commit o/r@f0f",
+		"commit o/r@abc",
+		"commit o/r@abc",
+		"pr o/r#5",
+		"repo o/r",
+		"pr ghe.example.com/o/r#6",
+		"repo ghe.example.com/o/r",
+	}, q.calls)
+}
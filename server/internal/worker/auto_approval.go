@@ -119,6 +119,11 @@ func (w *AutoApprovalWorker) runCheck(ctx context.Context) error {
 	// 2. Filter and Approve
 	var pendingIDs []string
 	for _, c := range candidates {
+		// Sessions of cancelled jobs are left alone
+		if inCancelledJob(ctx, w.db, c.id) {
+			continue
+		}
+
 		// FILTER: If NOT "All Sessions", check if this session belongs to a Job.
 		if !s.GetAutoApprovalAllSessions() {
 			var count int
@@ -133,3 +133,37 @@ func TestAutoApprovalWorker_GetInterval(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 120*time.Second, workerCtx.getInterval(ctx))
 }
+
+func TestAutoApprovalWorker_SkipsCancelledJobSessions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	settingsSvc := &service.SettingsServer{DB: db}
+	t.Setenv("JULES_API_KEY", "")
+	sessionSvc := &service.SessionServer{DB: db}
+	workerCtx := NewAutoApprovalWorker(db, settingsSvc, sessionSvc)
+	ctx := context.Background()
+
+	_, err := settingsSvc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{
+		Settings: &pb.Settings{
+			ProfileId:               "default",
+			AutoApprovalEnabled:     true,
+			AutoApprovalInterval:    1,
+			AutoApprovalAllSessions: true,
+			Theme:                   "system",
+			AutoMergeMethod:         "squash",
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO sessions (id, name, title, create_time, state) VALUES ('s1', 'sessions/s1', 't', '2024-01-01T00:00:00Z', 'AWAITING_PLAN_APPROVAL')")
+	assert.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO jobs (id, name, status, session_ids, created_at, repo, branch, prompt) VALUES ('j1', 'job', 'CANCELLED', '["s1"]', '2024-01-01T00:00:00Z', 'owner/repo', 'main', 'p')`)
+	assert.NoError(t, err)
+
+	assert.NoError(t, workerCtx.runCheck(ctx))
+
+	s, err := sessionSvc.GetSession(ctx, &pb.GetSessionRequest{Id: "s1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "AWAITING_PLAN_APPROVAL", s.State)
+}
@@ -126,6 +126,11 @@ func (w *AutoContinueWorker) runCheck(ctx context.Context) error {
 	}
 
 	for _, sessID := range allSessionIDs {
+		// Sessions of cancelled jobs are left alone
+		if inCancelledJob(ctx, w.db, sessID) {
+			continue
+		}
+
 		// Local check first to avoid unnecessary API calls
 		var state string
 		err := w.db.QueryRowContext(ctx, "SELECT state FROM sessions WHERE id = ?", sessID).Scan(&state)
@@ -3,15 +3,24 @@ package worker
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
+	"errors"
+	"math/rand"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/ratelimit"
 	"github.com/mcpany/jules/internal/service"
 	pb "github.com/mcpany/jules/proto"
 )
 
+// maxSessionAttempts is how many times a single session creation is tried before the slot fails.
+const maxSessionAttempts = 3
+
+// maxRateLimitedAttempts is how many times a session creation that tripped the session rate
+// limiter is retried before it counts as a failed attempt.
+const maxRateLimitedAttempts = 50
+
 type BackgroundJobWorker struct {
 	BaseWorker
 	id             string
@@ -19,6 +28,7 @@ type BackgroundJobWorker struct {
 	jobService     *service.JobServer
 	sessionService *service.SessionServer
 	settingsSvc    *service.SettingsServer
+	retryBackoff   time.Duration
 }
 
 func NewBackgroundJobWorker(database *sql.DB, jobService *service.JobServer, sessionService *service.SessionServer, settingsSvc *service.SettingsServer) *BackgroundJobWorker {
@@ -32,12 +42,17 @@ func NewBackgroundJobWorker(database *sql.DB, jobService *service.JobServer, ses
 		jobService:     jobService,
 		sessionService: sessionService,
 		settingsSvc:    settingsSvc,
+		retryBackoff:   2 * time.Second,
 	}
 }
 
 func (w *BackgroundJobWorker) Start(ctx context.Context) error {
 	logger.Info("Starting worker: %s [%s]", w.Name(), w.id)
 
+	if err := w.RecoverJobs(ctx); err != nil {
+		logger.Error("%s [%s] failed to recover interrupted jobs: %s", w.Name(), w.id, err.Error())
+	}
+
 	for {
 		interval := w.getInterval(ctx)
 		select {
@@ -72,49 +87,56 @@ func (w *BackgroundJobWorker) getMaxConcurrentWorkers(ctx context.Context) int32
 }
 
 func (w *BackgroundJobWorker) ProcessJobs(ctx context.Context) error {
+	// Start queued jobs whose repo has room
+	promoted, err := w.jobService.PromoteQueuedJobs(ctx)
+	if err != nil {
+		logger.Error("%s [%s]: Failed to promote queued jobs: %s", w.Name(), w.id, err.Error())
+	} else if len(promoted) > 0 {
+		logger.Info("%s [%s]: Started %d queued jobs", w.Name(), w.id, len(promoted))
+	}
+
 	limit := w.getMaxConcurrentWorkers(ctx)
-	// Find PENDING jobs
-	rows, err := w.db.QueryContext(ctx, "SELECT id, session_count FROM jobs WHERE status = 'PENDING' LIMIT ?", limit)
+	// Find PENDING jobs, shared across profiles
+	jobIDs, err := w.jobService.NextPendingJobs(ctx, int(limit))
 	if err != nil {
 		return err
 	}
-	defer rows.Close()
 
-	type PendingJob struct {
-		ID           string
-		SessionCount int
+	if len(jobIDs) == 0 {
+		return nil
 	}
-	var jobs []PendingJob
 
-	for rows.Next() {
-		var j PendingJob
-		if err := rows.Scan(&j.ID, &j.SessionCount); err != nil {
-			continue
-		}
-		jobs = append(jobs, j)
-	}
-	rows.Close() // Close early to allow updates
+	logger.Info("%s [%s]: Found %d pending jobs", w.Name(), w.id, len(jobIDs))
 
-	if len(jobs) == 0 {
-		return nil
+	for _, id := range jobIDs {
+		w.processJob(ctx, id)
 	}
 
-	logger.Info("%s [%s]: Found %d pending jobs", w.Name(), w.id, len(jobs))
+	return nil
+}
 
-	for _, job := range jobs {
-		w.processJob(ctx, job.ID, job.SessionCount)
+// RecoverJobs resumes jobs left PROCESSING by a previous run (e.g. after a crash).
+// Session slots are recorded as they are created, so only the remaining ones are created.
+func (w *BackgroundJobWorker) RecoverJobs(ctx context.Context) error {
+	rows, err := w.db.QueryContext(ctx, "SELECT id FROM jobs WHERE status IN ('PROCESSING', 'Running')")
+	if err != nil {
+		return err
 	}
+	jobIDs := scanIDs(rows)
 
+	for _, id := range jobIDs {
+		logger.Info("%s [%s]: Resuming interrupted job %s", w.Name(), w.id, id)
+		w.processJob(ctx, id)
+	}
 	return nil
 }
 
-func (w *BackgroundJobWorker) processJob(ctx context.Context, jobID string, sessionCount int) {
+func (w *BackgroundJobWorker) processJob(ctx context.Context, jobID string) {
 	logger.Info("%s [%s]: Processing job %s", w.Name(), w.id, jobID)
 
-	// Mark as running
-	_, err := w.db.Exec("UPDATE jobs SET status = 'Running' WHERE id = ?", jobID)
-	if err != nil {
-		logger.Error("%s: Failed to update job %s to Running: %s", w.Name(), jobID, err.Error())
+	// Mark as processing (cancelled or finished jobs are rejected by the state machine)
+	if err := w.jobService.TransitionJob(ctx, jobID, pb.JobStatus_JOB_STATUS_PROCESSING); err != nil {
+		logger.Error("%s: Failed to update job %s to PROCESSING: %s", w.Name(), jobID, err.Error())
 		return
 	}
 
@@ -124,40 +146,175 @@ func (w *BackgroundJobWorker) processJob(ctx context.Context, jobID string, sess
 		logger.Error("%s: Failed to fetch job %s: %s", w.Name(), jobID, err.Error())
 		return
 	}
+	if err := w.jobService.InitJobSessions(ctx, job); err != nil {
+		logger.Error("%s: Failed to initialize sessions of job %s: %s", w.Name(), jobID, err.Error())
+		return
+	}
+	slots, err := w.jobService.ListJobSessions(ctx, jobID)
+	if err != nil {
+		logger.Error("%s: Failed to list sessions of job %s: %s", w.Name(), jobID, err.Error())
+		return
+	}
+
+	// Create the remaining sessions concurrently. Slots left CREATING by a crash are retried.
+	pool := GetPoolFactory().NewPool(int(w.getMaxConcurrentWorkers(ctx)))
+	for _, slot := range slots {
+		if slot.Status != service.SlotStatusPending && slot.Status != service.SlotStatusCreating {
+			continue
+		}
+		// An invalid target fails every attempt the same way, so it is not retried
+		repo, branch, _ := service.JobTargetFor(job, slot.TargetIndex)
+		invalid := service.ValidateRepo(repo)
+		if invalid == nil {
+			invalid = service.ValidateBranch(branch)
+		}
+		if invalid != nil {
+			slot.Status = service.SlotStatusFailed
+			slot.LastError = invalid.Error()
+			if err := w.jobService.UpdateJobSession(ctx, jobID, slot); err != nil {
+				logger.Error("%s: Failed to update session slot %d of job %s: %s", w.Name(), slot.SlotIndex, jobID, err.Error())
+			}
+			continue
+		}
+		slot := slot
+		pool.Submit(func() {
+			w.createSlotSession(ctx, job, slot)
+		})
+	}
+	pool.StopWait()
+
+	if !w.isCancelled(ctx, jobID) {
+		status, created, failed := w.jobOutcome(ctx, jobID)
+		err := w.jobService.TransitionJob(ctx, jobID, status)
+		if err == nil {
+			logger.Info("%s [%s]: Job %s finished with status %s (%d sessions created, %d failed)", w.Name(), w.id, jobID, service.JobStatusString(status), created, failed)
+			return
+		}
+		if !w.isCancelled(ctx, jobID) {
+			logger.Error("%s: Failed to update job %s to %s: %s", w.Name(), jobID, service.JobStatusString(status), err.Error())
+			return
+		}
+	}
 
-	// Create sessions
-	var sessionIDs []string
-	success := true
+	// The job was cancelled mid-flight: tear down whatever was created before we noticed.
+	if _, err := w.jobService.CancelJob(ctx, &pb.CancelJobRequest{Id: jobID}); err != nil {
+		logger.Error("%s: Failed to tear down cancelled job %s: %s", w.Name(), jobID, err.Error())
+	}
+	logger.Info("%s [%s]: Job %s was cancelled", w.Name(), w.id, jobID)
+}
+
+// createSlotSession creates the session of one slot, retrying with exponential backoff.
+func (w *BackgroundJobWorker) createSlotSession(ctx context.Context, job *pb.Job, slot *pb.JobSessionSlot) {
+	repo, branch, prompt := service.JobTargetFor(job, slot.TargetIndex)
+	backoff := w.retryBackoff
+	rateLimited := 0
+	for slot.Attempts < maxSessionAttempts {
+		// Stop as soon as the job is cancelled
+		if w.isCancelled(ctx, job.Id) {
+			return
+		}
+
+		slot.Attempts++
+		slot.Status = service.SlotStatusCreating
+		if err := w.jobService.UpdateJobSession(ctx, job.Id, slot); err != nil {
+			logger.Error("%s: Failed to update session slot %d of job %s: %s", w.Name(), slot.SlotIndex, job.Id, err.Error())
+			return
+		}
 
-	for i := 0; i < sessionCount; i++ {
-		// Create session
 		sess, err := w.sessionService.CreateSession(ctx, &pb.CreateSessionRequest{
 			Name:      "", // will be auto generated
-			Prompt:    job.Prompt,
-			Repo:      job.Repo,
-			Branch:    job.Branch,
+			Prompt:    prompt,
+			Repo:      repo,
+			Branch:    branch,
 			ProfileId: job.ProfileId,
 		})
-		if err != nil {
-			logger.Error("%s: Failed to create session for job %s: %s", w.Name(), jobID, err.Error())
-			success = false
+		if err == nil {
+			slot.Status = service.SlotStatusCreated
+			slot.SessionId = sess.Id
+			slot.LastError = ""
+			if err := w.jobService.UpdateJobSession(ctx, job.Id, slot); err != nil {
+				logger.Error("%s: Failed to record session %s for job %s: %s", w.Name(), sess.Id, job.Id, err.Error())
+			}
+			return
+		}
+
+		// Concurrent slots of the same profile trip the session rate limiter; that is not a real attempt
+		if errors.Is(err, ratelimit.ErrRateLimited) && rateLimited < maxRateLimitedAttempts {
+			rateLimited++
+			slot.Attempts--
+			// 50-200ms at the default backoff
+			wait := w.retryBackoff/40 + time.Duration(rand.Int63n(int64(w.retryBackoff*3/40)+1))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+			continue
+		}
+
+		logger.Warn("%s: Failed to create session %d for job %s (attempt %d/%d): %s", w.Name(), slot.SlotIndex, job.Id, slot.Attempts, maxSessionAttempts, err.Error())
+		slot.LastError = err.Error()
+		if slot.Attempts >= maxSessionAttempts {
 			break
 		}
-		sessionIDs = append(sessionIDs, sess.Id)
-	}
 
-	// Update job with session IDs and status
-	status := "Succeeded"
-	if !success {
-		status = "Failed"
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
 	}
 
-	sessionIDsJSON, _ := json.Marshal(sessionIDs)
+	slot.Status = service.SlotStatusFailed
+	if err := w.jobService.UpdateJobSession(ctx, job.Id, slot); err != nil {
+		logger.Error("%s: Failed to update session slot %d of job %s: %s", w.Name(), slot.SlotIndex, job.Id, err.Error())
+	}
+}
 
-	_, err = w.db.Exec("UPDATE jobs SET status = ?, session_ids = ? WHERE id = ?", status, string(sessionIDsJSON), jobID)
+// jobOutcome derives the final job status from its session slots.
+func (w *BackgroundJobWorker) jobOutcome(ctx context.Context, jobID string) (pb.JobStatus, int, int) {
+	slots, err := w.jobService.ListJobSessions(ctx, jobID)
 	if err != nil {
-		logger.Error("%s: Failed to update job %s to %s: %s", w.Name(), jobID, status, err.Error())
-	} else {
-		logger.Info("%s [%s]: Job %s completed with status %s. Created sessions: %v", w.Name(), w.id, jobID, status, sessionIDs)
+		return pb.JobStatus_JOB_STATUS_FAILED, 0, 0
+	}
+
+	created, failed := 0, 0
+	for _, slot := range slots {
+		if slot.Status == service.SlotStatusCreated {
+			created++
+		} else {
+			failed++
+		}
+	}
+
+	switch {
+	case failed == 0:
+		return pb.JobStatus_JOB_STATUS_COMPLETED, created, failed
+	case created == 0:
+		return pb.JobStatus_JOB_STATUS_FAILED, created, failed
+	default:
+		return pb.JobStatus_JOB_STATUS_PARTIALLY_SUCCEEDED, created, failed
+	}
+}
+
+func (w *BackgroundJobWorker) isCancelled(ctx context.Context, jobID string) bool {
+	var status sql.NullString
+	if err := w.db.QueryRowContext(ctx, "SELECT status FROM jobs WHERE id = ?", jobID).Scan(&status); err != nil {
+		return false
+	}
+	return status.String == service.JobStatusCancelled
+}
+
+func scanIDs(rows *sql.Rows) []string {
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			continue
+		}
+		ids = append(ids, id)
 	}
+	return ids
 }
@@ -3,6 +3,10 @@ package worker
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -99,7 +103,7 @@ func TestBackgroundJobWorker_ProcessJob_Partial(t *testing.T) {
 		t.Fatalf("GetJob failed: %v", err)
 	}
 	assert.Len(t, updatedJob.SessionIds, 3)
-	// assert.Equal(t, "sess-1", updatedJob.SessionIds[0]) // Worker overwrites IDs for clean run
+	assert.Equal(t, "sess-1", updatedJob.SessionIds[0]) // Already-created sessions are kept
 }
 
 func TestBackgroundJobWorker_ScheduleAndProcess(t *testing.T) {
@@ -134,7 +138,8 @@ func TestBackgroundJobWorker_ScheduleAndProcess(t *testing.T) {
 	// 3. Verify it was processed
 	updatedJob, err := jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: job.Id})
 	assert.NoError(t, err)
-	assert.Equal(t, "Succeeded", updatedJob.Status) // Assuming "Succeeded" based on previous logs
+	assert.Equal(t, "COMPLETED", updatedJob.Status)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_COMPLETED, updatedJob.State)
 	assert.Len(t, updatedJob.SessionIds, 1)
 
 	// Verify session
@@ -142,3 +147,253 @@ func TestBackgroundJobWorker_ScheduleAndProcess(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "QUEUED", s.GetState())
 }
+
+func TestBackgroundJobWorker_CancelledJobIsSkipped(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	jobSvc := &service.JobServer{DB: db}
+	sessionSvc := &service.SessionServer{DB: db, Limiter: ratelimit.New(1 * time.Nanosecond)}
+	workerCtx := NewBackgroundJobWorker(db, jobSvc, sessionSvc, &service.SettingsServer{DB: db})
+	ctx := context.Background()
+
+	job, err := jobSvc.CreateJob(ctx, &pb.CreateJobRequest{
+		Name:         "cancelled-job",
+		Status:       "PENDING",
+		SessionCount: 2,
+		Repo:         "test/repo",
+		Branch:       "main",
+		Prompt:       "p",
+	})
+	assert.NoError(t, err)
+
+	// Cancelled between being picked up and being marked as running
+	_, err = jobSvc.CancelJob(ctx, &pb.CancelJobRequest{Id: job.Id})
+	assert.NoError(t, err)
+	workerCtx.processJob(ctx, job.Id)
+
+	updatedJob, err := jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: job.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "CANCELLED", updatedJob.Status)
+	assert.Empty(t, updatedJob.SessionIds)
+
+	var count int
+	db.QueryRow("SELECT COUNT(*) FROM sessions").Scan(&count)
+	assert.Equal(t, 0, count)
+}
+
+func TestBackgroundJobWorker_RecoverJobs(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	jobSvc := &service.JobServer{DB: db}
+	sessionSvc := &service.SessionServer{DB: db, Limiter: ratelimit.New(1 * time.Nanosecond)}
+	workerCtx := NewBackgroundJobWorker(db, jobSvc, sessionSvc, &service.SettingsServer{DB: db})
+	ctx := context.Background()
+
+	// A job interrupted after creating one of three sessions, written by an older worker
+	_, err := db.Exec(`INSERT INTO jobs (id, name, status, session_count, session_ids, created_at, repo, branch, prompt)
+        VALUES ('job-crashed', 'crashed-job', 'Running', 3, '["sess-1"]', '2023-01-01T00:00:00Z', 'owner/repo', 'main', 'p')`)
+	assert.NoError(t, err)
+	// Finished jobs are left alone
+	_, err = db.Exec(`INSERT INTO jobs (id, name, status, session_count, session_ids, created_at, repo, branch, prompt)
+        VALUES ('job-done', 'done-job', 'COMPLETED', 2, '[]', '2023-01-01T00:00:00Z', 'owner/repo', 'main', 'p')`)
+	assert.NoError(t, err)
+
+	assert.NoError(t, workerCtx.RecoverJobs(ctx))
+
+	recovered, err := jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: "job-crashed"})
+	assert.NoError(t, err)
+	assert.Equal(t, "COMPLETED", recovered.Status)
+	assert.Len(t, recovered.SessionIds, 3)
+	assert.Equal(t, "sess-1", recovered.SessionIds[0])
+
+	var count int
+	db.QueryRow("SELECT COUNT(*) FROM sessions").Scan(&count)
+	assert.Equal(t, 2, count)
+
+	done, err := jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: "job-done"})
+	assert.NoError(t, err)
+	assert.Empty(t, done.SessionIds)
+}
+
+func TestBackgroundJobWorker_PartialFailureAndRetry(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	// The remote API hands out the same session id until told otherwise, so only
+	// the first local insert succeeds and the other slots fail.
+	var mu sync.Mutex
+	calls := 0
+	unique := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		id := "dup"
+		if unique {
+			id = fmt.Sprintf("sess%d", calls)
+		}
+		fmt.Fprintf(w, `{"name": "sessions/%s", "id": "%s", "state": "QUEUED"}`, id, id)
+	}))
+	defer server.Close()
+	sessionSvc := &service.SessionServer{DB: db, BaseURL: server.URL, Limiter: ratelimit.New(1 * time.Nanosecond)}
+	t.Setenv("JULES_API_KEY", "dummy-key")
+
+	jobSvc := &service.JobServer{DB: db}
+	workerCtx := NewBackgroundJobWorker(db, jobSvc, sessionSvc, &service.SettingsServer{DB: db})
+	workerCtx.retryBackoff = time.Millisecond
+	ctx := context.Background()
+
+	job, err := jobSvc.CreateJob(ctx, &pb.CreateJobRequest{
+		Name:         "partial-job",
+		Status:       "PENDING",
+		SessionCount: 3,
+		Repo:         "test/repo",
+		Branch:       "main",
+		Prompt:       "p",
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, workerCtx.ProcessJobs(ctx))
+
+	updatedJob, err := jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: job.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_PARTIALLY_SUCCEEDED, updatedJob.State)
+	assert.Equal(t, []string{"dup"}, updatedJob.SessionIds)
+	assert.Len(t, updatedJob.SessionSlots, 3)
+	failed := 0
+	for _, slot := range updatedJob.SessionSlots {
+		if slot.Status == service.SlotStatusFailed {
+			failed++
+			assert.Equal(t, int32(maxSessionAttempts), slot.Attempts)
+			assert.NotEmpty(t, slot.LastError)
+		}
+	}
+	assert.Equal(t, 2, failed)
+	assert.Equal(t, 1+2*maxSessionAttempts, calls)
+
+	// Retrying only creates the failed sessions
+	mu.Lock()
+	unique = true
+	mu.Unlock()
+	retried, err := jobSvc.RetryFailedSessions(ctx, &pb.RetryFailedSessionsRequest{Id: job.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_PENDING, retried.State)
+
+	assert.NoError(t, workerCtx.ProcessJobs(ctx))
+
+	updatedJob, err = jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: job.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_COMPLETED, updatedJob.State)
+	assert.Len(t, updatedJob.SessionIds, 3)
+	assert.Contains(t, updatedJob.SessionIds, "dup")
+
+	_, err = jobSvc.RetryFailedSessions(ctx, &pb.RetryFailedSessionsRequest{Id: job.Id})
+	assert.Error(t, err)
+}
+
+func TestBackgroundJobWorker_RateLimitedSessions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	// The limiter rejects every creation, as if other slots kept taking the profile's turn
+	limiter := ratelimit.New(time.Hour)
+	defer limiter.Stop()
+	sessionSvc := &service.SessionServer{DB: db, Limiter: limiter}
+	jobSvc := &service.JobServer{DB: db}
+	workerCtx := NewBackgroundJobWorker(db, jobSvc, sessionSvc, &service.SettingsServer{DB: db})
+	workerCtx.retryBackoff = time.Millisecond
+	ctx := context.Background()
+
+	job, err := jobSvc.CreateJob(ctx, &pb.CreateJobRequest{Name: "limited", Status: "PENDING", SessionCount: 1, Repo: "test/repo", Branch: "main", Prompt: "p"})
+	assert.NoError(t, err)
+	assert.NoError(t, limiter.Check("profile:default"))
+	slot := &pb.JobSessionSlot{SlotIndex: 0}
+	assert.NoError(t, jobSvc.InitJobSessions(ctx, job))
+
+	// Rate-limited retries are capped, then count as failed attempts
+	workerCtx.createSlotSession(ctx, job, slot)
+	assert.Equal(t, service.SlotStatusFailed, slot.Status)
+	assert.Equal(t, int32(maxSessionAttempts), slot.Attempts)
+	assert.Contains(t, slot.LastError, "rate limit")
+
+	// Waiting for the limiter stops with the context
+	cancelled, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	workerCtx.retryBackoff = time.Hour
+	slot = &pb.JobSessionSlot{SlotIndex: 0}
+	done := make(chan struct{})
+	go func() {
+		workerCtx.createSlotSession(cancelled, job, slot)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("createSlotSession kept waiting after the context was cancelled")
+	}
+	assert.Equal(t, service.SlotStatusCreating, slot.Status)
+}
+
+func TestBackgroundJobWorker_MatrixJob(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	var mu sync.Mutex
+	calls := 0
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Prompt        string `json:"prompt"`
+			SourceContext struct {
+				Source string `json:"source"`
+			} `json:"sourceContext"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		requests = append(requests, body.SourceContext.Source+": "+body.Prompt)
+		fmt.Fprintf(w, `{"name": "sessions/m%d", "id": "m%d", "state": "QUEUED"}`, calls, calls)
+	}))
+	defer server.Close()
+	sessionSvc := &service.SessionServer{DB: db, BaseURL: server.URL, Limiter: ratelimit.New(1 * time.Nanosecond)}
+	t.Setenv("JULES_API_KEY", "dummy-key")
+
+	jobSvc := &service.JobServer{DB: db}
+	workerCtx := NewBackgroundJobWorker(db, jobSvc, sessionSvc, &service.SettingsServer{DB: db})
+	ctx := context.Background()
+
+	job, err := jobSvc.CreateJob(ctx, &pb.CreateJobRequest{
+		Name:         "matrix-job",
+		Status:       "PENDING",
+		SessionCount: 1,
+		Prompt:       "Bump Go to {{version}} in {{repo}}",
+		Matrix: &pb.JobMatrix{Targets: []*pb.JobTarget{
+			{Repo: "org/a", Branch: "main", Vars: map[string]string{"version": "1.23"}},
+			{Repo: "org/b", Branch: "dev", Vars: map[string]string{"version": "1.22"}, SessionCount: 2},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "org/a", job.Repo)
+
+	assert.NoError(t, workerCtx.ProcessJobs(ctx))
+
+	updatedJob, err := jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: job.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JobStatus_JOB_STATUS_COMPLETED, updatedJob.State)
+	assert.Len(t, updatedJob.SessionIds, 3)
+	assert.ElementsMatch(t, []string{
+		"sources/github/org/a: Bump Go to 1.23 in org/a",
+		"sources/github/org/b: Bump Go to 1.22 in org/b",
+		"sources/github/org/b: Bump Go to 1.22 in org/b",
+	}, requests)
+
+	assert.Len(t, updatedJob.TargetProgress, 2)
+	assert.Equal(t, "org/b", updatedJob.TargetProgress[1].Repo)
+	assert.Equal(t, int32(2), updatedJob.TargetProgress[1].Total)
+	assert.Equal(t, int32(2), updatedJob.TargetProgress[1].Created)
+	assert.Len(t, updatedJob.TargetProgress[1].SessionIds, 2)
+	assert.Equal(t, int32(1), updatedJob.TargetProgress[0].Created)
+}
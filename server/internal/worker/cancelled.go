@@ -0,0 +1,37 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+)
+
+// inCancelledJob reports whether the session was spawned by a job that has been cancelled.
+// Automation workers must not approve, continue, retry or merge on behalf of such sessions.
+func inCancelledJob(ctx context.Context, db *sql.DB, sessionID string) bool {
+	var count int
+	err := db.QueryRowContext(ctx, "SELECT count(*) FROM jobs WHERE status = 'CANCELLED' AND session_ids LIKE ?", "%\""+sessionID+"\"%").Scan(&count)
+	return err == nil && count > 0
+}
+
+// prInCancelledJob reports whether the pull request was opened by a session of a cancelled job.
+func prInCancelledJob(ctx context.Context, db *sql.DB, prURL string) bool {
+	rows, err := db.QueryContext(ctx, "SELECT id FROM sessions WHERE pr_url = ?", prURL)
+	if err != nil {
+		return false
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	rows.Close()
+
+	for _, id := range ids {
+		if inCancelledJob(ctx, db, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -9,16 +9,24 @@ import (
 	"github.com/mcpany/jules/internal/logger"
 	"github.com/mcpany/jules/internal/service"
 	pb "github.com/mcpany/jules/proto"
-	"github.com/robfig/cron/v3"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// maxCronCatchUpRuns caps the jobs started at once for a cron job that runs all missed runs.
+const maxCronCatchUpRuns = 100
+
 type CronWorker struct {
 	BaseWorker
 	id             string
 	db             *sql.DB
 	cronJobService *service.CronJobServer
 	jobService     *service.JobServer
-	parser         cron.Parser
+}
+
+// dueCron is a cron job with the scheduled times it is due for.
+type dueCron struct {
+	cron *pb.CronJob
+	due  []time.Time
 }
 
 func NewCronWorker(database *sql.DB, cronJobService *service.CronJobServer, jobService *service.JobServer) *CronWorker {
@@ -31,7 +39,6 @@ func NewCronWorker(database *sql.DB, cronJobService *service.CronJobServer, jobS
 		db:             database,
 		cronJobService: cronJobService,
 		jobService:     jobService,
-		parser:         cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow),
 	}
 }
 
@@ -64,19 +71,20 @@ func (w *CronWorker) runCheck(ctx context.Context) error {
 	// Service ListCronJobs returns all.
 
 	// Direct DB query is better to filter 'enabled'
-	rows, err := w.db.QueryContext(ctx, "SELECT id, name, schedule, prompt, repo, branch, last_run_at, created_at, auto_approval, automation_mode, require_plan_approval, session_count, profile_id FROM cron_jobs WHERE enabled = 1")
+	rows, err := w.db.QueryContext(ctx, "SELECT id, name, schedule, prompt, repo, branch, last_run_at, created_at, auto_approval, automation_mode, require_plan_approval, session_count, profile_id, matrix, priority, time_zone, catch_up, jitter_seconds, skip_if_running FROM cron_jobs WHERE enabled = 1")
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
 
 	now := time.Now()
-	var jobsToTrigger []*pb.CronJob
+	var jobsToTrigger []dueCron
 
 	for rows.Next() {
 		var c pb.CronJob
 		var lastRunAt sql.NullString
 		var createdAt string
+		var matrix, timeZone, catchUp sql.NullString
 		var automationMode sql.NullString // Enum stored as string probably? Or int?
 		// Proto uses string for modes in some places, int in others?
 		// In DB schema it is TEXT.
@@ -85,15 +93,27 @@ func (w *CronWorker) runCheck(ctx context.Context) error {
 		err := rows.Scan(
 			&c.Id, &c.Name, &c.Schedule, &c.Prompt, &c.Repo, &c.Branch,
 			&lastRunAt, &createdAt, &c.AutoApproval, &automationMode,
-			&c.RequirePlanApproval, &c.SessionCount, &c.ProfileId,
+			&c.RequirePlanApproval, &c.SessionCount, &c.ProfileId, &matrix, &c.Priority,
+			&timeZone, &catchUp, &c.JitterSeconds, &c.SkipIfRunning,
 		)
 		if err != nil {
 			logger.Error("%s [%s]: scan error: %v", w.Name(), w.id, err)
 			continue
 		}
 
+		if matrix.Valid && matrix.String != "" {
+			c.Matrix = &pb.JobMatrix{}
+			if err := protojson.Unmarshal([]byte(matrix.String), c.Matrix); err != nil {
+				logger.Error("%s [%s]: invalid matrix for job %s: %v", w.Name(), w.id, c.Id, err)
+				continue
+			}
+		}
+
+		c.TimeZone = timeZone.String
+		c.CatchUp = service.ParseCronCatchUp(catchUp.String)
+
 		// Parse Schedule
-		schedule, err := w.parser.Parse(c.Schedule)
+		schedule, loc, err := service.ParseCronSchedule(c.Schedule, c.TimeZone)
 		if err != nil {
 			logger.Error("%s [%s]: invalid schedule for job %s: %v", w.Name(), w.id, c.Id, err)
 			continue
@@ -107,20 +127,71 @@ func (w *CronWorker) runCheck(ctx context.Context) error {
 			lastRunTime, _ = time.Parse(time.RFC3339, createdAt)
 		}
 
-		// Calculate next run time from last run
-		nextRun := schedule.Next(lastRunTime)
-		logger.Info("%s [%s]: Cron %s: LastRun %v, Next %v, Now %v", w.Name(), w.id, c.Name, lastRunTime, nextRun, now)
-
-		// If nextRun is in the past, it's due.
-		if nextRun.Before(now) {
-			logger.Info("%s [%s]: Job %s (%s) is due (Next: %v, Now: %v)", w.Name(), w.id, c.Name, c.Id, nextRun, now)
-			jobsToTrigger = append(jobsToTrigger, &c)
+		// Runs scheduled since the last one, including jitter
+		due := service.DueCronRuns(&c, schedule, loc, lastRunTime, now)
+		logger.Info("%s [%s]: Cron %s: LastRun %v, Due %d, Now %v", w.Name(), w.id, c.Name, lastRunTime, len(due), now)
+		if len(due) > 0 {
+			logger.Info("%s [%s]: Job %s (%s) is due (Scheduled: %v, Now: %v)", w.Name(), w.id, c.Name, c.Id, due[len(due)-1], now)
+			jobsToTrigger = append(jobsToTrigger, dueCron{cron: &c, due: due})
 		}
 	}
 	rows.Close()
 
-	for _, c := range jobsToTrigger {
-		// Trigger Job
+	for _, d := range jobsToTrigger {
+		w.trigger(ctx, d.cron, d.due, now)
+	}
+
+	return nil
+}
+
+// runsToFire applies the catch-up policy of a cron job to its due runs.
+func (w *CronWorker) runsToFire(c *pb.CronJob, due []time.Time, now time.Time) []time.Time {
+	latest := due[len(due)-1]
+	switch c.CatchUp {
+	case pb.CronCatchUp_CRON_CATCH_UP_SKIP:
+		// Only a run that became due since about the previous check is on time
+		if now.Sub(latest.Add(service.CronJitter(c.Id, latest, c.JitterSeconds))) > 2*w.Interval {
+			return nil
+		}
+		return due[len(due)-1:]
+	case pb.CronCatchUp_CRON_CATCH_UP_ALL:
+		if len(due) > maxCronCatchUpRuns {
+			return due[len(due)-maxCronCatchUpRuns:]
+		}
+		return due
+	default:
+		return due[len(due)-1:]
+	}
+}
+
+// trigger starts the jobs of a due cron job and records the latest run handled in last_run_at.
+// Runs that are skipped still advance last_run_at; a failed run is retried on the next check
+// and recorded as failed once.
+func (w *CronWorker) trigger(ctx context.Context, c *pb.CronJob, due []time.Time, now time.Time) {
+	var handled time.Time
+	runs := w.runsToFire(c, due, now)
+	if len(runs) < len(due) {
+		logger.Info("%s [%s]: Skipping %d missed runs of cron %s", w.Name(), w.id, len(due)-len(runs), c.Id)
+	}
+	if len(runs) == 0 {
+		handled = due[len(due)-1]
+		w.recordRun(ctx, c, handled, service.CronRunSkipped, "missed while the server was down", "")
+	}
+
+	if len(runs) > 0 && c.SkipIfRunning {
+		active, err := w.cronJobService.CronRunActive(ctx, c.Id)
+		if err != nil {
+			logger.Error("%s [%s]: %v", w.Name(), w.id, err)
+			return
+		}
+		if active {
+			logger.Info("%s [%s]: Skipping cron %s, its previous run is still active", w.Name(), w.id, c.Id)
+			runs, handled = nil, due[len(due)-1]
+			w.recordRun(ctx, c, handled, service.CronRunSkipped, "previous run is still active", "")
+		}
+	}
+
+	for _, run := range runs {
 		newJobId := uuid.New().String()
 
 		jobReq := &pb.CreateJobRequest{
@@ -136,22 +207,49 @@ func (w *CronWorker) runCheck(ctx context.Context) error {
 			RequirePlanApproval: c.RequirePlanApproval,
 			CronJobId:           c.Id,
 			ProfileId:           c.ProfileId,
+			Matrix:              c.Matrix,
+			Priority:            c.Priority,
 		}
 
 		_, err := w.jobService.CreateJob(ctx, jobReq)
 		if err != nil {
 			logger.Error("%s [%s]: Failed to create job for cron %s: %v", w.Name(), w.id, c.Id, err)
-			continue
+			// The run stays due until it succeeds, so only its first failure goes in the history
+			recorded, rerr := w.cronJobService.CronRunRecorded(ctx, c.Id, run.Format(time.RFC3339), service.CronRunFailed)
+			if rerr != nil {
+				logger.Error("%s [%s]: %v", w.Name(), w.id, rerr)
+			} else if !recorded {
+				w.recordRun(ctx, c, run, service.CronRunFailed, err.Error(), "")
+			}
+			break
 		}
+		handled = run
+		w.recordRun(ctx, c, run, service.CronRunStarted, "", newJobId)
 
-		// Update LastRunAt
-		_, err = w.db.ExecContext(ctx, "UPDATE cron_jobs SET last_run_at = ? WHERE id = ?", now.Format(time.RFC3339), c.Id)
-		if err != nil {
-			logger.Error("%s [%s]: Failed to update last_run_at for cron %s: %v", w.Name(), w.id, c.Id, err)
-		}
+		logger.Info("%s [%s]: Triggered job %s for cron %s (scheduled %s)", w.Name(), w.id, newJobId, c.Id, run.Format(time.RFC3339))
+	}
 
-		logger.Info("%s [%s]: Triggered job %s for cron %s", w.Name(), w.id, newJobId, c.Id)
+	if handled.IsZero() {
+		return
+	}
+	// Update LastRunAt
+	_, err := w.db.ExecContext(ctx, "UPDATE cron_jobs SET last_run_at = ? WHERE id = ?", handled.Format(time.RFC3339), c.Id)
+	if err != nil {
+		logger.Error("%s [%s]: Failed to update last_run_at for cron %s: %v", w.Name(), w.id, c.Id, err)
 	}
+}
 
-	return nil
+// recordRun adds a scheduled run to the history of a cron job.
+func (w *CronWorker) recordRun(ctx context.Context, c *pb.CronJob, scheduled time.Time, status, message, jobID string) {
+	err := w.cronJobService.RecordCronRun(ctx, &pb.CronRun{
+		CronJobId:   c.Id,
+		Trigger:     pb.CronTrigger_CRON_TRIGGER_SCHEDULED,
+		ScheduledAt: scheduled.Format(time.RFC3339),
+		Status:      status,
+		Message:     message,
+		JobId:       jobID,
+	})
+	if err != nil {
+		logger.Error("%s [%s]: %v", w.Name(), w.id, err)
+	}
 }
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -62,3 +63,127 @@ func TestCronWorker_RunCheck(t *testing.T) {
 	}
 	assert.True(t, found, "Job should have been created from cron")
 }
+
+func TestCronWorker_CatchUpPolicies(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cronSvc := &service.CronJobServer{DB: db}
+	jobSvc := &service.JobServer{DB: db}
+	w := NewCronWorker(db, cronSvc, jobSvc)
+	ctx := context.Background()
+
+	// Hourly crons that missed the last three runs
+	lastRun := time.Now().UTC().Truncate(time.Hour).Add(-3 * time.Hour)
+	policies := map[string]pb.CronCatchUp{
+		"once":    pb.CronCatchUp_CRON_CATCH_UP_UNSPECIFIED,
+		"all":     pb.CronCatchUp_CRON_CATCH_UP_ALL,
+		"running": pb.CronCatchUp_CRON_CATCH_UP_ONCE,
+	}
+	for id, policy := range policies {
+		_, err := cronSvc.CreateCronJob(ctx, &pb.CreateCronJobRequest{
+			Id: id, Name: id, Schedule: "0 * * * *", TimeZone: "UTC", Prompt: "Test", Repo: "test/repo", Branch: "main",
+			CatchUp: policy, SkipIfRunning: id == "running",
+		})
+		assert.NoError(t, err)
+		_, err = db.Exec("UPDATE cron_jobs SET last_run_at = ? WHERE id = ?", lastRun.Format(time.RFC3339), id)
+		assert.NoError(t, err)
+	}
+
+	// The previous run of "running" is still pending
+	_, err := jobSvc.CreateJob(ctx, &pb.CreateJobRequest{Name: "previous", Repo: "test/repo", Branch: "main", Background: true, Status: service.JobStatusPending, CronJobId: "running"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.runCheck(ctx))
+
+	count := func(cronID string) int {
+		var n int
+		assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM jobs WHERE cron_job_id = ?", cronID).Scan(&n))
+		return n
+	}
+	assert.Equal(t, 1, count("once"))
+	assert.Equal(t, 3, count("all"))
+	assert.Equal(t, 1, count("running"))
+
+	// Every cron advanced to the latest scheduled run, so nothing fires again
+	var lastRunAt string
+	assert.NoError(t, db.QueryRow("SELECT last_run_at FROM cron_jobs WHERE id = 'running'").Scan(&lastRunAt))
+	handled, err := time.Parse(time.RFC3339, lastRunAt)
+	assert.NoError(t, err)
+	assert.True(t, handled.Equal(lastRun.Add(3*time.Hour)))
+
+	assert.NoError(t, w.runCheck(ctx))
+	assert.Equal(t, 1, count("once"))
+	assert.Equal(t, 3, count("all"))
+
+	// Each trigger is in the run history
+	runs, err := cronSvc.ListCronRuns(ctx, &pb.ListCronRunsRequest{CronJobId: "all"})
+	assert.NoError(t, err)
+	assert.Len(t, runs.Runs, 3)
+	for _, r := range runs.Runs {
+		assert.Equal(t, pb.CronTrigger_CRON_TRIGGER_SCHEDULED, r.Trigger)
+		assert.Equal(t, service.CronRunStarted, r.Status)
+		assert.NotEmpty(t, r.JobId)
+	}
+	runs, err = cronSvc.ListCronRuns(ctx, &pb.ListCronRunsRequest{CronJobId: "running"})
+	assert.NoError(t, err)
+	assert.Len(t, runs.Runs, 1)
+	assert.Equal(t, service.CronRunSkipped, runs.Runs[0].Status)
+	assert.Equal(t, lastRun.Add(3*time.Hour).Format(time.RFC3339), runs.Runs[0].ScheduledAt)
+}
+
+func TestCronWorker_FailedRunRecordedOnce(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cronSvc := &service.CronJobServer{DB: db}
+	jobSvc := &service.JobServer{DB: db}
+	w := NewCronWorker(db, cronSvc, jobSvc)
+	ctx := context.Background()
+
+	_, err := cronSvc.CreateCronJob(ctx, &pb.CreateCronJobRequest{
+		Id: "broken", Name: "broken", Schedule: "0 * * * *", TimeZone: "UTC", Prompt: "Test", Repo: "test/repo", Branch: "main",
+	})
+	assert.NoError(t, err)
+	// A prompt the job service rejects, so every attempt to start the run fails
+	lastRun := time.Now().UTC().Truncate(time.Hour).Add(-time.Hour)
+	_, err = db.Exec("UPDATE cron_jobs SET prompt = ?, last_run_at = ? WHERE id = 'broken'", strings.Repeat("x", 50001), lastRun.Format(time.RFC3339))
+	assert.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, w.runCheck(ctx))
+	}
+
+	runs, err := cronSvc.ListCronRuns(ctx, &pb.ListCronRunsRequest{CronJobId: "broken"})
+	assert.NoError(t, err)
+	assert.Len(t, runs.Runs, 1)
+	assert.Equal(t, service.CronRunFailed, runs.Runs[0].Status)
+	assert.Equal(t, lastRun.Add(time.Hour).Format(time.RFC3339), runs.Runs[0].ScheduledAt)
+
+	// The run is still due and starts once the cron job is fixed
+	_, err = db.Exec("UPDATE cron_jobs SET prompt = 'Test' WHERE id = 'broken'")
+	assert.NoError(t, err)
+	assert.NoError(t, w.runCheck(ctx))
+	runs, err = cronSvc.ListCronRuns(ctx, &pb.ListCronRunsRequest{CronJobId: "broken"})
+	assert.NoError(t, err)
+	assert.Len(t, runs.Runs, 2)
+	var n int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM jobs WHERE cron_job_id = 'broken'").Scan(&n))
+	assert.Equal(t, 1, n)
+}
+
+func TestCronWorker_RunsToFire(t *testing.T) {
+	w := NewCronWorker(nil, nil, nil)
+	scheduled := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	due := []time.Time{scheduled.Add(-2 * time.Hour), scheduled.Add(-time.Hour), scheduled}
+
+	skip := &pb.CronJob{Id: "c", CatchUp: pb.CronCatchUp_CRON_CATCH_UP_SKIP}
+	assert.Equal(t, due[2:], w.runsToFire(skip, due, scheduled.Add(30*time.Second)))
+	assert.Empty(t, w.runsToFire(skip, due, scheduled.Add(time.Hour)))
+
+	once := &pb.CronJob{Id: "c", CatchUp: pb.CronCatchUp_CRON_CATCH_UP_ONCE}
+	assert.Equal(t, due[2:], w.runsToFire(once, due, scheduled.Add(time.Hour)))
+
+	all := &pb.CronJob{Id: "c", CatchUp: pb.CronCatchUp_CRON_CATCH_UP_ALL}
+	assert.Equal(t, due, w.runsToFire(all, due, scheduled.Add(time.Hour)))
+}
@@ -0,0 +1,314 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/google/uuid"
+	gclient "github.com/mcpany/jules/internal/github"
+	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+)
+
+const dependabotLogin = "dependabot[bot]"
+
+// TriggerGitHubClient is the part of the GitHub API the event trigger worker polls.
+type TriggerGitHubClient interface {
+	ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error)
+	ListIssueEvents(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error)
+	GetBranch(ctx context.Context, owner, repo, branch string) (*github.Branch, error)
+	ListCheckRunsForRef(ctx context.Context, owner, repo, ref string, opts *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error)
+	ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error)
+	ListPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error)
+}
+
+// EventTriggerWorker polls GitHub for the events of enabled event triggers and starts their jobs.
+// Only events after a trigger was created are considered.
+type EventTriggerWorker struct {
+	BaseWorker
+	id             string
+	db             *sql.DB
+	triggerService *service.EventTriggerServer
+	githubClient   TriggerGitHubClient
+}
+
+func NewEventTriggerWorker(database *sql.DB, triggerService *service.EventTriggerServer, gh TriggerGitHubClient) *EventTriggerWorker {
+	return &EventTriggerWorker{
+		BaseWorker: BaseWorker{
+			NameStr:  "EventTriggerWorker",
+			Interval: 120 * time.Second,
+		},
+		id:             uuid.New().String()[:8],
+		db:             database,
+		triggerService: triggerService,
+		githubClient:   gh,
+	}
+}
+
+func (w *EventTriggerWorker) Start(ctx context.Context) error {
+	logger.Info("%s [%s] starting...", w.Name(), w.id)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.Interval):
+			status := "Success"
+			if err := w.runCheck(ctx); err != nil {
+				logger.Error("%s [%s] check failed: %s", w.Name(), w.id, err.Error())
+				status = "Failed"
+			}
+			nextRun := time.Now().Add(w.Interval)
+			logger.Info("%s [%s] task completed. Status: %s. Next run at %s", w.Name(), w.id, status, nextRun.Format(time.RFC3339))
+		}
+	}
+}
+
+func (w *EventTriggerWorker) runCheck(ctx context.Context) error {
+	triggers, err := w.triggerService.EnabledEventTriggers(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range triggers {
+		events, err := w.events(ctx, t)
+		if err != nil {
+			logger.Error("%s [%s]: Failed to poll events of trigger %s: %s", w.Name(), w.id, t.Id, err.Error())
+			continue
+		}
+		for _, ev := range events {
+			job, err := w.triggerService.Fire(ctx, t, ev)
+			if err != nil {
+				logger.Error("%s [%s]: Failed to start job of trigger %s for %s: %s", w.Name(), w.id, t.Id, ev.Key, err.Error())
+				continue
+			}
+			if job != nil {
+				logger.Info("%s [%s]: Trigger %s started job %s for %s", w.Name(), w.id, t.Id, job.Id, ev.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// events lists the current events of a trigger's type on its repo, including ones already handled.
+func (w *EventTriggerWorker) events(ctx context.Context, t *pb.EventTrigger) ([]service.Event, error) {
+	r, err := gclient.ParseRepo(t.Repo)
+	if err != nil {
+		return nil, err
+	}
+	ctx, owner, repo := r.Context(ctx), r.Owner, r.Name
+	since, err := time.Parse(time.RFC3339, t.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+
+	switch t.Event {
+	case pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED:
+		return w.labeledIssues(ctx, owner, repo, t.Label, since)
+	case pb.TriggerEvent_TRIGGER_EVENT_CI_FAILED:
+		return w.failedChecks(ctx, owner, repo, t.Branch, since)
+	case pb.TriggerEvent_TRIGGER_EVENT_RELEASE:
+		return w.releases(ctx, owner, repo, since)
+	case pb.TriggerEvent_TRIGGER_EVENT_DEPENDABOT_PR:
+		return w.dependabotPRs(ctx, owner, repo, since)
+	}
+	return nil, fmt.Errorf("unsupported event %s", t.Event)
+}
+
+// labeledIssues lists open issues that were given the label since the given time.
+func (w *EventTriggerWorker) labeledIssues(ctx context.Context, owner, repo, label string, since time.Time) ([]service.Event, error) {
+	issues, _, err := w.githubClient.ListIssues(ctx, owner, repo, &github.IssueListByRepoOptions{
+		State:       "open",
+		Labels:      []string{label},
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 50},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []service.Event
+	for _, issue := range issues {
+		if issue.IsPullRequest() {
+			continue
+		}
+		// Issues are listed by update time, so older issues may have been labelled long ago
+		labeled, err := w.labeledSince(ctx, owner, repo, issue, label, since)
+		if err != nil {
+			return nil, err
+		}
+		if !labeled {
+			continue
+		}
+		var labels []string
+		for _, l := range issue.Labels {
+			labels = append(labels, l.GetName())
+		}
+		number := strconv.Itoa(issue.GetNumber())
+		events = append(events, service.Event{
+			Type:    pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED,
+			Key:     "issue/" + number,
+			Summary: fmt.Sprintf("#%s %s", number, issue.GetTitle()),
+			URL:     issue.GetHTMLURL(),
+			Vars: map[string]string{
+				"number": number,
+				"title":  issue.GetTitle(),
+				"body":   issue.GetBody(),
+				"author": issue.GetUser().GetLogin(),
+				"labels": strings.Join(labels, ", "),
+			},
+		})
+	}
+	return events, nil
+}
+
+// labeledSince reports whether the label was added to the issue at or after the given time.
+// Issues opened since then can only have been labelled since.
+func (w *EventTriggerWorker) labeledSince(ctx context.Context, owner, repo string, issue *github.Issue, label string, since time.Time) (bool, error) {
+	if !issue.GetCreatedAt().Before(since) {
+		return true, nil
+	}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		events, resp, err := w.githubClient.ListIssueEvents(ctx, owner, repo, issue.GetNumber(), opts)
+		if err != nil {
+			return false, err
+		}
+		for _, e := range events {
+			if e.GetEvent() == "labeled" && strings.EqualFold(e.GetLabel().GetName(), label) && !e.GetCreatedAt().Before(since) {
+				return true, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return false, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// failedChecks reports the head commit of the branch once all its checks completed and some failed.
+func (w *EventTriggerWorker) failedChecks(ctx context.Context, owner, repo, branch string, since time.Time) ([]service.Event, error) {
+	b, err := w.githubClient.GetBranch(ctx, owner, repo, branch)
+	if err != nil {
+		return nil, err
+	}
+	sha := b.GetCommit().GetSHA()
+	if sha == "" {
+		return nil, nil
+	}
+	result, _, err := w.githubClient.ListCheckRunsForRef(ctx, owner, repo, sha, &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}})
+	if err != nil {
+		return nil, err
+	}
+
+	var names, details []string
+	var url string
+	for _, run := range result.CheckRuns {
+		if run.GetStatus() != "completed" {
+			// Wait for every check so the prompt lists all failures
+			return nil, nil
+		}
+		if !isFailingConclusion(run.GetConclusion()) || run.GetCompletedAt().Before(since) {
+			continue
+		}
+		if url == "" {
+			url = run.GetHTMLURL()
+		}
+		names = append(names, run.GetName())
+		details = append(details, fmt.Sprintf("- %s: %s %s", run.GetName(), run.GetConclusion(), run.GetHTMLURL()))
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	short := sha
+	if len(short) > 7 {
+		short = short[:7]
+	}
+	return []service.Event{{
+		Type:    pb.TriggerEvent_TRIGGER_EVENT_CI_FAILED,
+		Key:     "checks/" + sha,
+		Summary: fmt.Sprintf("CI failed on %s (%s)", branch, short),
+		URL:     url,
+		Vars: map[string]string{
+			"sha":            sha,
+			"checks":         strings.Join(names, ", "),
+			"check_details":  strings.Join(details, "\n"),
+			"commit_message": b.GetCommit().GetCommit().GetMessage(),
+		},
+	}}, nil
+}
+
+// releases lists the releases published since the given time.
+func (w *EventTriggerWorker) releases(ctx context.Context, owner, repo string, since time.Time) ([]service.Event, error) {
+	releases, _, err := w.githubClient.ListReleases(ctx, owner, repo, &github.ListOptions{PerPage: 20})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []service.Event
+	for _, r := range releases {
+		if r.GetDraft() || r.GetPublishedAt().Before(since) {
+			continue
+		}
+		events = append(events, service.Event{
+			Type:    pb.TriggerEvent_TRIGGER_EVENT_RELEASE,
+			Key:     "release/" + r.GetTagName(),
+			Summary: "Release " + r.GetTagName(),
+			URL:     r.GetHTMLURL(),
+			Vars: map[string]string{
+				"tag":    r.GetTagName(),
+				"name":   r.GetName(),
+				"body":   r.GetBody(),
+				"author": r.GetAuthor().GetLogin(),
+			},
+		})
+	}
+	return events, nil
+}
+
+// dependabotPRs lists the open PRs Dependabot opened since the given time. Jobs start from the PR's branch.
+func (w *EventTriggerWorker) dependabotPRs(ctx context.Context, owner, repo string, since time.Time) ([]service.Event, error) {
+	prs, _, err := w.githubClient.ListPullRequests(ctx, owner, repo, &github.PullRequestListOptions{
+		State:       "open",
+		Sort:        "created",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: 50},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []service.Event
+	for _, pr := range prs {
+		if pr.GetUser().GetLogin() != dependabotLogin || pr.GetCreatedAt().Before(since) {
+			continue
+		}
+		number := strconv.Itoa(pr.GetNumber())
+		events = append(events, service.Event{
+			Type:    pb.TriggerEvent_TRIGGER_EVENT_DEPENDABOT_PR,
+			Key:     "pr/" + number,
+			Summary: fmt.Sprintf("#%s %s", number, pr.GetTitle()),
+			URL:     pr.GetHTMLURL(),
+			Branch:  pr.GetHead().GetRef(),
+			Vars: map[string]string{
+				"number":      number,
+				"title":       pr.GetTitle(),
+				"body":        pr.GetBody(),
+				"author":      pr.GetUser().GetLogin(),
+				"head_branch": pr.GetHead().GetRef(),
+			},
+		})
+	}
+	return events, nil
+}
+
+func isFailingConclusion(conclusion string) bool {
+	return conclusion == "failure" || conclusion == "timed_out" || conclusion == "cancelled"
+}
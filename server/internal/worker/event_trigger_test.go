@@ -0,0 +1,197 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTriggerGitHubClient struct {
+	issues      []*github.Issue
+	issueEvents map[int][]*github.IssueEvent
+	branch      *github.Branch
+	checkRuns   []*github.CheckRun
+	releases    []*github.RepositoryRelease
+	prs         []*github.PullRequest
+	labels      []string
+}
+
+func (m *mockTriggerGitHubClient) ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	m.labels = opts.Labels
+	return m.issues, &github.Response{}, nil
+}
+
+func (m *mockTriggerGitHubClient) ListIssueEvents(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error) {
+	return m.issueEvents[number], &github.Response{}, nil
+}
+
+func (m *mockTriggerGitHubClient) GetBranch(ctx context.Context, owner, repo, branch string) (*github.Branch, error) {
+	return m.branch, nil
+}
+
+func (m *mockTriggerGitHubClient) ListCheckRunsForRef(ctx context.Context, owner, repo, ref string, opts *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error) {
+	return &github.ListCheckRunsResults{CheckRuns: m.checkRuns}, &github.Response{}, nil
+}
+
+func (m *mockTriggerGitHubClient) ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+	return m.releases, &github.Response{}, nil
+}
+
+func (m *mockTriggerGitHubClient) ListPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	return m.prs, &github.Response{}, nil
+}
+
+func TestEventTriggerWorker_StartsJobs(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	jobSvc := &service.JobServer{DB: db}
+	triggerSvc := &service.EventTriggerServer{DB: db, Jobs: jobSvc}
+
+	now := time.Now()
+	before := &github.Timestamp{Time: now.Add(-2 * time.Hour)}
+	after := &github.Timestamp{Time: now.Add(-10 * time.Minute)}
+	gh := &mockTriggerGitHubClient{
+		issues: []*github.Issue{
+			{Number: github.Int(5), Title: github.String("Crash on start"), Body: github.String("Stack trace"), HTMLURL: github.String("https://github.com/o/r/issues/5"), CreatedAt: after},
+			{Number: github.Int(6), Title: github.String("A PR"), PullRequestLinks: &github.PullRequestLinks{URL: github.String("x")}},
+		},
+		branch: &github.Branch{Commit: &github.RepositoryCommit{SHA: github.String("abcdef123456")}},
+		checkRuns: []*github.CheckRun{
+			{Name: github.String("lint"), Status: github.String("completed"), Conclusion: github.String("success"), CompletedAt: after},
+			{Name: github.String("test"), Status: github.String("completed"), Conclusion: github.String("failure"), CompletedAt: after, HTMLURL: github.String("https://github.com/o/r/runs/1")},
+		},
+		releases: []*github.RepositoryRelease{
+			{TagName: github.String("v1.1.0"), PublishedAt: after},
+			{TagName: github.String("v1.0.0"), PublishedAt: before},
+			{TagName: github.String("v2.0.0-rc"), Draft: github.Bool(true)},
+		},
+		prs: []*github.PullRequest{
+			{Number: github.Int(9), Title: github.String("Bump lodash"), User: &github.User{Login: github.String("dependabot[bot]")}, CreatedAt: after, Head: &github.PullRequestBranch{Ref: github.String("dependabot/npm_and_yarn/lodash-4.17.21")}},
+			{Number: github.Int(10), Title: github.String("Feature"), User: &github.User{Login: github.String("alice")}, CreatedAt: after},
+		},
+	}
+	w := NewEventTriggerWorker(db, triggerSvc, gh)
+
+	for _, req := range []*pb.CreateEventTriggerRequest{
+		{Id: "issues", Name: "Issues", Event: pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED, Prompt: "Fix #{{number}}: {{title}}"},
+		{Id: "ci", Name: "CI", Event: pb.TriggerEvent_TRIGGER_EVENT_CI_FAILED, Prompt: "Fix {{checks}} on {{sha}}\n{{check_details}}"},
+		{Id: "release", Name: "Release", Event: pb.TriggerEvent_TRIGGER_EVENT_RELEASE, Prompt: "Write notes for {{tag}}"},
+		{Id: "deps", Name: "Deps", Event: pb.TriggerEvent_TRIGGER_EVENT_DEPENDABOT_PR, Prompt: "Make {{head_branch}} pass"},
+	} {
+		req.Repo = "o/r"
+		_, err := triggerSvc.CreateEventTrigger(ctx, req)
+		assert.NoError(t, err)
+	}
+	_, err := db.Exec("UPDATE event_triggers SET created_at = ?", now.Add(-time.Hour).Format(time.RFC3339))
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.runCheck(ctx))
+	assert.Equal(t, []string{"jules"}, gh.labels)
+
+	jobsOf := func(triggerID string) []*pb.Job {
+		events, err := triggerSvc.ListTriggeredEvents(ctx, &pb.ListTriggeredEventsRequest{TriggerId: triggerID})
+		assert.NoError(t, err)
+		var jobs []*pb.Job
+		for _, e := range events.Events {
+			job, err := jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: e.JobId})
+			assert.NoError(t, err)
+			jobs = append(jobs, job)
+		}
+		return jobs
+	}
+
+	issueJobs := jobsOf("issues")
+	assert.Len(t, issueJobs, 1)
+	assert.Equal(t, "Fix #5: Crash on start", issueJobs[0].Prompt)
+
+	ciJobs := jobsOf("ci")
+	assert.Len(t, ciJobs, 1)
+	assert.Equal(t, "CI / CI failed on main (abcdef1)", ciJobs[0].Name)
+	assert.Equal(t, "Fix test on abcdef123456\n- test: failure https://github.com/o/r/runs/1", ciJobs[0].Prompt)
+
+	releaseJobs := jobsOf("release")
+	assert.Len(t, releaseJobs, 1)
+	assert.Equal(t, "Write notes for v1.1.0", releaseJobs[0].Prompt)
+
+	depJobs := jobsOf("deps")
+	assert.Len(t, depJobs, 1)
+	assert.Equal(t, "dependabot/npm_and_yarn/lodash-4.17.21", depJobs[0].Branch)
+
+	// Polling again does not start the same jobs twice
+	assert.NoError(t, w.runCheck(ctx))
+	var count int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM jobs").Scan(&count))
+	assert.Equal(t, 4, count)
+}
+
+func TestEventTriggerWorker_IssuesLabelledBeforeTrigger(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	jobSvc := &service.JobServer{DB: db}
+	triggerSvc := &service.EventTriggerServer{DB: db, Jobs: jobSvc}
+
+	now := time.Now()
+	opened := &github.Timestamp{Time: now.AddDate(-1, 0, 0)}
+	labeled := func(name string, at time.Time) *github.IssueEvent {
+		return &github.IssueEvent{Event: github.String("labeled"), Label: &github.Label{Name: github.String(name)}, CreatedAt: &github.Timestamp{Time: at}}
+	}
+	// Both issues are old and were just commented on, so GitHub lists them as updated
+	gh := &mockTriggerGitHubClient{
+		issues: []*github.Issue{
+			{Number: github.Int(1), Title: github.String("Labelled long ago"), CreatedAt: opened},
+			{Number: github.Int(2), Title: github.String("Labelled just now"), CreatedAt: opened},
+		},
+		issueEvents: map[int][]*github.IssueEvent{
+			1: {labeled("jules", now.Add(-2*time.Hour))},
+			2: {labeled("bug", now.Add(-2*time.Hour)), labeled("Jules", now.Add(-10*time.Minute))},
+		},
+	}
+	w := NewEventTriggerWorker(db, triggerSvc, gh)
+
+	_, err := triggerSvc.CreateEventTrigger(ctx, &pb.CreateEventTriggerRequest{
+		Id: "issues", Name: "Issues", Event: pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED, Repo: "o/r", Prompt: "Fix #{{number}}",
+	})
+	assert.NoError(t, err)
+	_, err = db.Exec("UPDATE event_triggers SET created_at = ?", now.Add(-time.Hour).Format(time.RFC3339))
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.runCheck(ctx))
+	events, err := triggerSvc.ListTriggeredEvents(ctx, &pb.ListTriggeredEventsRequest{TriggerId: "issues"})
+	assert.NoError(t, err)
+	if assert.Len(t, events.Events, 1) {
+		assert.Equal(t, "issue/2", events.Events[0].EventKey)
+	}
+}
+
+func TestEventTriggerWorker_WaitsForChecks(t *testing.T) {
+	completed := &github.Timestamp{Time: time.Now()}
+	gh := &mockTriggerGitHubClient{
+		branch: &github.Branch{Commit: &github.RepositoryCommit{SHA: github.String("abc")}},
+		checkRuns: []*github.CheckRun{
+			{Name: github.String("test"), Status: github.String("completed"), Conclusion: github.String("failure"), CompletedAt: completed},
+			{Name: github.String("e2e"), Status: github.String("in_progress")},
+		},
+	}
+	w := NewEventTriggerWorker(nil, nil, gh)
+
+	events, err := w.failedChecks(context.Background(), "o", "r", "main", time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	gh.checkRuns[1].Status = github.String("completed")
+	gh.checkRuns[1].Conclusion = github.String("timed_out")
+	gh.checkRuns[1].CompletedAt = completed
+	events, err = w.failedChecks(context.Background(), "o", "r", "main", time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "test, e2e", events[0].Vars["checks"])
+}
@@ -0,0 +1,403 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/google/uuid"
+	gclient "github.com/mcpany/jules/internal/github"
+	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+)
+
+var pullRequestURLRegex = regexp.MustCompile(`^https?://[^/]+/([^/]+)/([^/]+)/pull/(\d+)`)
+
+// issueClaimTimeout is how long an issue stays claimed by a job that was never created, e.g.
+// because the server stopped in between, before another check may claim it.
+const issueClaimTimeout = 10 * time.Minute
+
+// IssueGitHubClient is the part of the GitHub API the issue automation uses.
+type IssueGitHubClient interface {
+	ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error)
+	GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error)
+	AddLabelsToIssue(ctx context.Context, owner, repo string, number int, labels []string) error
+	CreateComment(ctx context.Context, owner, repo string, number int, body string) error
+	GetRepository(ctx context.Context, owner, repo string) (*github.Repository, error)
+	GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error)
+	UpdatePullRequestBody(ctx context.Context, owner, repo string, number int, body string) (*github.PullRequest, error)
+}
+
+// IssueAutomationWorker turns labelled GitHub issues of the configured repos into jobs.
+// It comments the session link on the issue and makes sure the session's PR closes it.
+type IssueAutomationWorker struct {
+	BaseWorker
+	id              string
+	db              *sql.DB
+	settingsService *service.SettingsServer
+	promptService   *service.PromptServer
+	jobService      *service.JobServer
+	githubClient    IssueGitHubClient
+}
+
+func NewIssueAutomationWorker(database *sql.DB, settingsService *service.SettingsServer, promptService *service.PromptServer, jobService *service.JobServer, gh IssueGitHubClient) *IssueAutomationWorker {
+	return &IssueAutomationWorker{
+		BaseWorker: BaseWorker{
+			NameStr:  "IssueAutomationWorker",
+			Interval: 120 * time.Second,
+		},
+		id:              uuid.New().String()[:8],
+		db:              database,
+		settingsService: settingsService,
+		promptService:   promptService,
+		jobService:      jobService,
+		githubClient:    gh,
+	}
+}
+
+func (w *IssueAutomationWorker) Start(ctx context.Context) error {
+	logger.Info("%s [%s] starting...", w.Name(), w.id)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.Interval):
+			status := "Success"
+			if err := w.runCheck(ctx); err != nil {
+				logger.Error("%s [%s] check failed: %s", w.Name(), w.id, err.Error())
+				status = "Failed"
+			}
+			nextRun := time.Now().Add(w.Interval)
+			logger.Info("%s [%s] task completed. Status: %s. Next run at %s", w.Name(), w.id, status, nextRun.Format(time.RFC3339))
+		}
+	}
+}
+
+func (w *IssueAutomationWorker) runCheck(ctx context.Context) error {
+	rows, err := w.db.QueryContext(ctx, "SELECT profile_id FROM settings WHERE issue_automation_enabled = 1")
+	if err != nil {
+		return err
+	}
+	var profiles []string
+	for rows.Next() {
+		var p string
+		if err := rows.Scan(&p); err != nil {
+			rows.Close()
+			return err
+		}
+		profiles = append(profiles, p)
+	}
+	rows.Close()
+
+	for _, profileID := range profiles {
+		s, err := w.settingsService.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: profileID})
+		if err != nil {
+			logger.Error("%s [%s]: Failed to get settings of profile %s: %s", w.Name(), w.id, profileID, err.Error())
+			continue
+		}
+		repos, err := service.ParseRepoList(s.GetIssueAutomationRepos())
+		if err != nil {
+			logger.Error("%s [%s]: Invalid issue automation repos of profile %s: %s", w.Name(), w.id, profileID, err.Error())
+			continue
+		}
+		for _, repo := range repos {
+			if err := w.pickUpIssues(ctx, profileID, s.GetIssueAutomationLabel(), repo); err != nil {
+				logger.Error("%s [%s]: Failed to check issues of %s: %s", w.Name(), w.id, repo, err.Error())
+			}
+		}
+	}
+
+	return w.followUp(ctx)
+}
+
+// pickUpIssues starts a job for every open issue of the repo with the label that has none yet.
+func (w *IssueAutomationWorker) pickUpIssues(ctx context.Context, profileID, label, fullName string) error {
+	r, err := gclient.ParseRepo(fullName)
+	if err != nil {
+		return err
+	}
+	ctx, owner, repo := r.Context(ctx), r.Owner, r.Name
+	covered, err := w.coveredByEventTrigger(ctx, fullName, label)
+	if err != nil {
+		return err
+	}
+	if covered {
+		logger.Warn("%s [%s]: An event trigger starts jobs for issues of %s labelled %q; leaving them to it", w.Name(), w.id, fullName, label)
+		return nil
+	}
+	issues, _, err := w.githubClient.ListIssues(ctx, owner, repo, &github.IssueListByRepoOptions{
+		State:       "open",
+		Labels:      []string{label},
+		ListOptions: github.ListOptions{PerPage: 50},
+	})
+	if err != nil {
+		return err
+	}
+
+	var branch string
+	for _, issue := range issues {
+		if issue.IsPullRequest() || hasLabel(issue, service.IssueInProgressLabel(label)) {
+			continue
+		}
+		if branch == "" {
+			r, err := w.githubClient.GetRepository(ctx, owner, repo)
+			if err != nil {
+				return fmt.Errorf("failed to get default branch: %w", err)
+			}
+			branch = r.GetDefaultBranch()
+		}
+		if err := w.startJob(ctx, profileID, label, fullName, branch, issue); err != nil {
+			logger.Error("%s [%s]: Failed to start job for %s#%d: %s", w.Name(), w.id, fullName, issue.GetNumber(), err.Error())
+		}
+	}
+	return nil
+}
+
+// coveredByEventTrigger reports whether an enabled ISSUE_LABELED event trigger starts jobs for
+// the issues of the repo with the label, so that an issue doesn't start two jobs.
+func (w *IssueAutomationWorker) coveredByEventTrigger(ctx context.Context, repo, label string) (bool, error) {
+	var covered bool
+	err := w.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM event_triggers WHERE enabled = 1 AND event = ? AND repo = ? COLLATE NOCASE AND label = ? COLLATE NOCASE)`,
+		service.TriggerEventString(pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED), repo, label).Scan(&covered)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up event triggers: %w", err)
+	}
+	return covered, nil
+}
+
+func (w *IssueAutomationWorker) startJob(ctx context.Context, profileID, label, fullName, branch string, issue *github.Issue) error {
+	r, err := gclient.ParseRepo(fullName)
+	if err != nil {
+		return err
+	}
+	owner, repo := r.Owner, r.Name
+	number := issue.GetNumber()
+	jobID := uuid.New().String()
+
+	// Claim the issue first so it is never picked up twice. The claim stays pending until its
+	// job exists; a pending claim older than issueClaimTimeout is taken over.
+	now := time.Now()
+	res, err := w.db.ExecContext(ctx, `
+		INSERT INTO issue_jobs (repo, issue_number, title, job_id, profile_id, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(repo, issue_number) DO UPDATE SET
+			title = excluded.title, job_id = excluded.job_id, profile_id = excluded.profile_id, created_at = excluded.created_at
+		WHERE issue_jobs.created_at < ? AND NOT EXISTS (SELECT 1 FROM jobs WHERE jobs.id = issue_jobs.job_id)`,
+		fullName, number, issue.GetTitle(), jobID, profileID, now.Format(time.RFC3339), now.Add(-issueClaimTimeout).Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil
+	}
+
+	repoPrompt, err := w.promptService.GetRepoPrompt(ctx, &pb.GetRepoPromptRequest{Repo: fullName})
+	if err != nil {
+		w.releaseIssue(ctx, fullName, number, jobID)
+		return err
+	}
+
+	_, err = w.jobService.CreateJob(ctx, &pb.CreateJobRequest{
+		Id:           jobID,
+		Name:         service.TruncateString(fmt.Sprintf("#%d %s", number, issue.GetTitle()), 255),
+		Repo:         fullName,
+		Branch:       branch,
+		Background:   true,
+		Prompt:       service.TruncateString(issuePrompt(issue, repoPrompt.GetPrompt()), 50000),
+		SessionCount: 1,
+		Status:       service.JobStatusPending,
+		ProfileId:    profileID,
+	})
+	if err != nil {
+		w.releaseIssue(ctx, fullName, number, jobID)
+		return err
+	}
+	logger.Info("%s [%s]: Started job %s for %s#%d", w.Name(), w.id, jobID, fullName, number)
+
+	if err := w.githubClient.AddLabelsToIssue(ctx, owner, repo, number, []string{service.IssueInProgressLabel(label)}); err != nil {
+		logger.Error("%s [%s]: Failed to label %s#%d: %s", w.Name(), w.id, fullName, number, err.Error())
+	}
+	return nil
+}
+
+// releaseIssue drops the claim of the job on the issue so the next check retries it. If that
+// fails, the claim is taken over once it times out.
+func (w *IssueAutomationWorker) releaseIssue(ctx context.Context, fullName string, number int, jobID string) {
+	if _, err := w.db.ExecContext(ctx, "DELETE FROM issue_jobs WHERE repo = ? AND issue_number = ? AND job_id = ?", fullName, number, jobID); err != nil {
+		logger.Error("%s [%s]: Failed to release %s#%d: %s", w.Name(), w.id, fullName, number, err.Error())
+	}
+}
+
+// issuePrompt combines the issue with the repo prompt.
+func issuePrompt(issue *github.Issue, repoPrompt string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Resolve GitHub issue #%d: %s\n", issue.GetNumber(), issue.GetTitle())
+	if body := strings.TrimSpace(issue.GetBody()); body != "" {
+		fmt.Fprintf(&b, "\n%s\n", body)
+	}
+	if repoPrompt = strings.TrimSpace(repoPrompt); repoPrompt != "" {
+		fmt.Fprintf(&b, "\n%s\n", repoPrompt)
+	}
+	fmt.Fprintf(&b, "\nInclude \"Fixes #%d\" in the pull request description.", issue.GetNumber())
+	return b.String()
+}
+
+type issueJob struct {
+	repo      string
+	number    int
+	jobID     string
+	commented bool
+}
+
+// followUp comments the session links on picked up issues and links the sessions' PRs to them.
+func (w *IssueAutomationWorker) followUp(ctx context.Context) error {
+	rows, err := w.db.QueryContext(ctx, `
+		SELECT repo, issue_number, job_id, commented_at IS NOT NULL FROM issue_jobs
+		WHERE commented_at IS NULL OR linked_pr_url IS NULL`)
+	if err != nil {
+		return err
+	}
+	var pending []issueJob
+	for rows.Next() {
+		var j issueJob
+		if err := rows.Scan(&j.repo, &j.number, &j.jobID, &j.commented); err != nil {
+			rows.Close()
+			return err
+		}
+		pending = append(pending, j)
+	}
+	rows.Close()
+
+	for _, j := range pending {
+		if err := w.followUpIssue(ctx, j); err != nil {
+			logger.Error("%s [%s]: Failed to follow up on %s#%d: %s", w.Name(), w.id, j.repo, j.number, err.Error())
+		}
+	}
+	return nil
+}
+
+func (w *IssueAutomationWorker) followUpIssue(ctx context.Context, j issueJob) error {
+	rows, err := w.db.QueryContext(ctx, `
+		SELECT COALESCE(s.url, ''), COALESCE(s.pr_url, '') FROM job_sessions js
+		JOIN sessions s ON s.id = js.session_id
+		WHERE js.job_id = ? ORDER BY js.slot_index`, j.jobID)
+	if err != nil {
+		return err
+	}
+	var sessionURLs []string
+	var prURL string
+	for rows.Next() {
+		var url, pr string
+		if err := rows.Scan(&url, &pr); err != nil {
+			rows.Close()
+			return err
+		}
+		if url != "" {
+			sessionURLs = append(sessionURLs, url)
+		}
+		if prURL == "" {
+			prURL = pr
+		}
+	}
+	rows.Close()
+
+	if (j.commented || len(sessionURLs) == 0) && prURL == "" {
+		return nil
+	}
+
+	r, err := gclient.ParseRepo(j.repo)
+	if err != nil {
+		return err
+	}
+	ctx, owner, repo := r.Context(ctx), r.Owner, r.Name
+	issue, err := w.githubClient.GetIssue(ctx, owner, repo, j.number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+	if issue.GetState() == "closed" {
+		// Nothing left to do once the issue is closed
+		_, err := w.db.ExecContext(ctx, `
+			UPDATE issue_jobs SET commented_at = COALESCE(commented_at, ?), linked_pr_url = COALESCE(linked_pr_url, '')
+			WHERE repo = ? AND issue_number = ?`, time.Now().Format(time.RFC3339), j.repo, j.number)
+		return err
+	}
+
+	if !j.commented && len(sessionURLs) > 0 {
+		msg := "Jules started working on this issue: " + strings.Join(sessionURLs, ", ")
+		if err := w.githubClient.CreateComment(ctx, owner, repo, j.number, msg); err != nil {
+			return fmt.Errorf("failed to comment: %w", err)
+		}
+		if _, err := w.db.ExecContext(ctx, "UPDATE issue_jobs SET commented_at = ? WHERE repo = ? AND issue_number = ?",
+			time.Now().Format(time.RFC3339), j.repo, j.number); err != nil {
+			return err
+		}
+	}
+
+	if prURL == "" {
+		return nil
+	}
+	if err := w.linkPullRequest(ctx, j, prURL); err != nil {
+		return err
+	}
+	_, err = w.db.ExecContext(ctx, "UPDATE issue_jobs SET linked_pr_url = ? WHERE repo = ? AND issue_number = ?", prURL, j.repo, j.number)
+	return err
+}
+
+// linkPullRequest adds a closing reference to the issue to the PR description unless it has one.
+func (w *IssueAutomationWorker) linkPullRequest(ctx context.Context, j issueJob, prURL string) error {
+	m := pullRequestURLRegex.FindStringSubmatch(prURL)
+	if m == nil {
+		return fmt.Errorf("invalid PR URL %q", prURL)
+	}
+	prOwner, prRepo := m[1], m[2]
+	number, _ := strconv.Atoi(m[3])
+
+	r, err := gclient.ParseRepo(j.repo)
+	if err != nil {
+		return err
+	}
+	ref := "#" + strconv.Itoa(j.number)
+	if !strings.EqualFold(prOwner+"/"+prRepo, r.FullName()) {
+		ref = r.FullName() + ref
+	}
+
+	pr, _, err := w.githubClient.GetPullRequest(ctx, prOwner, prRepo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get PR: %w", err)
+	}
+	if referencesIssue(pr.GetBody(), ref) {
+		return nil
+	}
+	body := strings.TrimRight(pr.GetBody(), "\n")
+	if body != "" {
+		body += "\n\n"
+	}
+	if _, err := w.githubClient.UpdatePullRequestBody(ctx, prOwner, prRepo, number, body+"Closes "+ref); err != nil {
+		return fmt.Errorf("failed to update PR description: %w", err)
+	}
+	logger.Info("%s [%s]: Linked %s to %s", w.Name(), w.id, prURL, ref)
+	return nil
+}
+
+// referencesIssue reports whether the text closes the issue with a GitHub closing keyword.
+func referencesIssue(text, ref string) bool {
+	re := regexp.MustCompile(`(?i)\b(close[sd]?|fix(e[sd])?|resolve[sd]?):?\s+` + regexp.QuoteMeta(ref) + `\b`)
+	return re.MatchString(text)
+}
+
+func hasLabel(issue *github.Issue, name string) bool {
+	for _, l := range issue.Labels {
+		if strings.EqualFold(l.GetName(), name) {
+			return true
+		}
+	}
+	return false
+}
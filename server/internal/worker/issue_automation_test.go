@@ -0,0 +1,234 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockIssueGitHubClient struct {
+	issues   []*github.Issue
+	prBody   string
+	labels   map[int][]string
+	comments map[int][]string
+	edits    map[int]string
+	closed   map[int]bool
+}
+
+func newMockIssueGitHubClient() *mockIssueGitHubClient {
+	return &mockIssueGitHubClient{labels: map[int][]string{}, comments: map[int][]string{}, edits: map[int]string{}, closed: map[int]bool{}}
+}
+
+func (m *mockIssueGitHubClient) ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return m.issues, &github.Response{}, nil
+}
+
+func (m *mockIssueGitHubClient) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	state := "open"
+	if m.closed[number] {
+		state = "closed"
+	}
+	return &github.Issue{Number: github.Int(number), State: github.String(state)}, nil
+}
+
+func (m *mockIssueGitHubClient) AddLabelsToIssue(ctx context.Context, owner, repo string, number int, labels []string) error {
+	m.labels[number] = append(m.labels[number], labels...)
+	return nil
+}
+
+func (m *mockIssueGitHubClient) CreateComment(ctx context.Context, owner, repo string, number int, body string) error {
+	m.comments[number] = append(m.comments[number], body)
+	return nil
+}
+
+func (m *mockIssueGitHubClient) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, error) {
+	return &github.Repository{DefaultBranch: github.String("develop")}, nil
+}
+
+func (m *mockIssueGitHubClient) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error) {
+	return &github.PullRequest{Number: github.Int(number), Body: github.String(m.prBody)}, &github.Response{}, nil
+}
+
+func (m *mockIssueGitHubClient) UpdatePullRequestBody(ctx context.Context, owner, repo string, number int, body string) (*github.PullRequest, error) {
+	m.edits[number] = body
+	return &github.PullRequest{Number: github.Int(number), Body: github.String(body)}, nil
+}
+
+func TestIssueAutomationWorker(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	settingsSvc := &service.SettingsServer{DB: db}
+	promptSvc := &service.PromptServer{DB: db}
+	jobSvc := &service.JobServer{DB: db}
+	gh := newMockIssueGitHubClient()
+	w := NewIssueAutomationWorker(db, settingsSvc, promptSvc, jobSvc, gh)
+
+	s, err := settingsSvc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+	assert.NoError(t, err)
+	s.IssueAutomationEnabled = true
+	s.IssueAutomationRepos = "o/r"
+	_, err = settingsSvc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: s})
+	assert.NoError(t, err)
+	_, err = promptSvc.SaveRepoPrompt(ctx, &pb.SaveRepoPromptRequest{Repo: "o/r", Prompt: "Run make test before committing."})
+	assert.NoError(t, err)
+
+	gh.issues = []*github.Issue{
+		{Number: github.Int(7), Title: github.String("Crash on start"), Body: github.String("Stack trace here")},
+		{Number: github.Int(8), Title: github.String("Already handled"), Labels: []*github.Label{{Name: github.String("jules:in-progress")}}},
+		{Number: github.Int(9), Title: github.String("A PR"), PullRequestLinks: &github.PullRequestLinks{URL: github.String("x")}},
+	}
+	assert.NoError(t, w.runCheck(ctx))
+
+	var jobID string
+	assert.NoError(t, db.QueryRow("SELECT job_id FROM issue_jobs WHERE repo = 'o/r' AND issue_number = 7").Scan(&jobID))
+	job, err := jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: jobID})
+	assert.NoError(t, err)
+	assert.Equal(t, "#7 Crash on start", job.Name)
+	assert.Equal(t, "develop", job.Branch)
+	assert.Equal(t, "Resolve GitHub issue #7: Crash on start\n\nStack trace here\n\nRun make test before committing.\n\nInclude \"Fixes #7\" in the pull request description.", job.Prompt)
+	assert.Equal(t, []string{"jules:in-progress"}, gh.labels[7])
+
+	var count int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM issue_jobs").Scan(&count))
+	assert.Equal(t, 1, count)
+
+	// The issue stays open and labelled, but is not picked up again
+	assert.NoError(t, w.runCheck(ctx))
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM jobs").Scan(&count))
+	assert.Equal(t, 1, count)
+	assert.Empty(t, gh.comments[7])
+
+	// The session starts: its link is commented on the issue once
+	_, err = db.Exec("INSERT INTO sessions (id, name, url) VALUES ('s1', 'sessions/s1', 'https://jules.google.com/session/s1')")
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO job_sessions (job_id, slot_index, session_id, updated_at) VALUES (?, 0, 's1', '')", jobID)
+	assert.NoError(t, err)
+	assert.NoError(t, w.runCheck(ctx))
+	assert.NoError(t, w.runCheck(ctx))
+	assert.Equal(t, []string{"Jules started working on this issue: https://jules.google.com/session/s1"}, gh.comments[7])
+
+	// The PR is opened without referencing the issue
+	gh.prBody = "Fixes the crash."
+	_, err = db.Exec("UPDATE sessions SET pr_url = 'https://github.com/o/r/pull/12' WHERE id = 's1'")
+	assert.NoError(t, err)
+	assert.NoError(t, w.runCheck(ctx))
+	assert.Equal(t, "Fixes the crash.\n\nCloses #7", gh.edits[12])
+
+	var linked string
+	assert.NoError(t, db.QueryRow("SELECT linked_pr_url FROM issue_jobs WHERE issue_number = 7").Scan(&linked))
+	assert.Equal(t, "https://github.com/o/r/pull/12", linked)
+}
+
+func TestIssueAutomationWorker_Claims(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	settingsSvc := &service.SettingsServer{DB: db}
+	jobSvc := &service.JobServer{DB: db}
+	gh := newMockIssueGitHubClient()
+	gh.issues = []*github.Issue{{Number: github.Int(7), Title: github.String("Crash on start")}}
+	w := NewIssueAutomationWorker(db, settingsSvc, &service.PromptServer{DB: db}, jobSvc, gh)
+
+	s, err := settingsSvc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+	assert.NoError(t, err)
+	s.IssueAutomationEnabled = true
+	s.IssueAutomationRepos = "o/r"
+	_, err = settingsSvc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: s})
+	assert.NoError(t, err)
+
+	// A fresh claim whose job doesn't exist yet is left alone
+	_, err = db.Exec("INSERT INTO issue_jobs (repo, issue_number, title, job_id, created_at) VALUES ('o/r', 7, 'Crash on start', 'lost', ?)", time.Now().Format(time.RFC3339))
+	assert.NoError(t, err)
+	assert.NoError(t, w.runCheck(ctx))
+	var jobs int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM jobs").Scan(&jobs))
+	assert.Equal(t, 0, jobs)
+
+	// Once it times out, the claim is taken over
+	_, err = db.Exec("UPDATE issue_jobs SET created_at = ?", time.Now().Add(-issueClaimTimeout-time.Minute).Format(time.RFC3339))
+	assert.NoError(t, err)
+	assert.NoError(t, w.runCheck(ctx))
+	var jobID string
+	assert.NoError(t, db.QueryRow("SELECT job_id FROM issue_jobs WHERE issue_number = 7").Scan(&jobID))
+	assert.NotEqual(t, "lost", jobID)
+	_, err = jobSvc.GetJob(ctx, &pb.GetJobRequest{Id: jobID})
+	assert.NoError(t, err)
+}
+
+func TestIssueAutomationWorker_LeavesIssuesToEventTriggers(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	settingsSvc := &service.SettingsServer{DB: db}
+	gh := newMockIssueGitHubClient()
+	gh.issues = []*github.Issue{{Number: github.Int(7), Title: github.String("Crash on start")}}
+	w := NewIssueAutomationWorker(db, settingsSvc, &service.PromptServer{DB: db}, &service.JobServer{DB: db}, gh)
+
+	s, err := settingsSvc.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+	assert.NoError(t, err)
+	s.IssueAutomationEnabled = true
+	s.IssueAutomationRepos = "o/r"
+	_, err = settingsSvc.UpdateSettings(ctx, &pb.UpdateSettingsRequest{Settings: s})
+	assert.NoError(t, err)
+	triggers := &service.EventTriggerServer{DB: db, Jobs: &service.JobServer{DB: db}}
+	_, err = triggers.CreateEventTrigger(ctx, &pb.CreateEventTriggerRequest{
+		Name: "Issues", Event: pb.TriggerEvent_TRIGGER_EVENT_ISSUE_LABELED, Repo: "O/R", Prompt: "Fix {{title}}",
+	})
+	assert.NoError(t, err)
+
+	// The trigger's default label is the issue automation's, so only the trigger starts a job
+	assert.NoError(t, w.runCheck(ctx))
+	var claims int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM issue_jobs").Scan(&claims))
+	assert.Equal(t, 0, claims)
+	assert.Empty(t, gh.labels)
+}
+
+func TestIssueAutomationWorker_ClosedIssue(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	gh := newMockIssueGitHubClient()
+	gh.closed[3] = true
+	w := NewIssueAutomationWorker(db, &service.SettingsServer{DB: db}, &service.PromptServer{DB: db}, &service.JobServer{DB: db}, gh)
+
+	_, err := db.Exec("INSERT INTO issue_jobs (repo, issue_number, title, job_id, created_at) VALUES ('o/r', 3, 'Bug', 'j1', '')")
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO sessions (id, name, url, pr_url) VALUES ('s1', 'sessions/s1', 'https://jules.google.com/session/s1', 'https://github.com/o/r/pull/4')")
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO job_sessions (job_id, slot_index, session_id, updated_at) VALUES ('j1', 0, 's1', '')")
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.runCheck(ctx))
+	assert.Empty(t, gh.comments)
+	assert.Empty(t, gh.edits)
+
+	var pending int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM issue_jobs WHERE commented_at IS NULL OR linked_pr_url IS NULL").Scan(&pending))
+	assert.Equal(t, 0, pending)
+}
+
+func TestReferencesIssue(t *testing.T) {
+	for body, want := range map[string]bool{
+		"Fixes #7":      true,
+		"closes #7.":    true,
+		"Resolved: #7":  true,
+		"Related to #7": false,
+		"Fixes #70":     false,
+		"Fixes o/r#7":   false,
+		"":              false,
+	} {
+		assert.Equal(t, want, referencesIssue(body, "#7"), body)
+	}
+	assert.True(t, referencesIssue("Closes o/r#7", "o/r#7"))
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/service"
+)
+
+// PipelineWorker advances running pipelines as their jobs, sessions and PRs change state.
+type PipelineWorker struct {
+	BaseWorker
+	id              string
+	db              *sql.DB
+	pipelineService *service.PipelineServer
+}
+
+func NewPipelineWorker(database *sql.DB, pipelineService *service.PipelineServer) *PipelineWorker {
+	return &PipelineWorker{
+		BaseWorker: BaseWorker{
+			NameStr:  "PipelineWorker",
+			Interval: 60 * time.Second,
+		},
+		id:              uuid.New().String()[:8],
+		db:              database,
+		pipelineService: pipelineService,
+	}
+}
+
+func (w *PipelineWorker) Start(ctx context.Context) error {
+	logger.Info("%s [%s] starting...", w.Name(), w.id)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.Interval):
+			status := "Success"
+			if err := w.runCheck(ctx); err != nil {
+				logger.Error("%s [%s] check failed: %s", w.Name(), w.id, err.Error())
+				status = "Failed"
+			}
+			nextRun := time.Now().Add(w.Interval)
+			logger.Info("%s [%s] task completed. Status: %s. Next run at %s", w.Name(), w.id, status, nextRun.Format(time.RFC3339))
+		}
+	}
+}
+
+func (w *PipelineWorker) runCheck(ctx context.Context) error {
+	runIDs, err := w.pipelineService.RunningPipelineRuns(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range runIDs {
+		if err := w.pipelineService.AdvanceRun(ctx, id); err != nil {
+			logger.Error("%s [%s]: Failed to advance pipeline run %s: %s", w.Name(), w.id, id, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineWorker_AdvancesRuns(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	jobSvc := &service.JobServer{DB: db}
+	pipelineSvc := &service.PipelineServer{DB: db, Jobs: jobSvc}
+	w := NewPipelineWorker(db, pipelineSvc)
+
+	p, err := pipelineSvc.CreatePipeline(ctx, &pb.CreatePipelineRequest{Name: "Two steps", Sequential: true, Steps: []*pb.PipelineStep{
+		{Id: "first", Job: &pb.CreateJobRequest{Prompt: "p1", Repo: "test/repo", Branch: "main", SessionCount: 1}},
+		{Id: "second", Job: &pb.CreateJobRequest{Prompt: "p2", Repo: "test/repo", Branch: "main", SessionCount: 1}},
+	}})
+	assert.NoError(t, err)
+	run, err := pipelineSvc.StartPipeline(ctx, &pb.StartPipelineRequest{Id: p.Id})
+	assert.NoError(t, err)
+	firstJob := run.Steps[0].JobId
+
+	// The first job fails without creating sessions
+	assert.NoError(t, jobSvc.TransitionJob(ctx, firstJob, pb.JobStatus_JOB_STATUS_FAILED))
+
+	assert.NoError(t, w.runCheck(ctx))
+
+	run, err = pipelineSvc.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, service.PipelineRunFailed, run.Status)
+	assert.Equal(t, service.PipelineStepFailed, run.Steps[0].Status)
+	assert.Equal(t, "job failed", run.Steps[0].Message)
+	assert.Equal(t, service.PipelineStepSkipped, run.Steps[1].Status)
+
+	running, err := pipelineSvc.RunningPipelineRuns(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, running)
+}
@@ -0,0 +1,185 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+)
+
+// PR comment command statuses, stored in the pr_comment_commands table.
+const (
+	prCommandDone   = "DONE"
+	prCommandFailed = "FAILED"
+	prCommandDenied = "DENIED"
+)
+
+// maxPRCommandAge keeps old comments from running when ChatOps is first enabled.
+const maxPRCommandAge = 24 * time.Hour
+
+// prCommandAssociations are the author associations allowed to run commands.
+var prCommandAssociations = map[string]bool{"OWNER": true, "MEMBER": true, "COLLABORATOR": true}
+
+type prCommand struct {
+	name string // e.g. "/hub merge"
+	arg  string
+}
+
+// parsePRCommand finds the first /jules or /hub command in a comment.
+// Everything after the command (including the following lines) is its argument.
+func parsePRCommand(body string) (prCommand, bool) {
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || (fields[0] != "/jules" && fields[0] != "/hub") {
+			continue
+		}
+		rest := strings.TrimSpace(line)
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, fields[0]))
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, fields[1]))
+		if i+1 < len(lines) {
+			rest = strings.TrimSpace(rest + "\n" + strings.Join(lines[i+1:], "\n"))
+		}
+		return prCommand{name: fields[0] + " " + strings.ToLower(fields[1]), arg: rest}, true
+	}
+	return prCommand{}, false
+}
+
+// runCommands runs the new slash commands in the PR's comments and replies to each.
+// It reports whether the PR was merged or closed.
+func (w *PRMonitorWorker) runCommands(ctx context.Context, owner, repo string, pr *github.PullRequest, s *pb.Settings) bool {
+	comments, err := w.gh(ctx).ListComments(ctx, owner, repo, pr.GetNumber())
+	if err != nil {
+		logger.Error("%s [%s]: Failed to list comments for %s: %v", w.Name(), w.id, pr.GetHTMLURL(), err)
+		return false
+	}
+
+	for _, c := range comments {
+		cmd, ok := parsePRCommand(c.GetBody())
+		if !ok || c.GetCreatedAt().Before(time.Now().Add(-maxPRCommandAge)) {
+			continue
+		}
+		author := c.GetUser().GetLogin()
+
+		// Claim the comment so it runs only once
+		res, err := w.db.ExecContext(ctx, `
+			INSERT OR IGNORE INTO pr_comment_commands (comment_id, pr_url, author, command, status, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			c.GetID(), pr.GetHTMLURL(), author, cmd.name, prCommandFailed, time.Now().Format(time.RFC3339))
+		if err != nil {
+			logger.Error("%s [%s]: Failed to record command of comment %d: %v", w.Name(), w.id, c.GetID(), err)
+			continue
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			continue
+		}
+
+		var status, reply string
+		if !prCommandAssociations[c.GetAuthorAssociation()] {
+			status, reply = prCommandDenied, fmt.Sprintf("`%s` from @%s was ignored: only repository owners, members and collaborators can run commands.", cmd.name, author)
+		} else if result, err := w.runCommand(ctx, owner, repo, pr, s, cmd); err != nil {
+			status, reply = prCommandFailed, fmt.Sprintf("`%s` from @%s failed: %s", cmd.name, author, err.Error())
+		} else {
+			status, reply = prCommandDone, fmt.Sprintf("`%s` from @%s: %s", cmd.name, author, result)
+		}
+		logger.Info("%s [%s]: %s on %s: %s", w.Name(), w.id, cmd.name, pr.GetHTMLURL(), status)
+
+		if _, err := w.db.ExecContext(ctx, "UPDATE pr_comment_commands SET status = ?, message = ? WHERE comment_id = ?", status, reply, c.GetID()); err != nil {
+			logger.Error("%s [%s]: Failed to update command of comment %d: %v", w.Name(), w.id, c.GetID(), err)
+		}
+		if err := w.gh(ctx).CreateComment(ctx, owner, repo, pr.GetNumber(), reply); err != nil {
+			logger.Error("%s [%s]: Failed to reply to command on %s: %v", w.Name(), w.id, pr.GetHTMLURL(), err)
+		}
+		if status == prCommandDone && (cmd.name == "/hub merge" || cmd.name == "/hub close") {
+			return true
+		}
+	}
+	return false
+}
+
+// runCommand runs a single command and returns the confirmation to reply with.
+func (w *PRMonitorWorker) runCommand(ctx context.Context, owner, repo string, pr *github.PullRequest, s *pb.Settings, cmd prCommand) (string, error) {
+	switch cmd.name {
+	case "/jules retry", "/jules continue":
+		sessionID, err := w.prSession(ctx, pr.GetHTMLURL())
+		if err != nil {
+			return "", err
+		}
+		msg := cmd.arg
+		if msg == "" && cmd.name == "/jules retry" {
+			msg = s.GetAutoRetryMessage()
+		} else if msg == "" {
+			msg = s.GetAutoContinueMessage()
+		}
+		if _, err := w.sessionService.SendMessage(ctx, &pb.SendMessageRequest{Id: sessionID, Message: msg}); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("sent to session %s.", sessionID), nil
+
+	case "/hub merge":
+		if pr.GetMerged() {
+			return "", fmt.Errorf("the PR is already merged")
+		}
+		method := s.GetAutoMergeMethod()
+		if method == "" {
+			method = "squash"
+		}
+		if err := w.gh(ctx).MergePullRequest(ctx, owner, repo, pr.GetNumber(), mergeCommitMessage(pr), method); err != nil {
+			return "", err
+		}
+		w.recordPRAction(ctx, owner, repo, pr.GetNumber(), prActionCommandMerged, service.PRStateMerged)
+		return "merged.", nil
+
+	case "/hub close":
+		if _, err := w.gh(ctx).ClosePullRequest(ctx, owner, repo, pr.GetNumber()); err != nil {
+			return "", err
+		}
+		w.recordPRAction(ctx, owner, repo, pr.GetNumber(), prActionCommandClosed, service.PRStateClosed)
+		return "closed.", nil
+
+	case "/hub rerun":
+		jobID, err := w.prJob(ctx, pr.GetHTMLURL())
+		if err != nil {
+			return "", err
+		}
+		job, err := w.jobService.RerunJob(ctx, &pb.RerunJobRequest{Id: jobID})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("started job %s rerunning job %s.", job.Id, jobID), nil
+	}
+	return "", fmt.Errorf("unknown command (use /jules retry, /jules continue <message>, /hub merge, /hub close or /hub rerun)")
+}
+
+// prSession returns the latest session that opened the PR.
+func (w *PRMonitorWorker) prSession(ctx context.Context, prURL string) (string, error) {
+	var id string
+	err := w.db.QueryRowContext(ctx, "SELECT id FROM sessions WHERE pr_url = ? ORDER BY create_time DESC LIMIT 1", prURL).Scan(&id)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("no session opened this PR")
+	}
+	return id, err
+}
+
+// prJob returns the job whose session opened the PR.
+func (w *PRMonitorWorker) prJob(ctx context.Context, prURL string) (string, error) {
+	sessionID, err := w.prSession(ctx, prURL)
+	if err != nil {
+		return "", err
+	}
+	var id string
+	err = w.db.QueryRowContext(ctx, `
+		SELECT id FROM jobs
+		WHERE id IN (SELECT job_id FROM job_sessions WHERE session_id = ?) OR session_ids LIKE ?
+		ORDER BY created_at DESC LIMIT 1`, sessionID, "%\""+sessionID+"\"%").Scan(&id)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("no job started the session of this PR")
+	}
+	return id, err
+}
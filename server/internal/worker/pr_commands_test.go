@@ -0,0 +1,147 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePRCommand(t *testing.T) {
+	cmd, ok := parsePRCommand("LGTM\n/jules continue please also add tests\nfor the parser")
+	assert.True(t, ok)
+	assert.Equal(t, "/jules continue", cmd.name)
+	assert.Equal(t, "please also add tests\nfor the parser", cmd.arg)
+
+	cmd, ok = parsePRCommand("/hub Merge")
+	assert.True(t, ok)
+	assert.Equal(t, "/hub merge", cmd.name)
+
+	_, ok = parsePRCommand("Use /hub merge once CI is green")
+	assert.False(t, ok)
+	_, ok = parsePRCommand("/jules")
+	assert.False(t, ok)
+}
+
+func TestPRMonitorWorker_RunCommands(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	t.Setenv("JULES_API_KEY", "dummy-key")
+	ctx := context.Background()
+
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, ":sendMessage") {
+			b, _ := io.ReadAll(r.Body)
+			sent = append(sent, r.URL.Path+" "+string(b))
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	sessionSvc := &service.SessionServer{DB: db, BaseURL: server.URL, HTTPClient: server.Client()}
+	settingsSvc := &service.SettingsServer{DB: db}
+	gh := &MockGitHubClient{}
+	w := NewPRMonitorWorker(db, settingsSvc, sessionSvc, gh, nil, "")
+	s, err := settingsSvc.GetSettings(ctx, &pb.GetSettingsRequest{})
+	assert.NoError(t, err)
+
+	prURL := "https://github.com/o/r/pull/5"
+	_, err = db.Exec("INSERT INTO sessions (id, name, pr_url, create_time) VALUES ('s1', 'sessions/s1', ?, ?)", prURL, time.Now().Format(time.RFC3339))
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO jobs (id, name, repo, branch, prompt, created_at, session_ids) VALUES ('j1', 'Fix', 'o/r', 'main', 'Fix it', ?, '[\"s1\"]')", time.Now().Format(time.RFC3339))
+	assert.NoError(t, err)
+
+	now := &github.Timestamp{Time: time.Now()}
+	comment := func(id int64, body, association string) *github.IssueComment {
+		return &github.IssueComment{ID: github.Int64(id), Body: github.String(body), AuthorAssociation: github.String(association), User: &github.User{Login: github.String("reviewer")}, CreatedAt: now}
+	}
+	gh.Comments = []*github.IssueComment{
+		{ID: github.Int64(1), Body: github.String("/hub close"), AuthorAssociation: github.String("OWNER"), CreatedAt: &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)}},
+		comment(2, "Nice work", "OWNER"),
+		comment(3, "/jules continue Rename the helper too", "MEMBER"),
+		comment(4, "/hub close", "NONE"),
+		comment(5, "/hub rerun", "COLLABORATOR"),
+		comment(6, "/hub deploy", "OWNER"),
+	}
+	pr := &github.PullRequest{Number: github.Int(5), HTMLURL: github.String(prURL), Title: github.String("Fix")}
+
+	assert.False(t, w.runCommands(ctx, "o", "r", pr, s))
+
+	assert.Len(t, sent, 1)
+	assert.Contains(t, sent[0], "/sessions/s1:sendMessage")
+	assert.Contains(t, sent[0], "Rename the helper too")
+	assert.False(t, gh.ClosePullRequestCalled)
+
+	var rerunID string
+	assert.NoError(t, db.QueryRow("SELECT id FROM jobs WHERE parent_job_id = 'j1'").Scan(&rerunID))
+
+	assert.Equal(t, []string{
+		"`/jules continue` from @reviewer: sent to session s1.",
+		"`/hub close` from @reviewer was ignored: only repository owners, members and collaborators can run commands.",
+		"`/hub rerun` from @reviewer: started job " + rerunID + " rerunning job j1.",
+		"`/hub deploy` from @reviewer failed: unknown command (use /jules retry, /jules continue <message>, /hub merge, /hub close or /hub rerun)",
+	}, gh.CreatedComments)
+
+	statuses := map[int64]string{}
+	rows, err := db.Query("SELECT comment_id, status FROM pr_comment_commands")
+	assert.NoError(t, err)
+	for rows.Next() {
+		var id int64
+		var status string
+		assert.NoError(t, rows.Scan(&id, &status))
+		statuses[id] = status
+	}
+	rows.Close()
+	assert.Equal(t, map[int64]string{3: prCommandDone, 4: prCommandDenied, 5: prCommandDone, 6: prCommandFailed}, statuses)
+
+	// Processed comments never run again; a new merge command ends the PR's checks
+	gh.CreatedComments = nil
+	gh.Comments = append(gh.Comments, comment(7, "/hub merge", "OWNER"))
+	assert.True(t, w.runCommands(ctx, "o", "r", pr, s))
+	assert.Len(t, sent, 1)
+	assert.Equal(t, []string{"MERGED_PR_5_squash", "`/hub merge` from @reviewer: merged."}, gh.CreatedComments)
+}
+
+func TestPRMonitorWorker_ChatOpsOnlyRunsCommands(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+	_, err := db.Exec("INSERT INTO settings (profile_id, chat_ops_enabled, check_failing_actions_enabled, auto_merge_enabled, theme, auto_retry_message, auto_continue_message) VALUES ('default', 1, 0, 0, 'system', '', '')")
+	assert.NoError(t, err)
+
+	gh := failingPRMock()
+	gh.PullRequests[0].ChangedFiles = github.Int(0)
+	gh.PullRequests[0].Draft = github.Bool(true)
+	gh.PullRequests[0].Mergeable = github.Bool(true)
+	gh.Files = []*github.CommitFile{{Filename: github.String("parse_test.go"), Status: github.String("removed")}}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+
+	s, err := w.settingsService.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+	assert.NoError(t, err)
+	assert.True(t, s.GetChatOpsEnabled())
+	w.evaluatePR(ctx, "o", "r", 5, s)
+
+	// Empty PRs aren't closed, test deletions and failures aren't reported
+	assert.False(t, gh.ClosePullRequestCalled)
+	assert.Empty(t, gh.CreatedComments)
+
+	// Commands still run
+	gh.PullRequests[0].ChangedFiles = github.Int(1)
+	gh.Comments = []*github.IssueComment{{
+		ID: github.Int64(1), Body: github.String("/hub close"), AuthorAssociation: github.String("OWNER"),
+		User: &github.User{Login: github.String("reviewer")}, CreatedAt: &github.Timestamp{Time: time.Now()},
+	}}
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	assert.True(t, gh.ClosePullRequestCalled)
+}
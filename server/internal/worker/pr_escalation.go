@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+)
+
+// allowFailureReport decides whether the failure report may be posted on the PR. Reports are not
+// repeated and are spaced at least check_failing_actions_interval seconds apart. Once the PR got
+// check_failing_actions_threshold reports it is escalated instead.
+func (w *PRMonitorWorker) allowFailureReport(ctx context.Context, owner, repo string, number int, prUrl, report string, s *pb.Settings) bool {
+	t := w.failureTracking(ctx, owner, repo, number)
+	if threshold := int(s.GetCheckFailingActionsThreshold()); threshold > 0 && t.nagCount >= threshold {
+		if !t.escalated {
+			w.escalatePR(ctx, owner, repo, number, prUrl, t.nagCount, s)
+		}
+		return false
+	}
+	if t.lastReport == report {
+		logger.Info("%s [%s]: Failure report on PR %s is unchanged. Skipping.", w.Name(), w.id, prUrl)
+		return false
+	}
+	if interval := s.GetCheckFailingActionsInterval(); interval > 0 && !t.lastReportAt.IsZero() {
+		if next := t.lastReportAt.Add(time.Duration(interval) * time.Second); time.Now().Before(next) {
+			logger.Info("%s [%s]: Failure report on PR %s is rate limited until %s", w.Name(), w.id, prUrl, next.Format(time.RFC3339))
+			return false
+		}
+	}
+	return true
+}
+
+// escalatePR hands a PR whose checks keep failing over to humans, as configured by
+// check_failing_actions_escalation. Failed escalations are retried on the next check.
+func (w *PRMonitorWorker) escalatePR(ctx context.Context, owner, repo string, number int, prUrl string, reports int, s *pb.Settings) {
+	escalation := s.GetCheckFailingActionsEscalation()
+	if escalation == "" {
+		escalation = service.EscalationLabel
+	}
+	target := strings.TrimSpace(s.GetCheckFailingActionsEscalationTarget())
+	logins := splitLogins(target)
+	logger.Info("%s [%s]: PR %s got %d failure reports. Escalating: %s", w.Name(), w.id, prUrl, reports, escalation)
+
+	var action string
+	var err error
+	switch escalation {
+	case service.EscalationLabel:
+		label := target
+		if label == "" {
+			label = service.DefaultEscalationLabel
+		}
+		action = "escalated: labeled " + label
+		err = w.gh(ctx).AddLabelsToIssue(ctx, owner, repo, number, []string{label})
+
+	case service.EscalationRequestReviewer:
+		if len(logins) == 0 {
+			err = fmt.Errorf("no reviewers configured")
+			break
+		}
+		action = "escalated: requested review from " + strings.Join(logins, ", ")
+		err = w.gh(ctx).RequestReviewers(ctx, owner, repo, number, logins)
+
+	case service.EscalationClose:
+		msg := fmt.Sprintf("Closing this PR: its checks are still failing after %d failure reports.", reports)
+		if err = w.gh(ctx).CreateComment(ctx, owner, repo, number, msg); err != nil {
+			break
+		}
+		if _, err = w.gh(ctx).ClosePullRequest(ctx, owner, repo, number); err != nil {
+			break
+		}
+		w.recordPRAction(ctx, owner, repo, number, "escalated: closed", service.PRStateClosed)
+		w.recordEscalation(ctx, owner, repo, number, "escalated: closed")
+		return
+
+	case service.EscalationNotify:
+		msg := fmt.Sprintf("The checks of this PR are still failing after %d failure reports and need a human to look at them.", reports)
+		if len(logins) > 0 {
+			msg = "@" + strings.Join(logins, " @") + " " + msg
+		}
+		action = "escalated: notified"
+		err = w.gh(ctx).CreateComment(ctx, owner, repo, number, msg)
+
+	default:
+		err = fmt.Errorf("unknown escalation %q", escalation)
+	}
+
+	if err != nil {
+		logger.Error("%s [%s]: Failed to escalate PR %s: %v", w.Name(), w.id, prUrl, err)
+		return
+	}
+	w.recordEscalation(ctx, owner, repo, number, action)
+}
+
+// splitLogins splits a comma-separated list of GitHub logins, dropping leading @s.
+func splitLogins(list string) []string {
+	var logins []string
+	for _, l := range strings.Split(list, ",") {
+		if l = strings.TrimPrefix(strings.TrimSpace(l), "@"); l != "" {
+			logins = append(logins, l)
+		}
+	}
+	return logins
+}
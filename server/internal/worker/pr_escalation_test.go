@@ -0,0 +1,106 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingPRMock returns a GitHub mock whose PR 5 in o/r has a failing check.
+func failingPRMock() *MockGitHubClient {
+	return &MockGitHubClient{
+		PullRequests: []*github.PullRequest{{
+			Number: github.Int(5), HTMLURL: github.String("https://github.com/o/r/pull/5"), State: github.String("open"),
+			User: &github.User{Login: github.String("someone")}, Head: &github.PullRequestBranch{SHA: github.String("sha1")},
+		}},
+		CombinedStatus: &github.CombinedStatus{State: github.String("failure")},
+		CheckRuns: &github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+			{Name: github.String("lint"), Status: github.String("completed"), Conclusion: github.String("failure")},
+		}},
+	}
+}
+
+func TestPRMonitorWorker_EscalatesAfterThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		escalation string
+		target     string
+		check      func(t *testing.T, gh *MockGitHubClient, state string)
+	}{
+		{"default label", "", "", func(t *testing.T, gh *MockGitHubClient, state string) {
+			assert.Equal(t, []string{service.DefaultEscalationLabel}, gh.Labels)
+			assert.Empty(t, gh.CreatedComments)
+		}},
+		{"custom label", service.EscalationLabel, "ci-stuck", func(t *testing.T, gh *MockGitHubClient, state string) {
+			assert.Equal(t, []string{"ci-stuck"}, gh.Labels)
+		}},
+		{"request reviewer", service.EscalationRequestReviewer, "alice, @bob", func(t *testing.T, gh *MockGitHubClient, state string) {
+			assert.Equal(t, []string{"alice", "bob"}, gh.Reviewers)
+		}},
+		{"close", service.EscalationClose, "", func(t *testing.T, gh *MockGitHubClient, state string) {
+			assert.True(t, gh.ClosePullRequestCalled)
+			assert.Len(t, gh.CreatedComments, 1)
+			assert.Equal(t, service.PRStateClosed, state)
+		}},
+		{"notify", service.EscalationNotify, "alice,bob", func(t *testing.T, gh *MockGitHubClient, state string) {
+			if assert.Len(t, gh.CreatedComments, 1) {
+				assert.Contains(t, gh.CreatedComments[0], "@alice @bob ")
+				assert.Contains(t, gh.CreatedComments[0], "after 3 failure reports")
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupTestDB(t)
+			defer db.Close()
+			_, err := db.Exec(`
+				INSERT INTO pull_requests (repo, number, url, state, nag_count, last_report, created_at, updated_at)
+				VALUES ('o/r', 5, 'https://github.com/o/r/pull/5', 'open', 3, 'an older report', '2026-01-01T00:00:00Z', '2026-01-01T00:00:00Z')`)
+			assert.NoError(t, err)
+			gh := failingPRMock()
+			w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+			s := &pb.Settings{CheckFailingActionsEnabled: true, CheckFailingActionsThreshold: 3, CheckFailingActionsEscalation: tt.escalation, CheckFailingActionsEscalationTarget: tt.target}
+
+			w.evaluatePR(context.Background(), "o", "r", 5, s)
+			var state string
+			var escalatedAt sql.NullString
+			assert.NoError(t, db.QueryRow("SELECT state, escalated_at FROM pull_requests WHERE number = 5").Scan(&state, &escalatedAt))
+			assert.True(t, escalatedAt.Valid)
+			tt.check(t, gh, state)
+
+			// Escalated PRs get no more reports or escalations
+			comments, labels := len(gh.CreatedComments), len(gh.Labels)
+			gh.ClosePullRequestCalled = false
+			w.evaluatePR(context.Background(), "o", "r", 5, s)
+			assert.Len(t, gh.CreatedComments, comments)
+			assert.Len(t, gh.Labels, labels)
+		})
+	}
+}
+
+func TestPRMonitorWorker_RateLimitsFailureReports(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	_, err := db.Exec(`
+		INSERT INTO pull_requests (repo, number, url, state, nag_count, last_report, last_report_at, created_at, updated_at)
+		VALUES ('o/r', 5, 'https://github.com/o/r/pull/5', 'open', 1, 'an older report', ?, '2026-01-01T00:00:00Z', '2026-01-01T00:00:00Z')`,
+		time.Now().Add(-5*time.Minute).Format(time.RFC3339))
+	assert.NoError(t, err)
+	gh := failingPRMock()
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+
+	w.evaluatePR(context.Background(), "o", "r", 5, &pb.Settings{CheckFailingActionsEnabled: true, CheckFailingActionsInterval: 600, CheckFailingActionsThreshold: 10})
+	assert.Empty(t, gh.CreatedComments)
+
+	w.evaluatePR(context.Background(), "o", "r", 5, &pb.Settings{CheckFailingActionsEnabled: true, CheckFailingActionsInterval: 60, CheckFailingActionsThreshold: 10})
+	assert.Len(t, gh.CreatedComments, 1)
+	var nagCount int
+	assert.NoError(t, db.QueryRow("SELECT nag_count FROM pull_requests WHERE number = 5").Scan(&nagCount))
+	assert.Equal(t, 2, nagCount)
+}
@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	gclient "github.com/mcpany/jules/internal/github"
+	"github.com/mcpany/jules/internal/logger"
+	pb "github.com/mcpany/jules/proto"
+)
+
+const (
+	// webhookReconcileInterval is the polling interval once webhooks deliver PR events.
+	webhookReconcileInterval = time.Hour
+	// prEvaluationTimeout bounds an evaluation started by a webhook event.
+	prEvaluationTimeout = 5 * time.Minute
+)
+
+// EnableWebhooks slows polling down to a reconciliation fallback. Call it before Start.
+func (w *PRMonitorWorker) EnableWebhooks() {
+	w.webhooks = true
+}
+
+// EnqueuePR evaluates a single PR in the background, as a poll would.
+// Events arriving for a PR that is already queued are coalesced.
+func (w *PRMonitorWorker) EnqueuePR(repoFullName string, number int) {
+	w.enqueue(repoFullName, repoFullName+"#"+strconv.Itoa(number), func(ctx context.Context, owner, repo string, s *pb.Settings) {
+		w.evaluatePR(ctx, owner, repo, number, s)
+	})
+}
+
+// EnqueueCommit evaluates the open PRs that contain the commit, e.g. after its status changed.
+// Searching by commit also finds PRs from forks, whose branches the repo doesn't have.
+func (w *PRMonitorWorker) EnqueueCommit(repoFullName, sha string) {
+	w.enqueue(repoFullName, repoFullName+"@"+sha, func(ctx context.Context, owner, repo string, s *pb.Settings) {
+		query := fmt.Sprintf("repo:%s/%s is:pr state:open %s", owner, repo, sha)
+		result, _, err := w.githubClient.SearchIssues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}})
+		if err != nil {
+			logger.Error("%s [%s]: Failed to search PRs of %s@%s: %v", w.Name(), w.id, repoFullName, sha, err)
+			return
+		}
+		for _, issue := range result.Issues {
+			w.evaluatePR(ctx, owner, repo, issue.GetNumber(), s)
+		}
+	})
+}
+
+// EnqueueRepo evaluates every open PR of the repo, e.g. after its base branch moved.
+func (w *PRMonitorWorker) EnqueueRepo(repoFullName string) {
+	w.enqueue(repoFullName, repoFullName, func(ctx context.Context, owner, repo string, s *pb.Settings) {
+		w.checkRepo(ctx, repoFullName, s)
+	})
+}
+
+// enqueue runs an evaluation on the worker pool unless one with the same key is already waiting.
+func (w *PRMonitorWorker) enqueue(repoFullName, key string, evaluate func(ctx context.Context, owner, repo string, s *pb.Settings)) {
+	r, err := gclient.ParseRepo(repoFullName)
+	if err != nil {
+		logger.Error("%s [%s]: Invalid repo name %s", w.Name(), w.id, repoFullName)
+		return
+	}
+
+	w.queueMu.Lock()
+	if w.queued[key] {
+		w.queueMu.Unlock()
+		return
+	}
+	w.queued[key] = true
+	w.queueMu.Unlock()
+
+	w.pool.Submit(func() {
+		// Events arriving from now on need another evaluation
+		w.queueMu.Lock()
+		delete(w.queued, key)
+		w.queueMu.Unlock()
+
+		ctx, cancel := context.WithTimeout(gclient.WithRequestCache(r.Context(context.Background())), prEvaluationTimeout)
+		defer cancel()
+		s, err := w.settingsService.GetSettings(ctx, &pb.GetSettingsRequest{ProfileId: "default"})
+		if err != nil {
+			logger.Error("%s [%s]: Failed to get settings: %v", w.Name(), w.id, err)
+			return
+		}
+		if !s.GetCheckFailingActionsEnabled() && !s.GetAutoMergeEnabled() && !s.GetChatOpsEnabled() {
+			return
+		}
+		logger.Info("%s [%s]: Evaluating %s after webhook event", w.Name(), w.id, key)
+		evaluate(ctx, r.Owner, r.Name, s)
+	})
+}
+
+// startEvaluation marks the PR as being evaluated. If it already is, it asks for another
+// evaluation once the running one finishes and returns false.
+func (w *PRMonitorWorker) startEvaluation(key string) bool {
+	w.queueMu.Lock()
+	defer w.queueMu.Unlock()
+	if _, ok := w.evaluating[key]; ok {
+		w.evaluating[key] = true
+		return false
+	}
+	w.evaluating[key] = false
+	return true
+}
+
+// finishEvaluation reports whether the PR must be evaluated again, or marks its evaluation done.
+func (w *PRMonitorWorker) finishEvaluation(key string) bool {
+	w.queueMu.Lock()
+	defer w.queueMu.Unlock()
+	if w.evaluating[key] {
+		w.evaluating[key] = false
+		return true
+	}
+	delete(w.evaluating, key)
+	return false
+}
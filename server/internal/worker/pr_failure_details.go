@@ -0,0 +1,126 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/logger"
+	pb "github.com/mcpany/jules/proto"
+)
+
+const (
+	// maxDetailedChecks bounds the failing checks whose annotations and logs are fetched.
+	maxDetailedChecks = 5
+	// maxCheckAnnotations bounds the annotations quoted per check.
+	maxCheckAnnotations = 10
+	// logTailBytes is how much of the end of a job log is downloaded.
+	logTailBytes = 64 * 1024
+	// maxLogExcerptLines bounds the log lines quoted per check.
+	maxLogExcerptLines = 40
+)
+
+var logTimestampRegex = regexp.MustCompile(`^\d{4}-\d\d-\d\dT\d\d:\d\d:\d\d(\.\d+)?Z `)
+
+// CIDetailsClient is implemented by GitHub clients that can read the annotations and job logs
+// of failing checks. Without it, sessions are told only the names of the failing checks.
+type CIDetailsClient interface {
+	ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64) ([]*github.CheckRunAnnotation, error)
+	GetJobLogTail(ctx context.Context, owner, repo string, jobID int64, maxBytes int) (string, error)
+}
+
+// reportFailureToSession sends the failures of the PR's checks, with their annotations and log
+// excerpts, to the session that opened the PR. It fails for PRs no session opened.
+func (w *PRMonitorWorker) reportFailureToSession(ctx context.Context, owner, repo, prUrl, summary string, runs []*github.CheckRun, statuses []*github.RepoStatus) (string, error) {
+	if w.sessionService == nil {
+		return "", fmt.Errorf("no session service")
+	}
+	sessionID, err := w.prSession(ctx, prUrl)
+	if err != nil {
+		return "", err
+	}
+	msg := summary + w.failureDetails(ctx, owner, repo, runs, statuses)
+	if _, err := w.sessionService.SendMessage(ctx, &pb.SendMessageRequest{Id: sessionID, Message: msg}); err != nil {
+		return "", err
+	}
+	return sessionID, nil
+}
+
+// failureDetails describes the failing checks: their links, annotations and the end of their logs.
+func (w *PRMonitorWorker) failureDetails(ctx context.Context, owner, repo string, runs []*github.CheckRun, statuses []*github.RepoStatus) string {
+	details, _ := w.githubClient.(CIDetailsClient)
+	var b strings.Builder
+	for i, run := range runs {
+		fmt.Fprintf(&b, "\n\n### %s", run.GetName())
+		if run.GetConclusion() != "" {
+			fmt.Fprintf(&b, " (%s)", run.GetConclusion())
+		}
+		if url := run.GetDetailsURL(); url != "" {
+			b.WriteString("\n" + url)
+		}
+		if details == nil || i >= maxDetailedChecks || run.GetID() == 0 {
+			continue
+		}
+
+		annotations, err := details.ListCheckRunAnnotations(ctx, owner, repo, run.GetID())
+		if err != nil {
+			logger.Warn("%s [%s]: Failed to list annotations of check %s: %v", w.Name(), w.id, run.GetName(), err)
+		}
+		if len(annotations) > 0 {
+			b.WriteString("\n\nAnnotations:")
+			for j, a := range annotations {
+				if j == maxCheckAnnotations {
+					fmt.Fprintf(&b, "\n- ... and %d more", len(annotations)-j)
+					break
+				}
+				fmt.Fprintf(&b, "\n- %s:%d [%s] %s", a.GetPath(), a.GetStartLine(), a.GetAnnotationLevel(), strings.TrimSpace(a.GetMessage()))
+			}
+		}
+
+		// Only GitHub Actions check runs have logs
+		log, err := details.GetJobLogTail(ctx, owner, repo, run.GetID(), logTailBytes)
+		if err != nil {
+			logger.Info("%s [%s]: No log for check %s: %v", w.Name(), w.id, run.GetName(), err)
+		} else if excerpt := logExcerpt(log, maxLogExcerptLines); excerpt != "" {
+			b.WriteString("\n\nLog excerpt:\n```\n" + excerpt + "\n```")
+		}
+	}
+	for _, s := range statuses {
+		fmt.Fprintf(&b, "\n\n### %s (%s)", s.GetContext(), s.GetState())
+		if d := s.GetDescription(); d != "" {
+			b.WriteString("\n" + d)
+		}
+		if url := s.GetTargetURL(); url != "" {
+			b.WriteString("\n" + url)
+		}
+	}
+	return b.String()
+}
+
+// logExcerpt returns the last lines of a job log up to its last error, without timestamps
+// and group markers.
+func logExcerpt(log string, maxLines int) string {
+	var lines []string
+	lastError := -1
+	for _, l := range strings.Split(log, "\n") {
+		l = logTimestampRegex.ReplaceAllString(strings.TrimRight(l, "\r"), "")
+		if strings.HasPrefix(l, "##[group]") || strings.HasPrefix(l, "##[endgroup]") {
+			continue
+		}
+		if strings.HasPrefix(l, "##[error]") {
+			lastError = len(lines)
+		}
+		lines = append(lines, l)
+	}
+	end := len(lines)
+	if lastError >= 0 {
+		end = lastError + 1
+	}
+	for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	start := max(0, end-maxLines)
+	return strings.Join(lines[start:end], "\n")
+}
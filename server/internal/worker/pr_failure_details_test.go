@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type ciDetailsGitHubClient struct {
+	*MockGitHubClient
+	annotations map[int64][]*github.CheckRunAnnotation
+	logs        map[int64]string
+}
+
+func (m *ciDetailsGitHubClient) ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64) ([]*github.CheckRunAnnotation, error) {
+	return m.annotations[checkRunID], nil
+}
+
+func (m *ciDetailsGitHubClient) GetJobLogTail(ctx context.Context, owner, repo string, jobID int64, maxBytes int) (string, error) {
+	log, ok := m.logs[jobID]
+	if !ok {
+		return "", fmt.Errorf("not found")
+	}
+	return log, nil
+}
+
+func TestLogExcerpt(t *testing.T) {
+	log := "2026-01-01T00:00:00.1234567Z ##[group]Run go test\n" +
+		"2026-01-01T00:00:01.1234567Z go test ./...\n" +
+		"2026-01-01T00:00:02.1234567Z ##[endgroup]\n" +
+		"2026-01-01T00:00:03.1234567Z --- FAIL: TestParse (0.00s)\n" +
+		"2026-01-01T00:00:04.1234567Z     parse_test.go:12: got 1, want 2\n" +
+		"2026-01-01T00:00:05.1234567Z ##[error]Process completed with exit code 1.\n" +
+		"2026-01-01T00:00:06.1234567Z Post job cleanup.\n"
+	assert.Equal(t, "go test ./...\n--- FAIL: TestParse (0.00s)\n    parse_test.go:12: got 1, want 2\n##[error]Process completed with exit code 1.", logExcerpt(log, 10))
+	assert.Equal(t, "    parse_test.go:12: got 1, want 2\n##[error]Process completed with exit code 1.", logExcerpt(log, 2))
+	assert.Equal(t, "b\nc", logExcerpt("a\nb\nc\n\n", 2))
+}
+
+func TestPRMonitorWorker_SendsFailureDetailsToSession(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	t.Setenv("JULES_API_KEY", "dummy-key")
+
+	var sent []string
+	sendStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		sent = append(sent, r.URL.Path+" "+body.Message)
+		w.WriteHeader(sendStatus)
+	}))
+	defer server.Close()
+
+	prURL := "https://github.com/o/r/pull/5"
+	_, err := db.Exec("INSERT INTO sessions (id, name, pr_url, create_time) VALUES ('s1', 'sessions/s1', ?, ?)", prURL, time.Now().Format(time.RFC3339))
+	assert.NoError(t, err)
+
+	gh := &ciDetailsGitHubClient{
+		MockGitHubClient: &MockGitHubClient{
+			PullRequests: []*github.PullRequest{{
+				Number: github.Int(5), HTMLURL: github.String(prURL), State: github.String("open"),
+				User: &github.User{Login: github.String("google-labs-jules")}, Head: &github.PullRequestBranch{SHA: github.String("sha1")},
+			}},
+			CombinedStatus: &github.CombinedStatus{State: github.String("failure"), Statuses: []*github.RepoStatus{
+				{Context: github.String("ci/legacy"), State: github.String("failure"), Description: github.String("Build broke"), TargetURL: github.String("https://ci.example.com/1")},
+			}},
+			CheckRuns: &github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+				{ID: github.Int64(11), Name: github.String("test"), Status: github.String("completed"), Conclusion: github.String("failure"), DetailsURL: github.String("https://github.com/o/r/actions/runs/1/job/11")},
+				{ID: github.Int64(12), Name: github.String("external"), Status: github.String("completed"), Conclusion: github.String("timed_out")},
+			}},
+		},
+		annotations: map[int64][]*github.CheckRunAnnotation{
+			11: {{Path: github.String("parse.go"), StartLine: github.Int(7), AnnotationLevel: github.String("failure"), Message: github.String("undefined: token")}},
+		},
+		logs: map[int64]string{11: "--- FAIL: TestParse\n##[error]Process completed with exit code 1.\n"},
+	}
+	sessions := &service.SessionServer{DB: db, BaseURL: server.URL, HTTPClient: server.Client()}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, sessions, gh, nil, "")
+	s := &pb.Settings{CheckFailingActionsEnabled: true, CheckFailingActionsThreshold: 10}
+
+	w.evaluatePR(context.Background(), "o", "r", 5, s)
+	assert.Empty(t, gh.CreatedComments)
+	if assert.Len(t, sent, 1) {
+		assert.Contains(t, sent[0], "/sessions/s1:sendMessage ")
+		assert.Contains(t, sent[0], failureCommentPrefix+"\n- test\n- external\n- ci/legacy")
+		assert.Contains(t, sent[0], "### test (failure)\nhttps://github.com/o/r/actions/runs/1/job/11")
+		assert.Contains(t, sent[0], "- parse.go:7 [failure] undefined: token")
+		assert.Contains(t, sent[0], "Log excerpt:\n```\n--- FAIL: TestParse\n##[error]Process completed with exit code 1.\n```")
+		assert.Contains(t, sent[0], "### external (timed_out)")
+		assert.Contains(t, sent[0], "### ci/legacy (failure)\nBuild broke\nhttps://ci.example.com/1")
+		assert.NotContains(t, sent[0], "@jules")
+	}
+	var nagCount int
+	assert.NoError(t, db.QueryRow("SELECT nag_count FROM pull_requests WHERE number = 5").Scan(&nagCount))
+	assert.Equal(t, 1, nagCount)
+
+	// When the session can't be reached, the PR gets a comment
+	_, err = db.Exec("UPDATE pull_requests SET last_report = NULL")
+	assert.NoError(t, err)
+	sendStatus = http.StatusInternalServerError
+	w.evaluatePR(context.Background(), "o", "r", 5, s)
+	if assert.Len(t, gh.CreatedComments, 1) {
+		assert.Equal(t, failureCommentPrefix+"\n- test\n- external\n- ci/legacy\n\n@jules", gh.CreatedComments[0])
+	}
+}
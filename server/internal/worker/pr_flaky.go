@@ -0,0 +1,201 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/logger"
+	pb "github.com/mcpany/jules/proto"
+)
+
+// rerunStartTimeout is how long the monitor waits for GitHub to start a rerun before it gives
+// up and reports the failure.
+const rerunStartTimeout = 15 * time.Minute
+
+// Outcomes of the failing checks the monitor reran, stored in the check_reruns table.
+const (
+	rerunOutcomeFlake  = "flake"
+	rerunOutcomeFailed = "failed"
+)
+
+// checkRerun is the state of the reruns of a failing check on a commit.
+type checkRerun struct {
+	attempts  int
+	lastRunID int64
+	outcome   string
+	updatedAt time.Time
+}
+
+// CheckRerunClient is implemented by GitHub clients that can rerun failed check runs.
+// Without it, failing checks are reported without being rerun.
+type CheckRerunClient interface {
+	RerunCheckRun(ctx context.Context, owner, repo string, checkRunID int64) error
+}
+
+// isFailedCheckRun reports whether the check run completed without passing.
+func isFailedCheckRun(run *github.CheckRun) bool {
+	switch run.GetConclusion() {
+	case "failure", "timed_out", "cancelled":
+		return true
+	}
+	return false
+}
+
+// rerunFailedChecks reruns the failing check runs of the commit that have been rerun fewer
+// than the configured number of times, so that flakes aren't reported. It reports whether any
+// check is being rerun, in which case the failures must not be reported yet.
+func (w *PRMonitorWorker) rerunFailedChecks(ctx context.Context, owner, repo, sha, prUrl string, runs []*github.CheckRun, ignored map[string]bool, s *pb.Settings) bool {
+	rerunner, _ := w.githubClient.(CheckRerunClient)
+	key := trackedRepo(ctx, owner, repo)
+	rerunning := false
+	for _, run := range runs {
+		if !isFailedCheckRun(run) || ignored[run.GetName()] {
+			continue
+		}
+		rerun, err := w.noteFailedCheck(ctx, key, sha, run.GetName())
+		if err != nil {
+			logger.Error("%s [%s]: Failed to record failure of check %s on %s: %v", w.Name(), w.id, run.GetName(), prUrl, err)
+			continue
+		}
+		if rerun.outcome != "" {
+			continue
+		}
+		if rerun.lastRunID != 0 && rerun.lastRunID == run.GetID() {
+			// GitHub hasn't started the rerun yet
+			if time.Since(rerun.updatedAt) < rerunStartTimeout {
+				rerunning = true
+				continue
+			}
+			logger.Warn("%s [%s]: GitHub didn't start the rerun of check %s on %s; reporting the failure", w.Name(), w.id, run.GetName(), prUrl)
+			w.resolveRerun(ctx, key, sha, run.GetName(), rerunOutcomeFailed)
+			continue
+		}
+		if rerunner == nil || run.GetID() == 0 || rerun.attempts >= int(s.GetFlakyCheckMaxReruns()) {
+			w.resolveRerun(ctx, key, sha, run.GetName(), rerunOutcomeFailed)
+			continue
+		}
+		if err := rerunner.RerunCheckRun(ctx, owner, repo, run.GetID()); err != nil {
+			logger.Error("%s [%s]: Failed to rerun check %s on %s: %v", w.Name(), w.id, run.GetName(), prUrl, err)
+			w.resolveRerun(ctx, key, sha, run.GetName(), rerunOutcomeFailed)
+			continue
+		}
+		logger.Info("%s [%s]: Reran failing check %s on %s (attempt %d of %d)", w.Name(), w.id, run.GetName(), prUrl, rerun.attempts+1, s.GetFlakyCheckMaxReruns())
+		w.recordRerun(ctx, key, sha, run.GetName(), run.GetID())
+		rerunning = true
+	}
+	return rerunning
+}
+
+// noteFailedCheck records that the check failed on the commit, counting the failure in the
+// check's statistics the first time. It returns the state of the check's reruns on the commit.
+func (w *PRMonitorWorker) noteFailedCheck(ctx context.Context, repo, sha, name string) (checkRerun, error) {
+	now := time.Now().Format(time.RFC3339)
+	res, err := w.db.ExecContext(ctx, `
+		INSERT INTO check_reruns (repo, head_sha, check_name, attempts, created_at, updated_at)
+		VALUES (?, ?, ?, 0, ?, ?)
+		ON CONFLICT(repo, head_sha, check_name) DO NOTHING`,
+		repo, sha, name, now, now)
+	if err != nil {
+		return checkRerun{}, err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		if _, err := w.db.ExecContext(ctx, `
+			INSERT INTO check_flakes (repo, check_name, failures, last_failed_at) VALUES (?, ?, 1, ?)
+			ON CONFLICT(repo, check_name) DO UPDATE SET failures = failures + 1, last_failed_at = excluded.last_failed_at`,
+			repo, name, now); err != nil {
+			return checkRerun{}, err
+		}
+	}
+
+	var rerun checkRerun
+	var lastRunID sql.NullInt64
+	var outcome sql.NullString
+	var updatedAt string
+	err = w.db.QueryRowContext(ctx, "SELECT attempts, last_run_id, outcome, updated_at FROM check_reruns WHERE repo = ? AND head_sha = ? AND check_name = ?",
+		repo, sha, name).Scan(&rerun.attempts, &lastRunID, &outcome, &updatedAt)
+	if err != nil {
+		return checkRerun{}, err
+	}
+	rerun.lastRunID, rerun.outcome = lastRunID.Int64, outcome.String
+	rerun.updatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+	return rerun, nil
+}
+
+// recordRerun counts a rerun of the check on the commit.
+func (w *PRMonitorWorker) recordRerun(ctx context.Context, repo, sha, name string, runID int64) {
+	now := time.Now().Format(time.RFC3339)
+	if _, err := w.db.ExecContext(ctx, `
+		UPDATE check_reruns SET attempts = attempts + 1, last_run_id = ?, updated_at = ?
+		WHERE repo = ? AND head_sha = ? AND check_name = ?`,
+		runID, now, repo, sha, name); err != nil {
+		logger.Error("%s [%s]: Failed to record rerun of check %s in %s: %v", w.Name(), w.id, name, repo, err)
+		return
+	}
+	if _, err := w.db.ExecContext(ctx, "UPDATE check_flakes SET reruns = reruns + 1 WHERE repo = ? AND check_name = ?", repo, name); err != nil {
+		logger.Error("%s [%s]: Failed to record rerun of check %s in %s: %v", w.Name(), w.id, name, repo, err)
+	}
+}
+
+// resolveRerun records the outcome of the failing check on the commit. A check that passed
+// after being rerun counts as a flake.
+func (w *PRMonitorWorker) resolveRerun(ctx context.Context, repo, sha, name, outcome string) {
+	now := time.Now().Format(time.RFC3339)
+	query := "UPDATE check_reruns SET outcome = ?, updated_at = ? WHERE repo = ? AND head_sha = ? AND check_name = ? AND outcome IS NULL"
+	if outcome == rerunOutcomeFlake {
+		query += " AND attempts > 0"
+	}
+	res, err := w.db.ExecContext(ctx, query, outcome, now, repo, sha, name)
+	if err != nil {
+		logger.Error("%s [%s]: Failed to record outcome of check %s in %s: %v", w.Name(), w.id, name, repo, err)
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 || outcome != rerunOutcomeFlake {
+		return
+	}
+	logger.Info("%s [%s]: Check %s in %s passed after a rerun; counting it as a flake", w.Name(), w.id, name, repo)
+	if _, err := w.db.ExecContext(ctx, "UPDATE check_flakes SET flakes = flakes + 1, last_flake_at = ? WHERE repo = ? AND check_name = ?",
+		now, repo, name); err != nil {
+		logger.Error("%s [%s]: Failed to record flake of check %s in %s: %v", w.Name(), w.id, name, repo, err)
+	}
+}
+
+// resolvePassedReruns counts the rerun checks of the commit that now pass as flakes.
+func (w *PRMonitorWorker) resolvePassedReruns(ctx context.Context, owner, repo, sha string, runs []*github.CheckRun) {
+	key := trackedRepo(ctx, owner, repo)
+	for _, run := range runs {
+		if run.GetConclusion() == "success" {
+			w.resolveRerun(ctx, key, sha, run.GetName(), rerunOutcomeFlake)
+		}
+	}
+}
+
+// hasPendingReruns reports whether checks of the commit were rerun and have no outcome yet.
+func (w *PRMonitorWorker) hasPendingReruns(ctx context.Context, owner, repo, sha string) bool {
+	var n int
+	if err := w.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM check_reruns WHERE repo = ? AND head_sha = ? AND outcome IS NULL AND attempts > 0",
+		trackedRepo(ctx, owner, repo), sha).Scan(&n); err != nil {
+		logger.Error("%s [%s]: Failed to look up reruns in %s/%s: %v", w.Name(), w.id, owner, repo, err)
+		return false
+	}
+	return n > 0
+}
+
+// ignoredChecks returns the names of the checks of the repo whose failures are not reported.
+func (w *PRMonitorWorker) ignoredChecks(ctx context.Context, owner, repo string) map[string]bool {
+	rows, err := w.db.QueryContext(ctx, "SELECT check_name FROM check_flakes WHERE repo = ? AND ignored", trackedRepo(ctx, owner, repo))
+	if err != nil {
+		logger.Error("%s [%s]: Failed to list ignored checks of %s/%s: %v", w.Name(), w.id, owner, repo, err)
+		return nil
+	}
+	defer rows.Close()
+	ignored := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err == nil {
+			ignored[name] = true
+		}
+	}
+	return ignored
+}
@@ -0,0 +1,122 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type rerunGitHubClient struct {
+	*MockGitHubClient
+	reruns []int64
+}
+
+func (m *rerunGitHubClient) RerunCheckRun(ctx context.Context, owner, repo string, checkRunID int64) error {
+	m.reruns = append(m.reruns, checkRunID)
+	return nil
+}
+
+func TestPRMonitorWorker_RerunsFlakyChecks(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	gh := &rerunGitHubClient{MockGitHubClient: failingPRMock()}
+	gh.CheckRuns.CheckRuns[0].ID = github.Int64(11)
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+	s := &pb.Settings{CheckFailingActionsEnabled: true, CheckFailingActionsThreshold: 10, FlakyCheckMaxReruns: 1}
+	ctx := context.Background()
+
+	flakes := func() (failures, reruns, flakes int) {
+		assert.NoError(t, db.QueryRow("SELECT failures, reruns, flakes FROM check_flakes WHERE repo = 'o/r' AND check_name = 'lint'").Scan(&failures, &reruns, &flakes))
+		return
+	}
+
+	// The failing check is rerun instead of reported
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	assert.Equal(t, []int64{11}, gh.reruns)
+	assert.Empty(t, gh.CreatedComments)
+
+	// Until GitHub starts the rerun, the old run is still the latest
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	assert.Len(t, gh.reruns, 1)
+	assert.Empty(t, gh.CreatedComments)
+
+	// The rerun passes, so the check is a flake
+	gh.CombinedStatus.State = github.String("success")
+	gh.CheckRuns.CheckRuns[0] = &github.CheckRun{ID: github.Int64(12), Name: github.String("lint"), Status: github.String("completed"), Conclusion: github.String("success")}
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	f, r, fl := flakes()
+	assert.Equal(t, []int{1, 1, 1}, []int{f, r, fl})
+
+	// On a new commit, the check fails again after its rerun and is reported
+	gh.PullRequests[0].Head.SHA = github.String("sha2")
+	gh.CombinedStatus.State = github.String("failure")
+	gh.CheckRuns.CheckRuns[0] = &github.CheckRun{ID: github.Int64(13), Name: github.String("lint"), Status: github.String("completed"), Conclusion: github.String("failure")}
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	assert.Equal(t, []int64{11, 13}, gh.reruns)
+	assert.Empty(t, gh.CreatedComments)
+	gh.CheckRuns.CheckRuns[0] = &github.CheckRun{ID: github.Int64(14), Name: github.String("lint"), Status: github.String("completed"), Conclusion: github.String("failure")}
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	assert.Len(t, gh.reruns, 2)
+	assert.Len(t, gh.CreatedComments, 1)
+	f, r, fl = flakes()
+	assert.Equal(t, []int{2, 2, 1}, []int{f, r, fl})
+	var outcome sql.NullString
+	assert.NoError(t, db.QueryRow("SELECT outcome FROM check_reruns WHERE head_sha = 'sha2'").Scan(&outcome))
+	assert.Equal(t, rerunOutcomeFailed, outcome.String)
+}
+
+func TestPRMonitorWorker_ReportsRerunsThatNeverStart(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	gh := &rerunGitHubClient{MockGitHubClient: failingPRMock()}
+	gh.CheckRuns.CheckRuns[0].ID = github.Int64(11)
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+	s := &pb.Settings{CheckFailingActionsEnabled: true, CheckFailingActionsThreshold: 10, FlakyCheckMaxReruns: 1}
+	ctx := context.Background()
+
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	assert.Equal(t, []int64{11}, gh.reruns)
+	assert.Empty(t, gh.CreatedComments)
+
+	// GitHub never replaced the failed run, so the failure is reported after the deadline
+	_, err := db.Exec("UPDATE check_reruns SET updated_at = ?", time.Now().Add(-rerunStartTimeout-time.Minute).Format(time.RFC3339))
+	assert.NoError(t, err)
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	assert.Len(t, gh.reruns, 1)
+	assert.Len(t, gh.CreatedComments, 1)
+	var outcome sql.NullString
+	assert.NoError(t, db.QueryRow("SELECT outcome FROM check_reruns").Scan(&outcome))
+	assert.Equal(t, rerunOutcomeFailed, outcome.String)
+}
+
+func TestPRMonitorWorker_IgnoresFlakyChecks(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	_, err := db.Exec("INSERT INTO check_flakes (repo, check_name, failures, reruns, flakes, ignored) VALUES ('o/r', 'lint', 4, 4, 3, 1)")
+	assert.NoError(t, err)
+	gh := &rerunGitHubClient{MockGitHubClient: failingPRMock()}
+	gh.CheckRuns.CheckRuns[0].ID = github.Int64(11)
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+
+	w.evaluatePR(context.Background(), "o", "r", 5, &pb.Settings{CheckFailingActionsEnabled: true, CheckFailingActionsThreshold: 10, FlakyCheckMaxReruns: 1})
+	assert.Empty(t, gh.reruns)
+	assert.Empty(t, gh.CreatedComments)
+}
+
+func TestPRMonitorWorker_ReportsWithoutReruns(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	gh := &rerunGitHubClient{MockGitHubClient: failingPRMock()}
+	gh.CheckRuns.CheckRuns[0].ID = github.Int64(11)
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+
+	w.evaluatePR(context.Background(), "o", "r", 5, &pb.Settings{CheckFailingActionsEnabled: true, CheckFailingActionsThreshold: 10})
+	assert.Empty(t, gh.reruns)
+	assert.Len(t, gh.CreatedComments, 1)
+}
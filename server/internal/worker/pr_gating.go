@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+)
+
+// requiredChecksTTL is how long the required checks of a branch are cached.
+const requiredChecksTTL = 5 * time.Minute
+
+// Results of the checks of a commit, worst last.
+const (
+	checkPassed  = "success"
+	checkPending = "pending"
+	checkFailed  = "failure"
+)
+
+// RequiredChecksClient is implemented by GitHub clients that can read the checks that branch
+// protection and rulesets require. Without it, every check gates PRs.
+type RequiredChecksClient interface {
+	RequiredChecks(ctx context.Context, owner, repo, branch string) ([]string, error)
+}
+
+type requiredChecksEntry struct {
+	names   []string
+	fetched time.Time
+}
+
+// checksPassed reports whether the checks that gate the PR passed: the checks the base branch
+// requires, or all checks of the head commit if it requires none. Both commit statuses and
+// check runs count, and informational checks are left out. Otherwise, it returns why not.
+func (w *PRMonitorWorker) checksPassed(ctx context.Context, owner, repo string, pr *github.PullRequest, s *pb.Settings) (bool, string) {
+	sha := pr.GetHead().GetSHA()
+	if sha == "" {
+		return false, "no head commit"
+	}
+	status, err := w.gh(ctx).GetCombinedStatus(ctx, owner, repo, sha)
+	if err != nil {
+		return false, fmt.Sprintf("failed to get status: %v", err)
+	}
+	if status == nil {
+		status = &github.CombinedStatus{}
+	}
+	runs, err := w.listCheckRuns(ctx, owner, repo, sha)
+	if err != nil {
+		return false, fmt.Sprintf("failed to list check runs: %v", err)
+	}
+	required, err := w.requiredChecks(ctx, owner, repo, pr.GetBase().GetRef())
+	if err != nil {
+		return false, fmt.Sprintf("failed to get required checks: %v", err)
+	}
+
+	names, results := checkResults(status.Statuses, runs)
+	informational := w.informationalChecks(ctx, owner, repo, s)
+	if len(required) > 0 {
+		for _, name := range required {
+			if informational[name] {
+				continue
+			}
+			result, ok := results[name]
+			if !ok {
+				return false, fmt.Sprintf("required check %s has not run", name)
+			}
+			if result != checkPassed {
+				return false, fmt.Sprintf("required check %s is %s", name, result)
+			}
+		}
+		return true, ""
+	}
+
+	gating := 0
+	for _, name := range names {
+		if informational[name] {
+			continue
+		}
+		if results[name] != checkPassed {
+			return false, fmt.Sprintf("check %s is %s", name, results[name])
+		}
+		gating++
+	}
+	if gating == 0 {
+		return false, "no checks ran"
+	}
+	return true, ""
+}
+
+// checkResults merges the commit statuses and check runs of a commit by name. A name with
+// several results gets the worst of them.
+func checkResults(statuses []*github.RepoStatus, runs []*github.CheckRun) ([]string, map[string]string) {
+	var names []string
+	results := make(map[string]string)
+	add := func(name, result string) {
+		prev, ok := results[name]
+		if !ok {
+			names = append(names, name)
+		}
+		if !ok || result == checkFailed || (result == checkPending && prev == checkPassed) {
+			results[name] = result
+		}
+	}
+	for _, st := range statuses {
+		switch st.GetState() {
+		case "success":
+			add(st.GetContext(), checkPassed)
+		case "pending":
+			add(st.GetContext(), checkPending)
+		default:
+			add(st.GetContext(), checkFailed)
+		}
+	}
+	for _, run := range runs {
+		switch {
+		case run.GetStatus() != "completed":
+			add(run.GetName(), checkPending)
+		case run.GetConclusion() == "success" || run.GetConclusion() == "neutral" || run.GetConclusion() == "skipped":
+			add(run.GetName(), checkPassed)
+		default:
+			add(run.GetName(), checkFailed)
+		}
+	}
+	return names, results
+}
+
+// requiredChecks returns the checks the branch requires, cached for requiredChecksTTL.
+func (w *PRMonitorWorker) requiredChecks(ctx context.Context, owner, repo, branch string) ([]string, error) {
+	client, ok := w.githubClient.(RequiredChecksClient)
+	if !ok || branch == "" {
+		return nil, nil
+	}
+	key := trackedRepo(ctx, owner, repo) + "@" + branch
+	w.requiredMu.Lock()
+	entry, ok := w.required[key]
+	w.requiredMu.Unlock()
+	if ok && time.Since(entry.fetched) < requiredChecksTTL {
+		return entry.names, nil
+	}
+
+	names, err := client.RequiredChecks(ctx, owner, repo, branch)
+	if err != nil {
+		return nil, err
+	}
+	w.requiredMu.Lock()
+	if w.required == nil {
+		w.required = make(map[string]requiredChecksEntry)
+	}
+	w.required[key] = requiredChecksEntry{names: names, fetched: time.Now()}
+	w.requiredMu.Unlock()
+	return names, nil
+}
+
+// informationalChecks returns the names of the checks of the repo that don't gate its PRs.
+func (w *PRMonitorWorker) informationalChecks(ctx context.Context, owner, repo string, s *pb.Settings) map[string]bool {
+	checks, err := service.ParseInformationalChecks(s.GetInformationalChecks())
+	if err != nil {
+		logger.Error("%s [%s]: Invalid informational checks: %v", w.Name(), w.id, err)
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, key := range []string{service.AllRepos, owner + "/" + repo, trackedRepo(ctx, owner, repo)} {
+		for _, name := range checks[key] {
+			names[name] = true
+		}
+	}
+	return names
+}
@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type requiredChecksGitHubClient struct {
+	*MockGitHubClient
+	required []string
+	calls    int
+}
+
+func (m *requiredChecksGitHubClient) RequiredChecks(ctx context.Context, owner, repo, branch string) ([]string, error) {
+	m.calls++
+	return m.required, nil
+}
+
+func checkRun(name, status, conclusion string) *github.CheckRun {
+	return &github.CheckRun{Name: github.String(name), Status: github.String(status), Conclusion: github.String(conclusion)}
+}
+
+func TestPRMonitorWorker_ChecksPassed(t *testing.T) {
+	legacy := func(state string) []*github.RepoStatus {
+		return []*github.RepoStatus{{Context: github.String("ci/legacy"), State: github.String(state)}}
+	}
+	tests := []struct {
+		name          string
+		required      []string
+		informational string
+		statuses      []*github.RepoStatus
+		runs          []*github.CheckRun
+		want          bool
+		reason        string
+	}{
+		{"only check runs", nil, "", nil, []*github.CheckRun{checkRun("test", "completed", "success"), checkRun("docs", "completed", "skipped")}, true, ""},
+		{"optional check failing", []string{"test"}, "", nil, []*github.CheckRun{checkRun("test", "completed", "success"), checkRun("lint", "completed", "failure")}, true, ""},
+		{"required status pending", []string{"test", "ci/legacy"}, "", legacy("pending"), []*github.CheckRun{checkRun("test", "completed", "success")}, false, "required check ci/legacy is pending"},
+		{"required check missing", []string{"test", "e2e"}, "", nil, []*github.CheckRun{checkRun("test", "completed", "success")}, false, "required check e2e has not run"},
+		{"required check running", []string{"test"}, "", nil, []*github.CheckRun{checkRun("test", "in_progress", "")}, false, "required check test is pending"},
+		{"required informational check", []string{"test", "ci/legacy"}, "o/r: ci/legacy", legacy("failure"), []*github.CheckRun{checkRun("test", "completed", "success")}, true, ""},
+		{"all checks without protection", nil, "", legacy("success"), []*github.CheckRun{checkRun("lint", "completed", "failure")}, false, "check lint is failure"},
+		{"informational check failing", nil, "*: lint\nother/repo: test", legacy("success"), []*github.CheckRun{checkRun("lint", "completed", "failure")}, true, ""},
+		{"no checks", nil, "", nil, nil, false, "no checks ran"},
+		{"status and run of the same check", nil, "", []*github.RepoStatus{{Context: github.String("test"), State: github.String("success")}}, []*github.CheckRun{checkRun("test", "completed", "timed_out")}, false, "check test is failure"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gh := &requiredChecksGitHubClient{
+				MockGitHubClient: &MockGitHubClient{
+					CombinedStatus: &github.CombinedStatus{State: github.String("pending"), Statuses: tt.statuses},
+					CheckRuns:      &github.ListCheckRunsResults{CheckRuns: tt.runs},
+				},
+				required: tt.required,
+			}
+			w := NewPRMonitorWorker(nil, nil, nil, gh, nil, "")
+			pr := &github.PullRequest{Head: &github.PullRequestBranch{SHA: github.String("sha1")}, Base: &github.PullRequestBranch{Ref: github.String("main")}}
+
+			passed, reason := w.checksPassed(context.Background(), "o", "r", pr, &pb.Settings{InformationalChecks: tt.informational})
+			assert.Equal(t, tt.want, passed)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+func TestPRMonitorWorker_AutoMergesWhenRequiredChecksPass(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	gh := &requiredChecksGitHubClient{
+		MockGitHubClient: &MockGitHubClient{
+			PullRequests: []*github.PullRequest{{
+				Number: github.Int(5), HTMLURL: github.String("https://github.com/o/r/pull/5"), State: github.String("open"),
+				User: &github.User{Login: github.String("someone")}, Mergeable: github.Bool(true), Draft: github.Bool(true),
+				Head: &github.PullRequestBranch{SHA: github.String("sha1")}, Base: &github.PullRequestBranch{Ref: github.String("main")},
+			}},
+			// Check runs alone leave the combined status pending
+			CombinedStatus: &github.CombinedStatus{State: github.String("pending")},
+			CheckRuns: &github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+				checkRun("test", "completed", "success"),
+				checkRun("coverage", "completed", "failure"),
+			}},
+		},
+		required: []string{"test"},
+	}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+	s := &pb.Settings{AutoMergeEnabled: true, CheckFailingActionsThreshold: 10, InformationalChecks: "o/r: coverage"}
+
+	w.evaluatePR(context.Background(), "o", "r", 5, s)
+	// The informational check's failure isn't reported
+	assert.Equal(t, []string{"MARKED_READY_FOR_REVIEW", "Automatically merged by bot as all checks passed", "MERGED_PR_5_squash"}, gh.CreatedComments)
+	// The required checks are cached
+	assert.Equal(t, 1, gh.calls)
+}
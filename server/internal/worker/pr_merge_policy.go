@@ -0,0 +1,280 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/google/uuid"
+	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+)
+
+// mergeAllowedReason is the decision logged for PRs the policy lets through.
+const mergeAllowedReason = "all auto-merge policy checks passed"
+
+// PRReviewsClient is implemented by GitHub clients that can list the reviews of PRs. Without
+// it, policies that depend on reviews block auto-merge.
+type PRReviewsClient interface {
+	ListReviews(ctx context.Context, owner, repo string, number int) ([]*github.PullRequestReview, error)
+}
+
+// evaluateMergePolicy decides whether the auto-merge policy lets the PR be merged. The reason
+// explains the decision for the decision log.
+func (w *PRMonitorWorker) evaluateMergePolicy(ctx context.Context, owner, repo string, pr *github.PullRequest, s *pb.Settings) (bool, string) {
+	if pr.Mergeable == nil {
+		return false, "GitHub hasn't computed whether the PR is mergeable yet"
+	}
+	if !*pr.Mergeable || pr.GetMergeableState() == "dirty" {
+		return false, "the PR has merge conflicts"
+	}
+	if reason := w.outOfMergeScope(ctx, pr, s); reason != "" {
+		return false, reason
+	}
+	if reason := diffTooLarge(pr, s); reason != "" {
+		return false, reason
+	}
+	if passed, reason := w.checksPassed(ctx, owner, repo, pr, s); !passed {
+		return false, reason
+	}
+	if reason := w.reviewsBlockMerge(ctx, owner, repo, pr, s); reason != "" {
+		return false, reason
+	}
+	if reason := w.touchesProtectedPaths(ctx, owner, repo, pr, s); reason != "" {
+		return false, reason
+	}
+	if s.GetAutoMergeRequireBaseGreen() {
+		if reason := w.baseBranchNotGreen(ctx, owner, repo, pr, s); reason != "" {
+			return false, reason
+		}
+	}
+	return true, mergeAllowedReason
+}
+
+// outOfMergeScope explains why the PR is outside the PRs the policy may merge, or returns "".
+func (w *PRMonitorWorker) outOfMergeScope(ctx context.Context, pr *github.PullRequest, s *pb.Settings) string {
+	label := s.GetAutoMergeLabel()
+	if label == "" {
+		label = service.DefaultAutoMergeLabel
+	}
+	labeled := false
+	for _, l := range pr.Labels {
+		if strings.EqualFold(l.GetName(), label) {
+			labeled = true
+		}
+	}
+
+	switch s.GetAutoMergeScope() {
+	case service.AutoMergeScopeSessions:
+		if labeled || w.isBotLogin(ctx, pr.GetUser().GetLogin()) {
+			return ""
+		}
+		if _, err := w.prSession(ctx, pr.GetHTMLURL()); err == nil {
+			return ""
+		}
+		return fmt.Sprintf("the PR was not opened by a Jules session and has no %q label", label)
+	case service.AutoMergeScopeLabeled:
+		if !labeled {
+			return fmt.Sprintf("the PR has no %q label", label)
+		}
+	}
+	return ""
+}
+
+// diffTooLarge explains which size limit the PR exceeds, or returns "".
+func diffTooLarge(pr *github.PullRequest, s *pb.Settings) string {
+	if limit := int(s.GetAutoMergeMaxAdditions()); limit > 0 && pr.GetAdditions() > limit {
+		return fmt.Sprintf("the PR adds %d lines, more than the limit of %d", pr.GetAdditions(), limit)
+	}
+	if limit := int(s.GetAutoMergeMaxDeletions()); limit > 0 && pr.GetDeletions() > limit {
+		return fmt.Sprintf("the PR deletes %d lines, more than the limit of %d", pr.GetDeletions(), limit)
+	}
+	if limit := int(s.GetAutoMergeMaxFiles()); limit > 0 && pr.GetChangedFiles() > limit {
+		return fmt.Sprintf("the PR changes %d files, more than the limit of %d", pr.GetChangedFiles(), limit)
+	}
+	return ""
+}
+
+// reviewsBlockMerge explains why the reviews of the PR block merging it, or returns "". Only
+// the latest approval or change request of each reviewer counts.
+func (w *PRMonitorWorker) reviewsBlockMerge(ctx context.Context, owner, repo string, pr *github.PullRequest, s *pb.Settings) string {
+	required := int(s.GetAutoMergeRequiredApprovals())
+	if required == 0 && !s.GetAutoMergeBlockChangesRequested() {
+		return ""
+	}
+	client, ok := w.githubClient.(PRReviewsClient)
+	if !ok {
+		return "the PR's reviews can't be read"
+	}
+	reviews, err := client.ListReviews(ctx, owner, repo, pr.GetNumber())
+	if err != nil {
+		return fmt.Sprintf("failed to list the PR's reviews: %v", err)
+	}
+
+	latest := make(map[string]string)
+	for _, r := range reviews {
+		switch r.GetState() {
+		case "APPROVED", "CHANGES_REQUESTED":
+			latest[r.GetUser().GetLogin()] = r.GetState()
+		case "DISMISSED":
+			delete(latest, r.GetUser().GetLogin())
+		}
+	}
+	approvals := 0
+	var requesters []string
+	for login, state := range latest {
+		if state == "APPROVED" {
+			approvals++
+		} else {
+			requesters = append(requesters, login)
+		}
+	}
+	if s.GetAutoMergeBlockChangesRequested() && len(requesters) > 0 {
+		sort.Strings(requesters)
+		return fmt.Sprintf("changes were requested by %s", strings.Join(requesters, ", "))
+	}
+	if approvals < required {
+		return fmt.Sprintf("the PR has %d approving reviews, %d required", approvals, required)
+	}
+	return ""
+}
+
+// touchesProtectedPaths explains which protected path the PR changes, or returns "".
+func (w *PRMonitorWorker) touchesProtectedPaths(ctx context.Context, owner, repo string, pr *github.PullRequest, s *pb.Settings) string {
+	globs := parsePathGlobs(s.GetAutoMergeProtectedPaths())
+	if len(globs) == 0 {
+		return ""
+	}
+	files, err := w.gh(ctx).ListFiles(ctx, owner, repo, pr.GetNumber(), &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return fmt.Sprintf("failed to list the PR's files: %v", err)
+	}
+	if len(files) < pr.GetChangedFiles() {
+		return "the PR changes too many files to check them against the protected paths"
+	}
+	for _, f := range files {
+		for _, name := range []string{f.GetFilename(), f.GetPreviousFilename()} {
+			if name == "" {
+				continue
+			}
+			for _, g := range globs {
+				if g.re.MatchString(name) {
+					return fmt.Sprintf("the PR changes %s, which matches the protected path %s", name, g.pattern)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// baseBranchNotGreen explains why the checks of the PR's base branch aren't green, or returns "".
+func (w *PRMonitorWorker) baseBranchNotGreen(ctx context.Context, owner, repo string, pr *github.PullRequest, s *pb.Settings) string {
+	base := pr.GetBase().GetRef()
+	if base == "" {
+		return "the PR has no base branch"
+	}
+	status, err := w.gh(ctx).GetCombinedStatus(ctx, owner, repo, base)
+	if err != nil {
+		return fmt.Sprintf("failed to get the status of %s: %v", base, err)
+	}
+	if status == nil {
+		status = &github.CombinedStatus{}
+	}
+	runs, err := w.listCheckRuns(ctx, owner, repo, base)
+	if err != nil {
+		return fmt.Sprintf("failed to list the check runs of %s: %v", base, err)
+	}
+	names, results := checkResults(status.Statuses, runs)
+	informational := w.informationalChecks(ctx, owner, repo, s)
+	for _, name := range names {
+		if !informational[name] && results[name] != checkPassed {
+			return fmt.Sprintf("the base branch %s is not green: check %s is %s", base, name, results[name])
+		}
+	}
+	return ""
+}
+
+type pathGlob struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// parsePathGlobs parses comma or newline separated path globs. "*" matches within a path
+// segment and "**" across segments; globs without a "/" match files in any directory, and
+// globs ending in "/" match everything below the directory.
+func parsePathGlobs(list string) []pathGlob {
+	var globs []pathGlob
+	for _, p := range strings.FieldsFunc(list, func(c rune) bool { return c == ',' || c == '\n' }) {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		glob := strings.TrimPrefix(p, "/")
+		if !strings.Contains(strings.TrimSuffix(glob, "/"), "/") {
+			glob = "**/" + glob
+		}
+		if strings.HasSuffix(glob, "/") {
+			glob += "**"
+		}
+		globs = append(globs, pathGlob{pattern: p, re: regexp.MustCompile("^" + globRegexp(glob) + "$")})
+	}
+	return globs
+}
+
+// globRegexp translates a path glob into a regular expression.
+func globRegexp(glob string) string {
+	var b strings.Builder
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; {
+		case strings.HasPrefix(glob[i:], "**/"):
+			b.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String()
+}
+
+// recordMergeDecision adds the policy's decision on the PR to the decision log, unless it
+// repeats the last decision on the same commit.
+func (w *PRMonitorWorker) recordMergeDecision(ctx context.Context, owner, repo string, pr *github.PullRequest, merged bool, reason string) {
+	key := trackedRepo(ctx, owner, repo)
+	sha := pr.GetHead().GetSHA()
+	var lastSHA sql.NullString
+	var lastMerged bool
+	var lastReason string
+	err := w.db.QueryRowContext(ctx, `
+		SELECT head_sha, merged, reason FROM merge_decisions WHERE repo = ? AND number = ?
+		ORDER BY created_at DESC, rowid DESC LIMIT 1`, key, pr.GetNumber()).Scan(&lastSHA, &lastMerged, &lastReason)
+	if err == nil && lastSHA.String == sha && lastMerged == merged && lastReason == reason {
+		return
+	}
+	if err != nil && err != sql.ErrNoRows {
+		logger.Error("%s [%s]: Failed to look up merge decisions of %s: %v", w.Name(), w.id, pr.GetHTMLURL(), err)
+	}
+
+	if merged {
+		logger.Info("%s [%s]: Auto-merge policy merged PR %s: %s", w.Name(), w.id, pr.GetHTMLURL(), reason)
+	} else {
+		logger.Info("%s [%s]: Not auto-merging PR %s: %s", w.Name(), w.id, pr.GetHTMLURL(), reason)
+	}
+	if _, err := w.db.ExecContext(ctx, `
+		INSERT INTO merge_decisions (id, repo, number, head_sha, merged, reason, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		uuid.New().String(), key, pr.GetNumber(), sha, merged, reason, time.Now().Format(time.RFC3339)); err != nil {
+		logger.Error("%s [%s]: Failed to record merge decision on %s: %v", w.Name(), w.id, pr.GetHTMLURL(), err)
+	}
+}
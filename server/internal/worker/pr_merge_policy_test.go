@@ -0,0 +1,229 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	gclient "github.com/mcpany/jules/internal/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+// baseStatusGitHubClient reports a separate combined status for the base branch "main".
+type baseStatusGitHubClient struct {
+	*MockGitHubClient
+	baseStatus *github.CombinedStatus
+}
+
+func (m *baseStatusGitHubClient) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*github.CombinedStatus, error) {
+	if ref == "main" {
+		return m.baseStatus, nil
+	}
+	return m.MockGitHubClient.GetCombinedStatus(ctx, owner, repo, ref)
+}
+
+func TestParsePathGlobs(t *testing.T) {
+	tests := []struct {
+		glob  string
+		path  string
+		match bool
+	}{
+		{"*.lock", "Cargo.lock", true},
+		{"*.lock", "sub/dir/yarn.lock", true},
+		{"*.lock", "lock", false},
+		{"migrations/", "db/migrations/001.sql", true},
+		{"/migrations/", "migrations/001.sql", true},
+		{".github/workflows/*", ".github/workflows/ci.yml", true},
+		{".github/workflows/*", ".github/workflows/sub/ci.yml", false},
+		{".github/**", ".github/workflows/sub/ci.yml", true},
+		{"src/**/secret?.go", "src/secret1.go", true},
+		{"src/**/secret?.go", "src/a/b/secret2.go", true},
+		{"src/**/secret?.go", "lib/src/secret1.go", false},
+		{"go.mod", "server/go.mod", true},
+		{"go.mod", "go.mod.bak", false},
+	}
+	for _, tt := range tests {
+		globs := parsePathGlobs(tt.glob)
+		if assert.Len(t, globs, 1) {
+			assert.Equal(t, tt.match, globs[0].re.MatchString(tt.path), "%s on %s", tt.glob, tt.path)
+		}
+	}
+	assert.Len(t, parsePathGlobs("a/*, b/*\n\n c"), 3)
+}
+
+func TestPRMonitorWorker_MergePolicy(t *testing.T) {
+	review := func(login, state string) *github.PullRequestReview {
+		return &github.PullRequestReview{User: &github.User{Login: github.String(login)}, State: github.String(state)}
+	}
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings)
+		allowed bool
+		reason  string
+	}{
+		{"defaults", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+		}, true, mergeAllowedReason},
+		{"mergeability unknown", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			pr.Mergeable = nil
+		}, false, "GitHub hasn't computed whether the PR is mergeable yet"},
+		{"human PR out of session scope", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeScope = service.AutoMergeScopeSessions
+		}, false, `the PR was not opened by a Jules session and has no "automerge" label`},
+		{"session PR", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeScope = service.AutoMergeScopeSessions
+			_, err := w.db.Exec("INSERT INTO sessions (id, name, pr_url, create_time) VALUES ('s1', 'sessions/s1', ?, ?)", pr.GetHTMLURL(), time.Now().Format(time.RFC3339))
+			assert.NoError(t, err)
+		}, true, mergeAllowedReason},
+		{"labeled PR", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeScope, s.AutoMergeLabel = service.AutoMergeScopeLabeled, "ship-it"
+			pr.Labels = []*github.Label{{Name: github.String("Ship-It")}}
+		}, true, mergeAllowedReason},
+		{"too many additions", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeMaxAdditions = 5
+		}, false, "the PR adds 10 lines, more than the limit of 5"},
+		{"too many deletions", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeMaxDeletions, s.AutoMergeMaxFiles = 2, 0
+			pr.Deletions = github.Int(3)
+		}, false, "the PR deletes 3 lines, more than the limit of 2"},
+		{"approved", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeRequiredApprovals = 1
+			gh.Reviews = []*github.PullRequestReview{review("alice", "CHANGES_REQUESTED"), review("bob", "COMMENTED"), review("alice", "APPROVED")}
+		}, true, mergeAllowedReason},
+		{"changes requested", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeRequiredApprovals = 1
+			gh.Reviews = []*github.PullRequestReview{review("alice", "APPROVED"), review("bob", "CHANGES_REQUESTED")}
+		}, false, "changes were requested by bob"},
+		{"changes requested allowed", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeBlockChangesRequested = false
+			gh.Reviews = []*github.PullRequestReview{review("bob", "CHANGES_REQUESTED")}
+		}, true, mergeAllowedReason},
+		{"approval dismissed", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeRequiredApprovals = 1
+			gh.Reviews = []*github.PullRequestReview{review("alice", "APPROVED"), review("alice", "DISMISSED")}
+		}, false, "the PR has 0 approving reviews, 1 required"},
+		{"protected path", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeProtectedPaths = ".github/**, migrations/"
+			gh.Files = append(gh.Files, &github.CommitFile{Filename: github.String("db/migrations/001.sql")})
+			pr.ChangedFiles = github.Int(2)
+		}, false, "the PR changes db/migrations/001.sql, which matches the protected path migrations/"},
+		{"protected path renamed away", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeProtectedPaths = "*.lock"
+			gh.Files[0].PreviousFilename = github.String("yarn.lock")
+		}, false, "the PR changes yarn.lock, which matches the protected path *.lock"},
+		{"red base branch", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeRequireBaseGreen = true
+			gh.baseStatus = &github.CombinedStatus{Statuses: []*github.RepoStatus{{Context: github.String("ci"), State: github.String("failure")}}}
+		}, false, "the base branch main is not green: check ci is failure"},
+		{"green base branch", func(t *testing.T, w *PRMonitorWorker, gh *baseStatusGitHubClient, pr *github.PullRequest, s *pb.Settings) {
+			s.AutoMergeRequireBaseGreen = true
+		}, true, mergeAllowedReason},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupTestDB(t)
+			defer db.Close()
+			passing := &github.CombinedStatus{State: github.String("success"), Statuses: []*github.RepoStatus{{Context: github.String("ci"), State: github.String("success")}}}
+			gh := &baseStatusGitHubClient{
+				MockGitHubClient: &MockGitHubClient{
+					CombinedStatus: passing,
+					Files:          []*github.CommitFile{{Filename: github.String("src/a.go")}},
+				},
+				baseStatus: passing,
+			}
+			pr := &github.PullRequest{
+				Number: github.Int(5), HTMLURL: github.String("https://github.com/o/r/pull/5"),
+				User: &github.User{Login: github.String("someone")}, Mergeable: github.Bool(true),
+				Additions: github.Int(10), Deletions: github.Int(2), ChangedFiles: github.Int(1),
+				Head: &github.PullRequestBranch{SHA: github.String("sha1")}, Base: &github.PullRequestBranch{Ref: github.String("main")},
+			}
+			s := &pb.Settings{AutoMergeScope: service.AutoMergeScopeAll, AutoMergeBlockChangesRequested: true}
+			w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+			tt.setup(t, w, gh, pr, s)
+
+			allowed, reason := w.evaluateMergePolicy(context.Background(), "o", "r", pr, s)
+			assert.Equal(t, tt.allowed, allowed)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+func TestPRMonitorWorker_LogsMergeDecisions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	gh := &MockGitHubClient{
+		PullRequests: []*github.PullRequest{{
+			Number: github.Int(5), HTMLURL: github.String("https://github.com/o/r/pull/5"), State: github.String("open"),
+			User: &github.User{Login: github.String("someone")}, Mergeable: github.Bool(true),
+			Head: &github.PullRequestBranch{SHA: github.String("sha1")}, Base: &github.PullRequestBranch{Ref: github.String("main")},
+		}},
+		CombinedStatus: &github.CombinedStatus{State: github.String("success"), Statuses: []*github.RepoStatus{{Context: github.String("ci"), State: github.String("success")}}},
+	}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+	prs := &service.PullRequestServer{DB: db}
+	s := &pb.Settings{AutoMergeEnabled: true, AutoMergeScope: service.AutoMergeScopeLabeled, AutoMergeBlockChangesRequested: true}
+	ctx := context.Background()
+
+	// Repeated evaluations log a decision once
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	resp, err := prs.ListMergeDecisions(ctx, &pb.ListMergeDecisionsRequest{Repo: "o/r", Number: 5})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Decisions, 1) {
+		assert.False(t, resp.Decisions[0].Merged)
+		assert.Equal(t, `the PR has no "automerge" label`, resp.Decisions[0].Reason)
+		assert.Equal(t, "sha1", resp.Decisions[0].HeadSha)
+	}
+	assert.NotContains(t, gh.CreatedComments, "MERGED_PR_5_squash")
+
+	gh.PullRequests[0].Labels = []*github.Label{{Name: github.String("automerge")}}
+	w.evaluatePR(ctx, "o", "r", 5, s)
+	assert.Contains(t, gh.CreatedComments, "MERGED_PR_5_squash")
+	resp, err = prs.ListMergeDecisions(ctx, &pb.ListMergeDecisionsRequest{Repo: "o/r", Number: 5})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Decisions, 2) {
+		assert.True(t, resp.Decisions[0].Merged)
+		assert.Equal(t, mergeAllowedReason, resp.Decisions[0].Reason)
+	}
+}
+
+func TestPRMonitorWorker_MergePolicyFromSnapshot(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	_, err := db.Exec("INSERT INTO jobs (id, name, repo, branch, prompt, created_at) VALUES ('j1', 'n', 'o/r', 'main', 'p', '2026-01-01T00:00:00Z')")
+	assert.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO settings (profile_id, auto_merge_enabled, auto_merge_scope, auto_merge_max_additions, theme, auto_retry_message, auto_continue_message)
+		VALUES ('default', 1, 'labeled', 20, 'system', '', '')`)
+	assert.NoError(t, err)
+
+	// The REST mock knows no PRs: the policy reads the labels and diff size from the snapshot
+	snap := &gclient.PullRequestSnapshot{
+		PullRequest: &github.PullRequest{
+			Number: github.Int(5), HTMLURL: github.String("https://github.com/o/r/pull/5"), State: github.String("open"),
+			User: &github.User{Login: github.String("someone")}, Mergeable: github.Bool(true), ChangedFiles: github.Int(1),
+			Additions: github.Int(30), Labels: []*github.Label{{Name: github.String("automerge")}},
+			Head: &github.PullRequestBranch{SHA: github.String("sha1")}, Base: &github.PullRequestBranch{Ref: github.String("main")},
+		},
+		Files:          []*github.CommitFile{{Filename: github.String("src/a.go")}},
+		CombinedStatus: &github.CombinedStatus{State: github.String("success"), Statuses: []*github.RepoStatus{{Context: github.String("ci"), State: github.String("success")}}},
+		CheckRuns:      []*github.CheckRun{},
+	}
+	gh := &snapshotGitHubClientMock{MockGitHubClient: &MockGitHubClient{}, snapshots: []*gclient.PullRequestSnapshot{snap}}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+	prs := &service.PullRequestServer{DB: db}
+	ctx := context.Background()
+
+	assert.NoError(t, w.runCheck(ctx))
+	assert.NotContains(t, gh.CreatedComments, "MERGED_PR_5_squash")
+	resp, err := prs.ListMergeDecisions(ctx, &pb.ListMergeDecisionsRequest{Repo: "o/r", Number: 5})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Decisions, 1) {
+		assert.Equal(t, "the PR adds 30 lines, more than the limit of 20", resp.Decisions[0].Reason)
+	}
+
+	snap.PullRequest.Additions = github.Int(10)
+	assert.NoError(t, w.runCheck(ctx))
+	assert.Contains(t, gh.CreatedComments, "MERGED_PR_5_squash")
+}
@@ -27,6 +27,9 @@ func TestPRMonitor_Comprehensive(t *testing.T) {
 	db.Exec("UPDATE sessions SET state = 'IN_PROGRESS', last_interaction_at = ? WHERE id = ?", nowMilli, sess.Id)
 	db.Exec("INSERT INTO jobs (id, repo, name, created_at, branch, prompt) VALUES (?, ?, ?, ?, ?, ?)",
 		"job-comp", "owner/repo", "test-job", time.Now(), "main", "test prompt")
+	// The cases report new failures in quick succession
+	db.Exec(`INSERT INTO settings (profile_id, check_failing_actions_interval, theme, auto_retry_message, auto_continue_message)
+		VALUES ('default', 0, 'system', '', '')`)
 
 	prUrl := "https://github.com/owner/repo/pull/100"
 	mockFetcher := &MockSessionFetcher{
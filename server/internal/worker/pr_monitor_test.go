@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/go-github/v69/github"
+	gclient "github.com/mcpany/jules/internal/github"
 	"github.com/mcpany/jules/internal/service"
 	pb "github.com/mcpany/jules/proto"
 	"github.com/stretchr/testify/assert"
@@ -25,6 +27,9 @@ type MockGitHubClient struct {
 	Files                  []*github.CommitFile
 	CombinedStatusError    error
 	IssuesSearchResult     *github.IssuesSearchResult
+	Labels                 []string
+	Reviewers              []string
+	Reviews                []*github.PullRequestReview
 }
 
 func (m *MockGitHubClient) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*github.CombinedStatus, error) {
@@ -161,6 +166,20 @@ func (m *MockGitHubClient) MergePullRequest(ctx context.Context, owner, repo str
 	return nil
 }
 
+func (m *MockGitHubClient) ListReviews(ctx context.Context, owner, repo string, number int) ([]*github.PullRequestReview, error) {
+	return m.Reviews, nil
+}
+
+func (m *MockGitHubClient) AddLabelsToIssue(ctx context.Context, owner, repo string, number int, labels []string) error {
+	m.Labels = append(m.Labels, labels...)
+	return nil
+}
+
+func (m *MockGitHubClient) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers []string) error {
+	m.Reviewers = append(m.Reviewers, reviewers...)
+	return nil
+}
+
 func (m *MockGitHubClient) SearchIssues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
 	if m.IssuesSearchResult != nil {
 		return m.IssuesSearchResult, &github.Response{}, nil
@@ -902,7 +921,8 @@ func TestRunCheck_AutoMergesPR(t *testing.T) {
 
 	mockGH := &MockGitHubClient{
 		CombinedStatus: &github.CombinedStatus{
-			State: github.String("success"),
+			State:    github.String("success"),
+			Statuses: []*github.RepoStatus{{Context: github.String("ci"), State: github.String("success")}},
 		},
 		PullRequests: []*github.PullRequest{
 			{
@@ -1032,7 +1052,7 @@ func TestRunCheck_AutoMerge_CommitMessageCleaning(t *testing.T) {
 		Url string `json:"url"`
 	}{Url: "https://g/o/r/pull/10"}}}}}
 	mockGH := &MockGitHubClient{
-		CombinedStatus: &github.CombinedStatus{State: github.String("success")},
+		CombinedStatus: &github.CombinedStatus{State: github.String("success"), Statuses: []*github.RepoStatus{{Context: github.String("ci"), State: github.String("success")}}},
 		PullRequests: []*github.PullRequest{{
 			Number: github.Int(10), HTMLURL: github.String("https://g/o/r/pull/10"), State: github.String("open"),
 			Mergeable: github.Bool(true),
@@ -1177,7 +1197,8 @@ func TestRunCheck_MarksReadyForReview(t *testing.T) {
 
 	mockGH := &MockGitHubClient{
 		CombinedStatus: &github.CombinedStatus{
-			State: github.String("success"),
+			State:    github.String("success"),
+			Statuses: []*github.RepoStatus{{Context: github.String("ci"), State: github.String("success")}},
 		},
 		PullRequests: []*github.PullRequest{
 			{
@@ -1330,3 +1351,207 @@ func TestPRMonitorWorker_RunCheck_MultiKey(t *testing.T) {
 	assert.Contains(t, mockFetcher.ListSourcesCalls, "key-1")
 	assert.Contains(t, mockFetcher.ListSourcesCalls, "key-2")
 }
+
+func TestPRMonitorWorker_EnqueuePR(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	settingsService := &service.SettingsServer{DB: db}
+	mockGH := &MockGitHubClient{
+		PullRequests: []*github.PullRequest{
+			{Number: github.Int(1), HTMLURL: github.String("http://1"), User: &github.User{Login: github.String("u")}, ChangedFiles: github.Int(0)},
+		},
+	}
+	w := NewPRMonitorWorker(db, settingsService, nil, mockGH, nil, "")
+
+	w.EnqueuePR("o/r", 1)
+	w.pool.StopWait()
+	assert.True(t, mockGH.ClosePullRequestCalled, "PR without changes should be closed")
+
+	// Polling only reconciles once webhooks deliver events
+	assert.Equal(t, 300*time.Second, w.getInterval(context.Background()))
+	w.EnableWebhooks()
+	assert.Equal(t, webhookReconcileInterval, w.getInterval(context.Background()))
+}
+
+// blockingGitHubClient holds the first GetPullRequest until released.
+type blockingGitHubClient struct {
+	*MockGitHubClient
+	mu      sync.Mutex
+	calls   int
+	started chan struct{}
+	release chan struct{}
+}
+
+func (m *blockingGitHubClient) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error) {
+	m.mu.Lock()
+	m.calls++
+	first := m.calls == 1
+	m.mu.Unlock()
+	if first {
+		close(m.started)
+		<-m.release
+	}
+	return m.MockGitHubClient.GetPullRequest(ctx, owner, repo, number)
+}
+
+func TestPRMonitorWorker_SerializesEvaluations(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	gh := &blockingGitHubClient{
+		MockGitHubClient: &MockGitHubClient{PullRequests: []*github.PullRequest{
+			{Number: github.Int(1), HTMLURL: github.String("http://1"), State: github.String("closed"), User: &github.User{Login: github.String("u")}},
+		}},
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, gh, nil, "")
+	s := &pb.Settings{CheckFailingActionsEnabled: true}
+	ctx := context.Background()
+
+	done := make(chan struct{})
+	go func() {
+		w.evaluatePR(ctx, "o", "r", 1, s)
+		close(done)
+	}()
+	<-gh.started
+
+	// Evaluations requested meanwhile don't overlap; the running one repeats once afterwards
+	w.evaluatePR(ctx, "o", "r", 1, s)
+	w.evaluatePR(ctx, "o", "r", 1, s)
+	close(gh.release)
+	<-done
+	assert.Equal(t, 2, gh.calls)
+	assert.Empty(t, w.evaluating)
+}
+
+func TestPRMonitorWorker_EnqueueCommit(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	mockGH := &hostRecordingGitHubClient{MockGitHubClient: &MockGitHubClient{
+		PullRequests: []*github.PullRequest{
+			{Number: github.Int(1), HTMLURL: github.String("http://1"), User: &github.User{Login: github.String("u")}, ChangedFiles: github.Int(0)},
+		},
+	}}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, mockGH, nil, "")
+
+	// PRs from forks are found by their head commit
+	w.EnqueueCommit("o/r", "abc")
+	w.pool.StopWait()
+	assert.Equal(t, []string{" repo:o/r is:pr state:open abc"}, mockGH.searches)
+	assert.True(t, mockGH.ClosePullRequestCalled, "PR without changes should be closed")
+}
+
+// hostRecordingGitHubClient records the host and query of every search.
+type hostRecordingGitHubClient struct {
+	*MockGitHubClient
+	searches []string
+}
+
+func (m *hostRecordingGitHubClient) SearchIssues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+	m.searches = append(m.searches, gclient.HostFromContext(ctx)+" "+query)
+	return m.MockGitHubClient.SearchIssues(ctx, query, opts)
+}
+
+type fakeGitHubHosts struct {
+	botLogins map[string]string
+	repos     []string
+}
+
+func (h fakeGitHubHosts) BotLogin(host string) string { return h.botLogins[host] }
+func (h fakeGitHubHosts) Repos() []string             { return h.repos }
+
+func TestPRMonitorWorker_OtherHosts(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	mockGH := &hostRecordingGitHubClient{MockGitHubClient: &MockGitHubClient{}}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, mockGH, nil, "")
+	w.SetHosts(fakeGitHubHosts{
+		botLogins: map[string]string{"": "google-labs-jules", "ghe.example.com": "jules-bot"},
+		repos:     []string{"ghe.example.com/team/service"},
+	})
+
+	assert.NoError(t, w.runCheck(context.Background()))
+	assert.Equal(t, []string{"ghe.example.com repo:team/service is:pr state:open"}, mockGH.searches)
+
+	ghes := gclient.WithHost(context.Background(), "ghe.example.com")
+	assert.True(t, w.isBotLogin(ghes, "jules-bot[bot]"))
+	assert.False(t, w.isBotLogin(ghes, "google-labs-jules[bot]"))
+	assert.True(t, w.isBotLogin(context.Background(), "google-labs-jules[bot]"))
+}
+
+// snapshotGitHubClientMock serves PR snapshots, as the GraphQL client does.
+type snapshotGitHubClientMock struct {
+	*MockGitHubClient
+	snapshots   []*gclient.PullRequestSnapshot
+	snapshotErr error
+	searches    int
+}
+
+func (m *snapshotGitHubClientMock) ListOpenPullRequestSnapshots(ctx context.Context, owner, repo string) ([]*gclient.PullRequestSnapshot, error) {
+	return m.snapshots, m.snapshotErr
+}
+
+func (m *snapshotGitHubClientMock) SearchIssues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+	m.searches++
+	return m.MockGitHubClient.SearchIssues(ctx, query, opts)
+}
+
+func TestPRMonitorWorker_UsesSnapshots(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	_, err := db.Exec("INSERT INTO jobs (id, name, repo, branch, prompt, created_at) VALUES ('j1', 'n', 'o/r', 'main', 'p', '2026-01-01T00:00:00Z')")
+	assert.NoError(t, err)
+
+	// The REST mock knows no PRs: everything the rules read comes from the snapshot
+	mockGH := &snapshotGitHubClientMock{
+		MockGitHubClient: &MockGitHubClient{},
+		snapshots: []*gclient.PullRequestSnapshot{{
+			PullRequest: &github.PullRequest{
+				Number: github.Int(3), HTMLURL: github.String("https://github.com/o/r/pull/3"), State: github.String("open"),
+				User: &github.User{Login: github.String("google-labs-jules")}, ChangedFiles: github.Int(1),
+				Head: &github.PullRequestBranch{SHA: github.String("abc")},
+			},
+			Files:          []*github.CommitFile{{Filename: github.String("server/a_test.go"), Status: github.String("removed")}},
+			CombinedStatus: &github.CombinedStatus{State: github.String("success")},
+			CheckRuns:      []*github.CheckRun{},
+		}},
+	}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, mockGH, nil, "")
+
+	assert.NoError(t, w.runCheck(context.Background()))
+	assert.Equal(t, 0, mockGH.searches)
+	assert.Len(t, mockGH.CreatedComments, 1)
+	assert.Contains(t, mockGH.CreatedComments[0], "Deletion of existing test cases are NOT ALLOWED")
+
+	// Without GraphQL the REST path runs
+	mockGH.snapshotErr = fmt.Errorf("GraphQL unavailable")
+	assert.NoError(t, w.runCheck(context.Background()))
+	assert.Equal(t, 1, mockGH.searches)
+}
+
+func TestSnapshotGitHubClient_StaleAfterWrite(t *testing.T) {
+	rest := &MockGitHubClient{Comments: []*github.IssueComment{{Body: github.String("from REST")}}}
+	snap := &gclient.PullRequestSnapshot{
+		PullRequest: &github.PullRequest{Number: github.Int(1), Head: &github.PullRequestBranch{SHA: github.String("abc")}},
+		Comments:    []*github.IssueComment{{Body: github.String("from snapshot")}},
+	}
+	ctx := withPRSnapshot(context.Background(), rest, snap)
+	w := &PRMonitorWorker{githubClient: rest}
+
+	comments, err := w.gh(ctx).ListComments(ctx, "o", "r", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "from snapshot", comments[0].GetBody())
+
+	// Other PRs and missing data are read from GitHub
+	_, err = w.gh(ctx).ListFiles(ctx, "o", "r", 1, nil)
+	assert.NoError(t, err)
+	_, _, err = w.gh(ctx).GetPullRequest(ctx, "o", "r", 2)
+	assert.Error(t, err)
+
+	assert.NoError(t, w.gh(ctx).CreateComment(ctx, "o", "r", 1, "hi"))
+	comments, err = w.gh(ctx).ListComments(ctx, "o", "r", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "from REST", comments[0].GetBody())
+}
@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/google/go-github/v69/github"
+	gclient "github.com/mcpany/jules/internal/github"
+)
+
+// PRSnapshotClient is implemented by GitHub clients that can fetch all open PRs of a repo
+// with the data the monitor reads in one query (GraphQL). Other clients are used through
+// per-PR REST calls.
+type PRSnapshotClient interface {
+	ListOpenPullRequestSnapshots(ctx context.Context, owner, repo string) ([]*gclient.PullRequestSnapshot, error)
+}
+
+type prSnapshotKey struct{}
+
+// snapshotGitHubClient serves the reads about a PR from its snapshot. Once a write may have
+// changed the PR, reads go to GitHub again.
+type snapshotGitHubClient struct {
+	GitHubClient
+	snap  *gclient.PullRequestSnapshot
+	stale bool
+}
+
+// withPRSnapshot makes the PR monitor rules run with the context read the PR from the snapshot.
+func withPRSnapshot(ctx context.Context, gh GitHubClient, snap *gclient.PullRequestSnapshot) context.Context {
+	return context.WithValue(ctx, prSnapshotKey{}, &snapshotGitHubClient{GitHubClient: gh, snap: snap})
+}
+
+// withoutPRSnapshot makes the PR monitor rules run with the context read the PR from GitHub.
+func withoutPRSnapshot(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(prSnapshotKey{}).(*snapshotGitHubClient); !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, prSnapshotKey{}, nil)
+}
+
+// gh returns the GitHub client for the PR being evaluated with the context.
+func (w *PRMonitorWorker) gh(ctx context.Context) GitHubClient {
+	if c, ok := ctx.Value(prSnapshotKey{}).(*snapshotGitHubClient); ok {
+		return c
+	}
+	return w.githubClient
+}
+
+func (c *snapshotGitHubClient) covers(number int) bool {
+	return !c.stale && c.snap.PullRequest.GetNumber() == number
+}
+
+func (c *snapshotGitHubClient) coversRef(ref string) bool {
+	return !c.stale && ref == c.snap.PullRequest.GetHead().GetSHA()
+}
+
+func (c *snapshotGitHubClient) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error) {
+	if c.covers(number) {
+		return c.snap.PullRequest, &github.Response{}, nil
+	}
+	return c.GitHubClient.GetPullRequest(ctx, owner, repo, number)
+}
+
+func (c *snapshotGitHubClient) ListFiles(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.CommitFile, error) {
+	if c.covers(number) && c.snap.Files != nil {
+		return c.snap.Files, nil
+	}
+	return c.GitHubClient.ListFiles(ctx, owner, repo, number, opts)
+}
+
+func (c *snapshotGitHubClient) ListComments(ctx context.Context, owner, repo string, number int) ([]*github.IssueComment, error) {
+	if c.covers(number) {
+		return c.snap.Comments, nil
+	}
+	return c.GitHubClient.ListComments(ctx, owner, repo, number)
+}
+
+func (c *snapshotGitHubClient) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*github.CombinedStatus, error) {
+	if c.coversRef(ref) && c.snap.CombinedStatus != nil {
+		return c.snap.CombinedStatus, nil
+	}
+	return c.GitHubClient.GetCombinedStatus(ctx, owner, repo, ref)
+}
+
+func (c *snapshotGitHubClient) ListCheckRunsForRef(ctx context.Context, owner, repo, ref string, opts *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error) {
+	if c.coversRef(ref) && c.snap.CheckRuns != nil {
+		return &github.ListCheckRunsResults{Total: github.Int(len(c.snap.CheckRuns)), CheckRuns: c.snap.CheckRuns}, &github.Response{}, nil
+	}
+	return c.GitHubClient.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
+}
+
+func (c *snapshotGitHubClient) CreateComment(ctx context.Context, owner, repo string, number int, body string) error {
+	c.stale = true
+	return c.GitHubClient.CreateComment(ctx, owner, repo, number, body)
+}
+
+func (c *snapshotGitHubClient) ClosePullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
+	c.stale = true
+	return c.GitHubClient.ClosePullRequest(ctx, owner, repo, number)
+}
+
+func (c *snapshotGitHubClient) UpdateBranch(ctx context.Context, owner, repo string, number int) error {
+	c.stale = true
+	return c.GitHubClient.UpdateBranch(ctx, owner, repo, number)
+}
+
+func (c *snapshotGitHubClient) MarkPullRequestReadyForReview(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
+	c.stale = true
+	return c.GitHubClient.MarkPullRequestReadyForReview(ctx, owner, repo, number)
+}
+
+func (c *snapshotGitHubClient) MergePullRequest(ctx context.Context, owner, repo string, number int, message string, method string) error {
+	c.stale = true
+	return c.GitHubClient.MergePullRequest(ctx, owner, repo, number, message, method)
+}
+
+func (c *snapshotGitHubClient) AddLabelsToIssue(ctx context.Context, owner, repo string, number int, labels []string) error {
+	c.stale = true
+	return c.GitHubClient.AddLabelsToIssue(ctx, owner, repo, number, labels)
+}
+
+func (c *snapshotGitHubClient) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers []string) error {
+	c.stale = true
+	return c.GitHubClient.RequestReviewers(ctx, owner, repo, number, reviewers)
+}
@@ -0,0 +1,163 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	gclient "github.com/mcpany/jules/internal/github"
+	"github.com/mcpany/jules/internal/logger"
+	"github.com/mcpany/jules/internal/service"
+)
+
+// Actions recorded as the last action on tracked PRs.
+const (
+	prActionClosedEmpty      = "closed: no changed files"
+	prActionClosedConflicted = "closed: stale merge conflict"
+	prActionTestDeletion     = "commented: test deletion"
+	prActionReady            = "marked ready for review"
+	prActionMerged           = "merged"
+	prActionBranchUpdated    = "updated branch"
+	prActionFailureReported  = "commented: failing checks"
+	prActionCommandMerged    = "merged: /hub merge"
+	prActionCommandClosed    = "closed: /hub close"
+)
+
+// trackedRepo identifies the repo in the pull_requests table, including the host of the context.
+func trackedRepo(ctx context.Context, owner, repo string) string {
+	return gclient.Repo{Host: gclient.HostFromContext(ctx), Owner: owner, Name: repo}.String()
+}
+
+// trackPR records the PR in the pull_requests table. Open PRs are added; closed PRs only
+// update the state of PRs that are already tracked.
+func (w *PRMonitorWorker) trackPR(ctx context.Context, owner, repo string, pr *github.PullRequest) {
+	now := time.Now().Format(time.RFC3339)
+	key := trackedRepo(ctx, owner, repo)
+
+	var err error
+	if pr.GetState() == "closed" {
+		state := service.PRStateClosed
+		if pr.GetMerged() {
+			state = service.PRStateMerged
+		}
+		_, err = w.db.ExecContext(ctx, `
+			UPDATE pull_requests SET state = ?, head_sha = ?, updated_at = ?
+			WHERE repo = ? AND number = ? AND state != ?`,
+			state, pr.GetHead().GetSHA(), now, key, pr.GetNumber(), state)
+	} else {
+		var sessionID sql.NullString
+		if id, err := w.prSession(ctx, pr.GetHTMLURL()); err == nil {
+			sessionID = sql.NullString{String: id, Valid: true}
+		}
+		_, err = w.db.ExecContext(ctx, `
+			INSERT INTO pull_requests (repo, number, url, session_id, head_sha, state, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT(repo, number) DO UPDATE SET
+				url = excluded.url,
+				session_id = COALESCE(excluded.session_id, pull_requests.session_id),
+				head_sha = excluded.head_sha,
+				state = excluded.state,
+				updated_at = CASE WHEN pull_requests.head_sha IS excluded.head_sha AND pull_requests.state = excluded.state
+					THEN pull_requests.updated_at ELSE excluded.updated_at END`,
+			key, pr.GetNumber(), pr.GetHTMLURL(), sessionID, pr.GetHead().GetSHA(), service.PRStateOpen, now, now)
+	}
+	if err != nil {
+		logger.Error("%s [%s]: Failed to track PR %s: %v", w.Name(), w.id, pr.GetHTMLURL(), err)
+	}
+}
+
+// recordPRStatus records the status of the PR's checks. Passing checks reset the failure tracking.
+func (w *PRMonitorWorker) recordPRStatus(ctx context.Context, owner, repo string, number int, status string) {
+	now := time.Now().Format(time.RFC3339)
+	var err error
+	switch status {
+	case "success":
+		_, err = w.db.ExecContext(ctx, `
+			UPDATE pull_requests SET last_status = ?, nag_count = 0, first_failed_at = NULL, last_report = NULL, escalated_at = NULL,
+				updated_at = CASE WHEN last_status IS ? THEN updated_at ELSE ? END
+			WHERE repo = ? AND number = ?`,
+			status, status, now, trackedRepo(ctx, owner, repo), number)
+	case "failure":
+		_, err = w.db.ExecContext(ctx, `
+			UPDATE pull_requests SET last_status = ?, first_failed_at = COALESCE(first_failed_at, ?),
+				updated_at = CASE WHEN last_status IS ? THEN updated_at ELSE ? END
+			WHERE repo = ? AND number = ?`,
+			status, now, status, now, trackedRepo(ctx, owner, repo), number)
+	default:
+		_, err = w.db.ExecContext(ctx, `
+			UPDATE pull_requests SET last_status = ?, updated_at = CASE WHEN last_status IS ? THEN updated_at ELSE ? END
+			WHERE repo = ? AND number = ?`,
+			status, status, now, trackedRepo(ctx, owner, repo), number)
+	}
+	if err != nil {
+		logger.Error("%s [%s]: Failed to record status of PR %d in %s/%s: %v", w.Name(), w.id, number, owner, repo, err)
+	}
+}
+
+// recordPRAction records what the monitor did to the PR. Merging or closing it also
+// records the new state and that the hub caused it.
+func (w *PRMonitorWorker) recordPRAction(ctx context.Context, owner, repo string, number int, action, state string) {
+	now := time.Now().Format(time.RFC3339)
+	query := "UPDATE pull_requests SET last_action = ?, last_action_at = ?, updated_at = ?"
+	args := []interface{}{action, now, now}
+	switch state {
+	case service.PRStateMerged:
+		query += ", state = ?, merged_by_hub = 1"
+		args = append(args, state)
+	case service.PRStateClosed:
+		query += ", state = ?, closed_by_hub = 1"
+		args = append(args, state)
+	}
+	query += " WHERE repo = ? AND number = ?"
+	args = append(args, trackedRepo(ctx, owner, repo), number)
+	if _, err := w.db.ExecContext(ctx, query, args...); err != nil {
+		logger.Error("%s [%s]: Failed to record action on PR %d in %s/%s: %v", w.Name(), w.id, number, owner, repo, err)
+	}
+}
+
+// recordFailureReport records a failure comment posted on the PR.
+func (w *PRMonitorWorker) recordFailureReport(ctx context.Context, owner, repo string, number int, report string) {
+	now := time.Now().Format(time.RFC3339)
+	if _, err := w.db.ExecContext(ctx, `
+		UPDATE pull_requests SET last_report = ?, last_report_at = ?, nag_count = nag_count + 1, last_action = ?, last_action_at = ?, updated_at = ?
+		WHERE repo = ? AND number = ?`,
+		report, now, prActionFailureReported, now, now, trackedRepo(ctx, owner, repo), number); err != nil {
+		logger.Error("%s [%s]: Failed to record failure report on PR %d in %s/%s: %v", w.Name(), w.id, number, owner, repo, err)
+	}
+}
+
+// prFailureTracking is what the monitor tracks about the failure reports of a PR.
+type prFailureTracking struct {
+	lastReport   string
+	lastReportAt time.Time // Zero if no report was posted
+	nagCount     int
+	escalated    bool
+}
+
+// failureTracking returns the failure reports tracked for the PR; untracked PRs have none.
+func (w *PRMonitorWorker) failureTracking(ctx context.Context, owner, repo string, number int) prFailureTracking {
+	var t prFailureTracking
+	var report, reportAt, escalatedAt sql.NullString
+	err := w.db.QueryRowContext(ctx, "SELECT last_report, last_report_at, nag_count, escalated_at FROM pull_requests WHERE repo = ? AND number = ?",
+		trackedRepo(ctx, owner, repo), number).Scan(&report, &reportAt, &t.nagCount, &escalatedAt)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Error("%s [%s]: Failed to read tracking of PR %d in %s/%s: %v", w.Name(), w.id, number, owner, repo, err)
+	}
+	t.lastReport, t.escalated = report.String, escalatedAt.Valid
+	if reportAt.Valid {
+		t.lastReportAt, _ = time.Parse(time.RFC3339, reportAt.String)
+	}
+	return t
+}
+
+// recordEscalation records that the PR was escalated instead of getting more failure reports.
+func (w *PRMonitorWorker) recordEscalation(ctx context.Context, owner, repo string, number int, action string) {
+	now := time.Now().Format(time.RFC3339)
+	if _, err := w.db.ExecContext(ctx, `
+		UPDATE pull_requests SET escalated_at = ?, last_action = ?, last_action_at = ?, updated_at = ?
+		WHERE repo = ? AND number = ?`,
+		now, action, now, now, trackedRepo(ctx, owner, repo), number); err != nil {
+		logger.Error("%s [%s]: Failed to record escalation of PR %d in %s/%s: %v", w.Name(), w.id, number, owner, repo, err)
+	}
+}
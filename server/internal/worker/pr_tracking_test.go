@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+	gclient "github.com/mcpany/jules/internal/github"
+	"github.com/mcpany/jules/internal/service"
+	pb "github.com/mcpany/jules/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPRMonitorWorker_TracksPullRequests(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	_, err := db.Exec("INSERT INTO jobs (id, name, repo, branch, prompt, created_at) VALUES ('j1', 'n', 'o/r', 'main', 'p', '2026-01-01T00:00:00Z')")
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO sessions (id, name, pr_url, create_time) VALUES ('s1', 'n', 'https://github.com/o/r/pull/4', '2026-01-01T00:00:00Z')")
+	assert.NoError(t, err)
+
+	pr := &github.PullRequest{
+		Number: github.Int(4), HTMLURL: github.String("https://github.com/o/r/pull/4"), State: github.String("open"),
+		User: &github.User{Login: github.String("google-labs-jules")}, Mergeable: github.Bool(true),
+		Head: &github.PullRequestBranch{SHA: github.String("sha1")},
+	}
+	mockGH := &MockGitHubClient{
+		PullRequests:   []*github.PullRequest{pr},
+		CombinedStatus: &github.CombinedStatus{State: github.String("failure")},
+		CheckRuns: &github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+			{Name: github.String("lint"), Status: github.String("completed"), Conclusion: github.String("failure")},
+		}},
+	}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, mockGH, nil, "")
+	ctx := context.Background()
+	prs := &service.PullRequestServer{DB: db}
+
+	assert.NoError(t, w.runCheck(ctx))
+	assert.Len(t, mockGH.CreatedComments, 1)
+	resp, err := prs.ListTrackedPullRequests(ctx, &pb.ListTrackedPullRequestsRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.PullRequests, 1) {
+		p := resp.PullRequests[0]
+		assert.Equal(t, "o/r", p.Repo)
+		assert.Equal(t, "s1", p.SessionId)
+		assert.Equal(t, "sha1", p.HeadSha)
+		assert.Equal(t, service.PRStateOpen, p.State)
+		assert.Equal(t, "failure", p.LastStatus)
+		assert.Equal(t, int32(1), p.NagCount)
+		assert.NotEmpty(t, p.FirstFailedAt)
+		assert.Equal(t, prActionFailureReported, p.LastAction)
+	}
+
+	// The same report is not posted again, even when the comments don't show it
+	assert.NoError(t, w.runCheck(ctx))
+	assert.Len(t, mockGH.CreatedComments, 1)
+
+	// Passing checks reset the failure tracking, then the PR is merged
+	mockGH.CombinedStatus = &github.CombinedStatus{State: github.String("success"), Statuses: []*github.RepoStatus{{Context: github.String("ci"), State: github.String("success")}}}
+	mockGH.CheckRuns = nil
+	assert.NoError(t, w.runCheck(ctx))
+	var nagCount int
+	var firstFailedAt, lastReport sql.NullString
+	assert.NoError(t, db.QueryRow("SELECT nag_count, first_failed_at, last_report FROM pull_requests WHERE repo = 'o/r' AND number = 4").Scan(&nagCount, &firstFailedAt, &lastReport))
+	assert.Equal(t, 0, nagCount)
+	assert.False(t, firstFailedAt.Valid)
+	assert.False(t, lastReport.Valid)
+
+	_, err = db.Exec("INSERT INTO settings (profile_id, auto_merge_enabled, theme, auto_retry_message, auto_continue_message) VALUES ('default', 1, 'system', '', '')")
+	assert.NoError(t, err)
+	assert.NoError(t, w.runCheck(ctx))
+	resp, err = prs.ListTrackedPullRequests(ctx, &pb.ListTrackedPullRequestsRequest{State: service.PRStateMerged})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.PullRequests, 1) {
+		assert.True(t, resp.PullRequests[0].MergedByHub)
+		assert.Equal(t, prActionMerged, resp.PullRequests[0].LastAction)
+	}
+}
+
+func TestPRMonitorWorker_TracksClosedPullRequests(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	pr := &github.PullRequest{
+		Number: github.Int(9), HTMLURL: github.String("https://ghe.example.com/o/r/pull/9"), State: github.String("open"),
+		User: &github.User{Login: github.String("someone")}, Head: &github.PullRequestBranch{SHA: github.String("sha1")},
+	}
+	mockGH := &MockGitHubClient{PullRequests: []*github.PullRequest{pr}, CombinedStatus: &github.CombinedStatus{State: github.String("pending")}}
+	w := NewPRMonitorWorker(db, &service.SettingsServer{DB: db}, nil, mockGH, nil, "")
+	ctx := gclient.WithHost(context.Background(), "ghe.example.com")
+	s := &pb.Settings{}
+
+	w.evaluatePR(ctx, "o", "r", 9, s)
+	pr.State, pr.Merged = github.String("closed"), github.Bool(true)
+	w.evaluatePR(ctx, "o", "r", 9, s)
+
+	var state string
+	var mergedByHub bool
+	assert.NoError(t, db.QueryRow("SELECT state, merged_by_hub FROM pull_requests WHERE repo = 'ghe.example.com/o/r' AND number = 9").Scan(&state, &mergedByHub))
+	assert.Equal(t, service.PRStateMerged, state)
+	assert.False(t, mergedByHub)
+
+	// Closed PRs that were never tracked are not added
+	w.evaluatePR(context.Background(), "o", "r", 9, s)
+	var n int
+	assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM pull_requests").Scan(&n))
+	assert.Equal(t, 1, n)
+}
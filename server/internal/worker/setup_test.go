@@ -60,7 +60,24 @@ func createTables(t *testing.T, conn *sql.DB) {
             auto_merge_method TEXT DEFAULT 'squash',
             auto_merge_message TEXT DEFAULT '',
             auto_close_on_conflict_message TEXT DEFAULT '',
-            close_pr_on_conflict_enabled BOOLEAN DEFAULT 0
+            close_pr_on_conflict_enabled BOOLEAN DEFAULT 0,
+            issue_automation_enabled BOOLEAN DEFAULT 0,
+            issue_automation_label TEXT DEFAULT 'jules',
+            issue_automation_repos TEXT DEFAULT '',
+            chat_ops_enabled BOOLEAN DEFAULT 0,
+            check_failing_actions_escalation TEXT DEFAULT 'label',
+            check_failing_actions_escalation_target TEXT DEFAULT '',
+            flaky_check_max_reruns INTEGER DEFAULT 1,
+            informational_checks TEXT DEFAULT '',
+            auto_merge_scope TEXT DEFAULT 'all',
+            auto_merge_label TEXT DEFAULT 'automerge',
+            auto_merge_required_approvals INTEGER DEFAULT 0,
+            auto_merge_block_changes_requested BOOLEAN DEFAULT 1,
+            auto_merge_max_additions INTEGER DEFAULT 0,
+            auto_merge_max_deletions INTEGER DEFAULT 0,
+            auto_merge_max_files INTEGER DEFAULT 0,
+            auto_merge_protected_paths TEXT DEFAULT '',
+            auto_merge_require_base_green BOOLEAN DEFAULT 0
         );`,
 		`CREATE TABLE profiles (
             id TEXT PRIMARY KEY,
@@ -83,7 +100,11 @@ func createTables(t *testing.T, conn *sql.DB) {
             require_plan_approval BOOLEAN DEFAULT 0,
             cron_job_id TEXT,
             profile_id TEXT NOT NULL DEFAULT 'default',
-            chat_enabled BOOLEAN DEFAULT 0
+            chat_enabled BOOLEAN DEFAULT 0,
+            parent_job_id TEXT,
+            matrix TEXT,
+            priority INTEGER NOT NULL DEFAULT 0,
+            queue_position INTEGER
         );`,
 		`CREATE TABLE cron_jobs (
             id TEXT PRIMARY KEY,
@@ -100,7 +121,13 @@ func createTables(t *testing.T, conn *sql.DB) {
             automation_mode TEXT,
             require_plan_approval BOOLEAN,
             session_count INTEGER DEFAULT 1,
-            profile_id TEXT NOT NULL DEFAULT 'default'
+            profile_id TEXT NOT NULL DEFAULT 'default',
+            matrix TEXT,
+            priority INTEGER NOT NULL DEFAULT 0,
+            time_zone TEXT,
+            catch_up TEXT,
+            jitter_seconds INTEGER NOT NULL DEFAULT 0,
+            skip_if_running BOOLEAN NOT NULL DEFAULT 0
         );`,
 		`CREATE TABLE sessions (
             id TEXT PRIMARY KEY,
@@ -118,7 +145,171 @@ func createTables(t *testing.T, conn *sql.DB) {
             last_error TEXT,
             last_interaction_at INTEGER,
             outputs TEXT,
+            pr_url TEXT,
+            is_pr_merged BOOLEAN DEFAULT 0,
             profile_id TEXT NOT NULL DEFAULT 'default'
+        );`,
+		`CREATE TABLE job_sessions (
+            job_id TEXT NOT NULL,
+            slot_index INTEGER NOT NULL,
+            session_id TEXT,
+            status TEXT NOT NULL DEFAULT 'PENDING',
+            attempts INTEGER NOT NULL DEFAULT 0,
+            last_error TEXT,
+            updated_at TEXT NOT NULL,
+            target_index INTEGER NOT NULL DEFAULT 0,
+            PRIMARY KEY (job_id, slot_index)
+        );`,
+		`CREATE TABLE pipelines (
+            id TEXT PRIMARY KEY,
+            name TEXT NOT NULL,
+            steps TEXT NOT NULL,
+            sequential BOOLEAN NOT NULL DEFAULT 0,
+            profile_id TEXT NOT NULL DEFAULT 'default',
+            created_at TEXT NOT NULL,
+            updated_at TEXT
+        );`,
+		`CREATE TABLE pipeline_runs (
+            id TEXT PRIMARY KEY,
+            pipeline_id TEXT NOT NULL,
+            pipeline_name TEXT NOT NULL,
+            steps TEXT NOT NULL,
+            sequential BOOLEAN NOT NULL DEFAULT 0,
+            profile_id TEXT NOT NULL DEFAULT 'default',
+            status TEXT NOT NULL,
+            created_at TEXT NOT NULL,
+            updated_at TEXT NOT NULL,
+            finished_at TEXT
+        );`,
+		`CREATE TABLE pipeline_run_steps (
+            run_id TEXT NOT NULL,
+            step_id TEXT NOT NULL,
+            status TEXT NOT NULL,
+            job_id TEXT,
+            message TEXT,
+            started_at TEXT,
+            finished_at TEXT,
+            PRIMARY KEY (run_id, step_id)
+        );`,
+		`CREATE TABLE repo_queues (
+            repo TEXT PRIMARY KEY,
+            max_active INTEGER NOT NULL,
+            updated_at TEXT NOT NULL
+        );`,
+		`CREATE TABLE cron_runs (
+            id TEXT PRIMARY KEY,
+            cron_job_id TEXT NOT NULL,
+            trigger TEXT NOT NULL,
+            scheduled_at TEXT NOT NULL,
+            triggered_at TEXT NOT NULL,
+            status TEXT NOT NULL,
+            message TEXT,
+            job_id TEXT
+        );`,
+		`CREATE TABLE event_triggers (
+            id TEXT PRIMARY KEY,
+            name TEXT NOT NULL,
+            event TEXT NOT NULL,
+            repo TEXT NOT NULL,
+            branch TEXT NOT NULL,
+            label TEXT,
+            prompt TEXT NOT NULL,
+            auto_approval BOOLEAN NOT NULL DEFAULT 0,
+            automation_mode TEXT,
+            require_plan_approval BOOLEAN,
+            session_count INTEGER DEFAULT 1,
+            profile_id TEXT NOT NULL DEFAULT 'default',
+            enabled BOOLEAN NOT NULL DEFAULT 1,
+            priority INTEGER NOT NULL DEFAULT 0,
+            created_at TEXT NOT NULL,
+            updated_at TEXT,
+            last_event_at TEXT
+        );`,
+		`CREATE TABLE triggered_events (
+            trigger_id TEXT NOT NULL,
+            event_key TEXT NOT NULL,
+            summary TEXT NOT NULL,
+            url TEXT,
+            job_id TEXT,
+            created_at TEXT NOT NULL,
+            PRIMARY KEY (trigger_id, event_key)
+        );`,
+		`CREATE TABLE issue_jobs (
+            repo TEXT NOT NULL,
+            issue_number INTEGER NOT NULL,
+            title TEXT NOT NULL,
+            job_id TEXT NOT NULL,
+            profile_id TEXT NOT NULL DEFAULT 'default',
+            commented_at TEXT,
+            linked_pr_url TEXT,
+            created_at TEXT NOT NULL,
+            PRIMARY KEY (repo, issue_number)
+        );`,
+		`CREATE TABLE repo_prompts (
+            repo TEXT PRIMARY KEY,
+            prompt TEXT NOT NULL,
+            profile_id TEXT NOT NULL DEFAULT 'default'
+        );`,
+		`CREATE TABLE pr_comment_commands (
+            comment_id INTEGER PRIMARY KEY,
+            pr_url TEXT NOT NULL,
+            author TEXT NOT NULL,
+            command TEXT NOT NULL,
+            status TEXT NOT NULL,
+            message TEXT,
+            created_at TEXT NOT NULL
+        );`,
+		`CREATE TABLE pull_requests (
+            repo TEXT NOT NULL,
+            number INTEGER NOT NULL,
+            url TEXT NOT NULL,
+            session_id TEXT,
+            head_sha TEXT,
+            state TEXT NOT NULL DEFAULT 'open',
+            last_status TEXT,
+            nag_count INTEGER NOT NULL DEFAULT 0,
+            first_failed_at TEXT,
+            last_report TEXT,
+            last_report_at TEXT,
+            escalated_at TEXT,
+            last_action TEXT,
+            last_action_at TEXT,
+            merged_by_hub BOOLEAN NOT NULL DEFAULT 0,
+            closed_by_hub BOOLEAN NOT NULL DEFAULT 0,
+            created_at TEXT NOT NULL,
+            updated_at TEXT NOT NULL,
+            PRIMARY KEY (repo, number)
+        );`,
+		`CREATE TABLE check_reruns (
+            repo TEXT NOT NULL,
+            head_sha TEXT NOT NULL,
+            check_name TEXT NOT NULL,
+            attempts INTEGER NOT NULL DEFAULT 0,
+            last_run_id INTEGER,
+            outcome TEXT,
+            created_at TEXT NOT NULL,
+            updated_at TEXT NOT NULL,
+            PRIMARY KEY (repo, head_sha, check_name)
+        );`,
+		`CREATE TABLE check_flakes (
+            repo TEXT NOT NULL,
+            check_name TEXT NOT NULL,
+            failures INTEGER NOT NULL DEFAULT 0,
+            reruns INTEGER NOT NULL DEFAULT 0,
+            flakes INTEGER NOT NULL DEFAULT 0,
+            ignored BOOLEAN NOT NULL DEFAULT 0,
+            last_failed_at TEXT,
+            last_flake_at TEXT,
+            PRIMARY KEY (repo, check_name)
+        );`,
+		`CREATE TABLE merge_decisions (
+            id TEXT PRIMARY KEY,
+            repo TEXT NOT NULL,
+            number INTEGER NOT NULL,
+            head_sha TEXT,
+            merged BOOLEAN NOT NULL DEFAULT 0,
+            reason TEXT NOT NULL,
+            created_at TEXT NOT NULL
         );`,
 	}
 
@@ -0,0 +1,5 @@
+UPDATE `jobs` SET `status` = 'PROCESSING' WHERE `status` = 'Running';
+--> statement-breakpoint
+UPDATE `jobs` SET `status` = 'COMPLETED' WHERE `status` = 'Succeeded';
+--> statement-breakpoint
+UPDATE `jobs` SET `status` = 'FAILED' WHERE `status` = 'Failed';
@@ -0,0 +1,10 @@
+CREATE TABLE `job_sessions` (
+	`job_id` text NOT NULL,
+	`slot_index` integer NOT NULL,
+	`session_id` text,
+	`status` text DEFAULT 'PENDING' NOT NULL,
+	`attempts` integer DEFAULT 0 NOT NULL,
+	`last_error` text,
+	`updated_at` text NOT NULL,
+	PRIMARY KEY(`job_id`, `slot_index`)
+);
@@ -0,0 +1 @@
+ALTER TABLE `jobs` ADD `parent_job_id` text;
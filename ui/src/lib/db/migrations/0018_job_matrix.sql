@@ -0,0 +1,3 @@
+ALTER TABLE `jobs` ADD `matrix` text;--> statement-breakpoint
+ALTER TABLE `cron_jobs` ADD `matrix` text;--> statement-breakpoint
+ALTER TABLE `job_sessions` ADD `target_index` integer DEFAULT 0 NOT NULL;
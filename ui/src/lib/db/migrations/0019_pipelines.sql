@@ -0,0 +1,35 @@
+CREATE TABLE `pipelines` (
+	`id` text PRIMARY KEY NOT NULL,
+	`name` text NOT NULL,
+	`steps` text NOT NULL,
+	`sequential` integer DEFAULT false NOT NULL,
+	`profile_id` text DEFAULT 'default' NOT NULL,
+	`created_at` text NOT NULL,
+	`updated_at` text,
+	FOREIGN KEY (`profile_id`) REFERENCES `profiles`(`id`) ON UPDATE no action ON DELETE no action
+);
+--> statement-breakpoint
+CREATE TABLE `pipeline_runs` (
+	`id` text PRIMARY KEY NOT NULL,
+	`pipeline_id` text NOT NULL,
+	`pipeline_name` text NOT NULL,
+	`steps` text NOT NULL,
+	`sequential` integer DEFAULT false NOT NULL,
+	`profile_id` text DEFAULT 'default' NOT NULL,
+	`status` text NOT NULL,
+	`created_at` text NOT NULL,
+	`updated_at` text NOT NULL,
+	`finished_at` text
+);
+--> statement-breakpoint
+CREATE INDEX `pipeline_runs_pipeline_id_created_at_idx` ON `pipeline_runs` (`pipeline_id`,`created_at`);--> statement-breakpoint
+CREATE TABLE `pipeline_run_steps` (
+	`run_id` text NOT NULL,
+	`step_id` text NOT NULL,
+	`status` text NOT NULL,
+	`job_id` text,
+	`message` text,
+	`started_at` text,
+	`finished_at` text,
+	PRIMARY KEY(`run_id`, `step_id`)
+);
@@ -0,0 +1,7 @@
+ALTER TABLE `jobs` ADD `priority` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `jobs` ADD `queue_position` integer;--> statement-breakpoint
+CREATE TABLE `repo_queues` (
+	`repo` text PRIMARY KEY NOT NULL,
+	`max_active` integer NOT NULL,
+	`updated_at` text NOT NULL
+);
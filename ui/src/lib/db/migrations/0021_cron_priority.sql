@@ -0,0 +1 @@
+ALTER TABLE `cron_jobs` ADD `priority` integer DEFAULT 0 NOT NULL;
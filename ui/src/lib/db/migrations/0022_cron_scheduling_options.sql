@@ -0,0 +1,4 @@
+ALTER TABLE `cron_jobs` ADD `time_zone` text;--> statement-breakpoint
+ALTER TABLE `cron_jobs` ADD `catch_up` text;--> statement-breakpoint
+ALTER TABLE `cron_jobs` ADD `jitter_seconds` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `cron_jobs` ADD `skip_if_running` integer DEFAULT false NOT NULL;
@@ -0,0 +1,12 @@
+CREATE TABLE `cron_runs` (
+	`id` text PRIMARY KEY NOT NULL,
+	`cron_job_id` text NOT NULL,
+	`trigger` text NOT NULL,
+	`scheduled_at` text NOT NULL,
+	`triggered_at` text NOT NULL,
+	`status` text NOT NULL,
+	`message` text,
+	`job_id` text
+);
+--> statement-breakpoint
+CREATE INDEX `cron_runs_cron_job_id_triggered_at_idx` ON `cron_runs` (`cron_job_id`,`triggered_at`);
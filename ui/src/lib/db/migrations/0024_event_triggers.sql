@@ -0,0 +1,32 @@
+CREATE TABLE `event_triggers` (
+	`id` text PRIMARY KEY NOT NULL,
+	`name` text NOT NULL,
+	`event` text NOT NULL,
+	`repo` text NOT NULL,
+	`branch` text NOT NULL,
+	`label` text,
+	`prompt` text NOT NULL,
+	`auto_approval` integer DEFAULT false NOT NULL,
+	`automation_mode` text,
+	`require_plan_approval` integer,
+	`session_count` integer DEFAULT 1,
+	`profile_id` text DEFAULT 'default' NOT NULL,
+	`enabled` integer DEFAULT true NOT NULL,
+	`priority` integer DEFAULT 0 NOT NULL,
+	`created_at` text NOT NULL,
+	`updated_at` text,
+	`last_event_at` text,
+	FOREIGN KEY (`profile_id`) REFERENCES `profiles`(`id`) ON UPDATE no action ON DELETE no action
+);
+--> statement-breakpoint
+CREATE TABLE `triggered_events` (
+	`trigger_id` text NOT NULL,
+	`event_key` text NOT NULL,
+	`summary` text NOT NULL,
+	`url` text,
+	`job_id` text,
+	`created_at` text NOT NULL,
+	PRIMARY KEY(`trigger_id`, `event_key`)
+);
+--> statement-breakpoint
+CREATE INDEX `triggered_events_trigger_id_created_at_idx` ON `triggered_events` (`trigger_id`,`created_at`);
@@ -0,0 +1,15 @@
+ALTER TABLE `settings` ADD `issue_automation_enabled` integer DEFAULT false NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `issue_automation_label` text DEFAULT 'jules' NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `issue_automation_repos` text DEFAULT '' NOT NULL;--> statement-breakpoint
+CREATE TABLE `issue_jobs` (
+	`repo` text NOT NULL,
+	`issue_number` integer NOT NULL,
+	`title` text NOT NULL,
+	`job_id` text NOT NULL,
+	`profile_id` text DEFAULT 'default' NOT NULL,
+	`commented_at` text,
+	`linked_pr_url` text,
+	`created_at` text NOT NULL,
+	PRIMARY KEY(`repo`, `issue_number`),
+	FOREIGN KEY (`profile_id`) REFERENCES `profiles`(`id`) ON UPDATE no action ON DELETE no action
+);
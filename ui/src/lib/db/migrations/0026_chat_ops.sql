@@ -0,0 +1,10 @@
+ALTER TABLE `settings` ADD `chat_ops_enabled` integer DEFAULT false NOT NULL;--> statement-breakpoint
+CREATE TABLE `pr_comment_commands` (
+	`comment_id` integer PRIMARY KEY NOT NULL,
+	`pr_url` text NOT NULL,
+	`author` text NOT NULL,
+	`command` text NOT NULL,
+	`status` text NOT NULL,
+	`message` text,
+	`created_at` text NOT NULL
+);
@@ -0,0 +1,21 @@
+CREATE TABLE `pull_requests` (
+	`repo` text NOT NULL,
+	`number` integer NOT NULL,
+	`url` text NOT NULL,
+	`session_id` text,
+	`head_sha` text,
+	`state` text DEFAULT 'open' NOT NULL,
+	`last_status` text,
+	`nag_count` integer DEFAULT 0 NOT NULL,
+	`first_failed_at` text,
+	`last_report` text,
+	`last_action` text,
+	`last_action_at` text,
+	`merged_by_hub` integer DEFAULT false NOT NULL,
+	`closed_by_hub` integer DEFAULT false NOT NULL,
+	`created_at` text NOT NULL,
+	`updated_at` text NOT NULL,
+	PRIMARY KEY(`repo`, `number`)
+);
+--> statement-breakpoint
+CREATE INDEX `pull_requests_updated_at_idx` ON `pull_requests` (`updated_at`);
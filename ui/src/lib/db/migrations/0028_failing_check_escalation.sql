@@ -0,0 +1,4 @@
+ALTER TABLE `settings` ADD `check_failing_actions_escalation` text DEFAULT 'label' NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `check_failing_actions_escalation_target` text DEFAULT '' NOT NULL;--> statement-breakpoint
+ALTER TABLE `pull_requests` ADD `last_report_at` text;--> statement-breakpoint
+ALTER TABLE `pull_requests` ADD `escalated_at` text;
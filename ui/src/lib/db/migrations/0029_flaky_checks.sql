@@ -0,0 +1,24 @@
+ALTER TABLE `settings` ADD `flaky_check_max_reruns` integer DEFAULT 1 NOT NULL;--> statement-breakpoint
+CREATE TABLE `check_reruns` (
+	`repo` text NOT NULL,
+	`head_sha` text NOT NULL,
+	`check_name` text NOT NULL,
+	`attempts` integer DEFAULT 0 NOT NULL,
+	`last_run_id` integer,
+	`outcome` text,
+	`created_at` text NOT NULL,
+	`updated_at` text NOT NULL,
+	PRIMARY KEY(`repo`, `head_sha`, `check_name`)
+);
+--> statement-breakpoint
+CREATE TABLE `check_flakes` (
+	`repo` text NOT NULL,
+	`check_name` text NOT NULL,
+	`failures` integer DEFAULT 0 NOT NULL,
+	`reruns` integer DEFAULT 0 NOT NULL,
+	`flakes` integer DEFAULT 0 NOT NULL,
+	`ignored` integer DEFAULT false NOT NULL,
+	`last_failed_at` text,
+	`last_flake_at` text,
+	PRIMARY KEY(`repo`, `check_name`)
+);
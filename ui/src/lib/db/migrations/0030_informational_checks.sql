@@ -0,0 +1 @@
+ALTER TABLE `settings` ADD `informational_checks` text DEFAULT '' NOT NULL;
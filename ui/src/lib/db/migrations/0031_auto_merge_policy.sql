@@ -0,0 +1,20 @@
+ALTER TABLE `settings` ADD `auto_merge_scope` text DEFAULT 'all' NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `auto_merge_label` text DEFAULT 'automerge' NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `auto_merge_required_approvals` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `auto_merge_block_changes_requested` integer DEFAULT true NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `auto_merge_max_additions` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `auto_merge_max_deletions` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `auto_merge_max_files` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `auto_merge_protected_paths` text DEFAULT '' NOT NULL;--> statement-breakpoint
+ALTER TABLE `settings` ADD `auto_merge_require_base_green` integer DEFAULT false NOT NULL;--> statement-breakpoint
+CREATE TABLE `merge_decisions` (
+	`id` text PRIMARY KEY NOT NULL,
+	`repo` text NOT NULL,
+	`number` integer NOT NULL,
+	`head_sha` text,
+	`merged` integer DEFAULT false NOT NULL,
+	`reason` text NOT NULL,
+	`created_at` text NOT NULL
+);
+--> statement-breakpoint
+CREATE INDEX `merge_decisions_repo_number_created_at_idx` ON `merge_decisions` (`repo`,`number`,`created_at`);
@@ -1,5 +1,5 @@
 import { integer, sqliteTable, text, primaryKey, index } from 'drizzle-orm/sqlite-core';
-import type { SourceContext, SessionOutput, AutomationMode } from '@/lib/types';
+import type { SourceContext, SessionOutput, AutomationMode, JobMatrix } from '@/lib/types';
 
 export const profiles = sqliteTable('profiles', {
   id: text('id').primaryKey(),
@@ -18,18 +18,43 @@ export const jobs = sqliteTable('jobs', {
   background: integer('background', { mode: 'boolean' }).notNull().default(false),
   prompt: text('prompt'),
   sessionCount: integer('session_count'),
-  status: text('status'), // 'PENDING', 'PROCESSING', 'COMPLETED'
+  status: text('status'), // 'QUEUED', 'PENDING', 'PROCESSING', 'COMPLETED', 'PARTIALLY_SUCCEEDED', 'FAILED', 'CANCELLED'
   automationMode: text('automation_mode').$type<AutomationMode>(),
   requirePlanApproval: integer('require_plan_approval', { mode: 'boolean' }),
   cronJobId: text('cron_job_id'),
   profileId: text('profile_id').references(() => profiles.id).notNull().default('default'),
   chatEnabled: integer('chat_enabled', { mode: 'boolean' }).notNull().default(false),
+  parentJobId: text('parent_job_id'), // Set when the job is a rerun of another job
+  matrix: text('matrix', { mode: 'json' }).$type<JobMatrix>(), // Repo/branch targets of a fan-out job
+  priority: integer('priority').notNull().default(0), // Higher runs first
+  queuePosition: integer('queue_position'), // Order within the repo queue among jobs of the same priority
 }, (table) => ({
   // Optimization: Add composite index on profileId and createdAt to speed up job listing queries.
   // This helps when filtering jobs by profile and sorting by creation time.
   profileIdCreatedAtIdx: index('jobs_profile_id_created_at_idx').on(table.profileId, table.createdAt),
 }));
 
+// Per-repo limit of active sessions/PRs for queued jobs. Repos without a row use the default limit.
+export const repoQueues = sqliteTable('repo_queues', {
+  repo: text('repo').primaryKey(),
+  maxActive: integer('max_active').notNull(),
+  updatedAt: text('updated_at').notNull(),
+});
+
+// Per-session creation status of a job. One row per requested session ("slot").
+export const jobSessions = sqliteTable('job_sessions', {
+  jobId: text('job_id').notNull(),
+  slotIndex: integer('slot_index').notNull(),
+  sessionId: text('session_id'),
+  status: text('status').notNull().default('PENDING'), // 'PENDING', 'CREATING', 'CREATED', 'FAILED'
+  attempts: integer('attempts').notNull().default(0),
+  lastError: text('last_error'),
+  updatedAt: text('updated_at').notNull(),
+  targetIndex: integer('target_index').notNull().default(0), // Index into the job's matrix targets
+}, (table) => ({
+  pk: primaryKey({ columns: [table.jobId, table.slotIndex] }),
+}));
+
 export const cronJobs = sqliteTable('cron_jobs', {
   id: text('id').primaryKey(),
   name: text('name').notNull(),
@@ -46,11 +71,195 @@ export const cronJobs = sqliteTable('cron_jobs', {
   requirePlanApproval: integer('require_plan_approval', { mode: 'boolean' }),
   sessionCount: integer('session_count').default(1),
   profileId: text('profile_id').references(() => profiles.id).notNull().default('default'),
+  matrix: text('matrix', { mode: 'json' }).$type<JobMatrix>(),
+  priority: integer('priority').notNull().default(0),
+  timeZone: text('time_zone'),
+  catchUp: text('catch_up'), // 'SKIP', 'ONCE' or 'ALL'
+  jitterSeconds: integer('jitter_seconds').notNull().default(0),
+  skipIfRunning: integer('skip_if_running', { mode: 'boolean' }).notNull().default(false),
 }, (table) => ({
   // Optimization: Add composite index on profileId and createdAt for cron jobs listing.
   profileIdCreatedAtIdx: index('cron_jobs_profile_id_created_at_idx').on(table.profileId, table.createdAt),
 }));
 
+// One row per trigger of a cron job. PRs and the job outcome are read from the created job.
+export const cronRuns = sqliteTable('cron_runs', {
+  id: text('id').primaryKey(),
+  cronJobId: text('cron_job_id').notNull(),
+  trigger: text('trigger').notNull(), // 'SCHEDULED', 'MANUAL'
+  scheduledAt: text('scheduled_at').notNull(),
+  triggeredAt: text('triggered_at').notNull(),
+  status: text('status').notNull(), // 'STARTED', 'SKIPPED', 'FAILED'
+  message: text('message'),
+  jobId: text('job_id'),
+}, (table) => ({
+  cronJobIdTriggeredAtIdx: index('cron_runs_cron_job_id_triggered_at_idx').on(table.cronJobId, table.triggeredAt),
+}));
+
+// Event triggers start a job when a GitHub event happens on their repo.
+export const eventTriggers = sqliteTable('event_triggers', {
+  id: text('id').primaryKey(),
+  name: text('name').notNull(),
+  event: text('event').notNull(), // 'ISSUE_LABELED', 'CI_FAILED', 'RELEASE', 'DEPENDABOT_PR'
+  repo: text('repo').notNull(),
+  branch: text('branch').notNull(),
+  label: text('label'),
+  prompt: text('prompt').notNull(),
+  autoApproval: integer('auto_approval', { mode: 'boolean' }).notNull().default(false),
+  automationMode: text('automation_mode').$type<AutomationMode>(),
+  requirePlanApproval: integer('require_plan_approval', { mode: 'boolean' }),
+  sessionCount: integer('session_count').default(1),
+  profileId: text('profile_id').references(() => profiles.id).notNull().default('default'),
+  enabled: integer('enabled', { mode: 'boolean' }).notNull().default(true),
+  priority: integer('priority').notNull().default(0),
+  createdAt: text('created_at').notNull(),
+  updatedAt: text('updated_at'),
+  lastEventAt: text('last_event_at'),
+});
+
+// Events handled by a trigger, so each one starts a single job.
+export const triggeredEvents = sqliteTable('triggered_events', {
+  triggerId: text('trigger_id').notNull(),
+  eventKey: text('event_key').notNull(),
+  summary: text('summary').notNull(),
+  url: text('url'),
+  jobId: text('job_id'),
+  createdAt: text('created_at').notNull(),
+}, (table) => ({
+  pk: primaryKey({ columns: [table.triggerId, table.eventKey] }),
+  triggerIdCreatedAtIdx: index('triggered_events_trigger_id_created_at_idx').on(table.triggerId, table.createdAt),
+}));
+
+// GitHub issues picked up by the issue automation, with the job working on each.
+export const issueJobs = sqliteTable('issue_jobs', {
+  repo: text('repo').notNull(),
+  issueNumber: integer('issue_number').notNull(),
+  title: text('title').notNull(),
+  jobId: text('job_id').notNull(),
+  profileId: text('profile_id').references(() => profiles.id).notNull().default('default'),
+  commentedAt: text('commented_at'), // When the session link was posted on the issue
+  linkedPrUrl: text('linked_pr_url'), // PR checked to reference the issue
+  createdAt: text('created_at').notNull(),
+}, (table) => ({
+  pk: primaryKey({ columns: [table.repo, table.issueNumber] }),
+}));
+
+// Slash commands found in PR comments, so each comment runs at most once.
+export const prCommentCommands = sqliteTable('pr_comment_commands', {
+  commentId: integer('comment_id').primaryKey(),
+  prUrl: text('pr_url').notNull(),
+  author: text('author').notNull(),
+  command: text('command').notNull(),
+  status: text('status').notNull(), // 'DONE', 'FAILED', 'DENIED'
+  message: text('message'),
+  createdAt: text('created_at').notNull(),
+});
+
+// Pull requests seen by the PR monitor, with what it did about each.
+export const pullRequests = sqliteTable('pull_requests', {
+  repo: text('repo').notNull(), // 'owner/repo', or 'host/owner/repo' for other GitHub hosts
+  number: integer('number').notNull(),
+  url: text('url').notNull(),
+  sessionId: text('session_id'), // Session that opened the PR, if any
+  headSha: text('head_sha'),
+  state: text('state').notNull().default('open'), // 'open', 'closed', 'merged'
+  lastStatus: text('last_status'), // Combined status of the head: 'success', 'pending', 'failure'
+  nagCount: integer('nag_count').notNull().default(0), // Failure reports since the checks last passed
+  firstFailedAt: text('first_failed_at'), // When the checks started failing; cleared when they pass
+  lastReport: text('last_report'), // Last failure report posted, to avoid repeating it
+  lastReportAt: text('last_report_at'),
+  escalatedAt: text('escalated_at'), // When failure reports reached the threshold and the PR was escalated
+  lastAction: text('last_action'),
+  lastActionAt: text('last_action_at'),
+  mergedByHub: integer('merged_by_hub', { mode: 'boolean' }).notNull().default(false),
+  closedByHub: integer('closed_by_hub', { mode: 'boolean' }).notNull().default(false),
+  createdAt: text('created_at').notNull(),
+  updatedAt: text('updated_at').notNull(),
+}, (table) => ({
+  pk: primaryKey({ columns: [table.repo, table.number] }),
+  updatedAtIdx: index('pull_requests_updated_at_idx').on(table.updatedAt),
+}));
+
+// Decisions of the auto-merge policy, explaining why PRs were merged or not.
+export const mergeDecisions = sqliteTable('merge_decisions', {
+  id: text('id').primaryKey(),
+  repo: text('repo').notNull(),
+  number: integer('number').notNull(),
+  headSha: text('head_sha'),
+  merged: integer('merged', { mode: 'boolean' }).notNull().default(false),
+  reason: text('reason').notNull(),
+  createdAt: text('created_at').notNull(),
+}, (table) => ({
+  repoNumberCreatedAtIdx: index('merge_decisions_repo_number_created_at_idx').on(table.repo, table.number, table.createdAt),
+}));
+
+// Failing checks the PR monitor found on a commit, and how often it reran them.
+export const checkReruns = sqliteTable('check_reruns', {
+  repo: text('repo').notNull(),
+  headSha: text('head_sha').notNull(),
+  checkName: text('check_name').notNull(),
+  attempts: integer('attempts').notNull().default(0),
+  lastRunId: integer('last_run_id'), // The check run that was rerun last
+  outcome: text('outcome'), // 'flake' if the check passed after a rerun, 'failed' if reruns ran out
+  createdAt: text('created_at').notNull(),
+  updatedAt: text('updated_at').notNull(),
+}, (table) => ({
+  pk: primaryKey({ columns: [table.repo, table.headSha, table.checkName] }),
+}));
+
+// Rerun statistics per check, to find flaky checks.
+export const checkFlakes = sqliteTable('check_flakes', {
+  repo: text('repo').notNull(),
+  checkName: text('check_name').notNull(),
+  failures: integer('failures').notNull().default(0), // Commits the check failed on
+  reruns: integer('reruns').notNull().default(0),
+  flakes: integer('flakes').notNull().default(0), // Commits the check passed on after a rerun
+  ignored: integer('ignored', { mode: 'boolean' }).notNull().default(false), // Failures are not reported
+  lastFailedAt: text('last_failed_at'),
+  lastFlakeAt: text('last_flake_at'),
+}, (table) => ({
+  pk: primaryKey({ columns: [table.repo, table.checkName] }),
+}));
+
+// Pipelines chain job templates into a DAG. Steps are stored as JSON (PipelineStep messages).
+export const pipelines = sqliteTable('pipelines', {
+  id: text('id').primaryKey(),
+  name: text('name').notNull(),
+  steps: text('steps').notNull(),
+  sequential: integer('sequential', { mode: 'boolean' }).notNull().default(false),
+  profileId: text('profile_id').references(() => profiles.id).notNull().default('default'),
+  createdAt: text('created_at').notNull(),
+  updatedAt: text('updated_at'),
+});
+
+// A run keeps a snapshot of the pipeline steps, so editing the pipeline does not affect it.
+export const pipelineRuns = sqliteTable('pipeline_runs', {
+  id: text('id').primaryKey(),
+  pipelineId: text('pipeline_id').notNull(),
+  pipelineName: text('pipeline_name').notNull(),
+  steps: text('steps').notNull(),
+  sequential: integer('sequential', { mode: 'boolean' }).notNull().default(false),
+  profileId: text('profile_id').notNull().default('default'),
+  status: text('status').notNull(), // 'RUNNING', 'SUCCEEDED', 'FAILED', 'CANCELLED'
+  createdAt: text('created_at').notNull(),
+  updatedAt: text('updated_at').notNull(),
+  finishedAt: text('finished_at'),
+}, (table) => ({
+  pipelineIdCreatedAtIdx: index('pipeline_runs_pipeline_id_created_at_idx').on(table.pipelineId, table.createdAt),
+}));
+
+export const pipelineRunSteps = sqliteTable('pipeline_run_steps', {
+  runId: text('run_id').notNull(),
+  stepId: text('step_id').notNull(),
+  status: text('status').notNull(), // 'WAITING', 'RUNNING', 'SUCCEEDED', 'FAILED', 'SKIPPED', 'CANCELLED'
+  jobId: text('job_id'),
+  message: text('message'),
+  startedAt: text('started_at'),
+  finishedAt: text('finished_at'),
+}, (table) => ({
+  pk: primaryKey({ columns: [table.runId, table.stepId] }),
+}));
+
 export const predefinedPrompts = sqliteTable('predefined_prompts', {
   id: text('id').primaryKey(),
   title: text('title').notNull(),
@@ -125,6 +334,24 @@ export const settings = sqliteTable('settings', {
   minSessionInteractionInterval: integer('min_session_interaction_interval').notNull().default(60),
   retryTimeout: integer('retry_timeout').notNull().default(1200), // 20 minutes
   maxConcurrentBackgroundWorkers: integer('max_concurrent_background_workers').notNull().default(5),
+  // Issue Automation
+  issueAutomationEnabled: integer('issue_automation_enabled', { mode: 'boolean' }).notNull().default(false),
+  issueAutomationLabel: text('issue_automation_label').notNull().default('jules'),
+  issueAutomationRepos: text('issue_automation_repos').notNull().default(''),
+  chatOpsEnabled: integer('chat_ops_enabled', { mode: 'boolean' }).notNull().default(false),
+  checkFailingActionsEscalation: text('check_failing_actions_escalation').notNull().default('label'), // 'label', 'request_reviewer', 'close', 'notify'
+  checkFailingActionsEscalationTarget: text('check_failing_actions_escalation_target').notNull().default(''), // Label, or comma-separated logins
+  flakyCheckMaxReruns: integer('flaky_check_max_reruns').notNull().default(1), // Reruns of a failing check before it is reported
+  informationalChecks: text('informational_checks').notNull().default(''), // Lines of "owner/repo: check, check" that don't gate PRs
+  autoMergeScope: text('auto_merge_scope').notNull().default('all'), // 'all', 'sessions' or 'labeled'
+  autoMergeLabel: text('auto_merge_label').notNull().default('automerge'),
+  autoMergeRequiredApprovals: integer('auto_merge_required_approvals').notNull().default(0),
+  autoMergeBlockChangesRequested: integer('auto_merge_block_changes_requested', { mode: 'boolean' }).notNull().default(true),
+  autoMergeMaxAdditions: integer('auto_merge_max_additions').notNull().default(0), // 0 for no limit
+  autoMergeMaxDeletions: integer('auto_merge_max_deletions').notNull().default(0), // 0 for no limit
+  autoMergeMaxFiles: integer('auto_merge_max_files').notNull().default(0), // 0 for no limit
+  autoMergeProtectedPaths: text('auto_merge_protected_paths').notNull().default(''), // Globs PRs must not touch
+  autoMergeRequireBaseGreen: integer('auto_merge_require_base_green', { mode: 'boolean' }).notNull().default(false),
   autoApprovalAllSessions: integer('auto_approval_all_sessions', { mode: 'boolean' }).notNull().default(true),
   autoContinueAllSessions: integer('auto_continue_all_sessions', { mode: 'boolean' }).notNull().default(true),
   autoMergeEnabled: integer('auto_merge_enabled', { mode: 'boolean' }).notNull().default(false),
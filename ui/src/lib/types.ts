@@ -38,8 +38,21 @@ export type Job = {
     cronJobId?: string | null;
     profileId?: string;
   chatEnabled?: boolean;
+    parentJobId?: string | null;
+    matrix?: JobMatrix | null;
 }
 
+export type JobTarget = {
+  repo: string;
+  branch: string;
+  vars?: Record<string, string>;
+  sessionCount?: number;
+};
+
+export type JobMatrix = {
+  targets: JobTarget[];
+};
+
 export type ChatConfig = {
   jobId: string;
   agentName: string;
@@ -72,6 +85,7 @@ export type CronJob = {
     requirePlanApproval?: boolean;
     sessionCount?: number;
     profileId?: string;
+    matrix?: JobMatrix | null;
 };
 
 export type SourceContext = {